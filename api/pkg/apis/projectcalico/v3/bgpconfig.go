@@ -117,6 +117,11 @@ type BGPConfigurationSpec struct {
 	// It is recommended to use a link-local address.
 	// +optional
 	LocalWorkloadPeeringIPV6 string `json:"localWorkloadPeeringIPV6,omitempty" validate:"omitempty,ipv6"`
+
+	// BFD configures Bidirectional Forwarding Detection for the BGP sessions of the node, including
+	// the node-to-node mesh.  Whether BFD is enabled can be overridden for individual BGPPeers.
+	// +optional
+	BFD *BFDConfiguration `json:"bfd,omitempty" validate:"omitempty"`
 }
 
 // ServiceLoadBalancerIPBlock represents a single allowed LoadBalancer IP CIDR block.
@@ -157,6 +162,27 @@ type PrefixAdvertisement struct {
 	Communities []string `json:"communities,omitempty" validate:"required"`
 }
 
+// BFDConfiguration contains the Bidirectional Forwarding Detection (BFD) settings used to detect the
+// failure of a BGP session faster than the BGP hold timer allows.
+type BFDConfiguration struct {
+	// Enabled sets whether BFD is used for BGP sessions. [Default: false]
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinRxInterval is the minimum interval between received BFD control packets that this node
+	// is able to support. [Default: 10ms]
+	MinRxInterval *metav1.Duration `json:"minRxInterval,omitempty"`
+
+	// MinTxInterval is the desired minimum interval between BFD control packets sent by this
+	// node. [Default: 100ms]
+	MinTxInterval *metav1.Duration `json:"minTxInterval,omitempty"`
+
+	// Multiplier is the number of consecutive BFD control packets that may be missed before the
+	// session is declared down. [Default: 5]
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=255
+	Multiplier *int `json:"multiplier,omitempty" validate:"omitempty,gte=1,lte=255"`
+}
+
 // New BGPConfiguration creates a new (zeroed) BGPConfiguration struct with the TypeMetadata
 // initialized to the current version.
 func NewBGPConfiguration() *BGPConfiguration {
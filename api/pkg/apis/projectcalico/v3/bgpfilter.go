@@ -15,6 +15,7 @@
 package v3
 
 import (
+	"github.com/projectcalico/api/pkg/lib/numorstring"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -72,7 +73,17 @@ type BGPFilterRuleV4 struct {
 
 	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty" validate:"omitempty,matchOperator"`
 
+	// If set, the rule only matches routes that carry all of the given BGP communities.
+	Communities *BGPFilterCommunityMatch `json:"communities,omitempty" validate:"omitempty"`
+
+	// If set, the rule only matches routes whose AS path satisfies the given match.
+	ASPath *BGPFilterASPathMatch `json:"asPath,omitempty" validate:"omitempty"`
+
 	Action BGPFilterAction `json:"action" validate:"required,filterAction"`
+
+	// Operations is an ordered list of route attribute modifications that are applied to
+	// matching routes before they are accepted. Operations may only be used with the Accept action.
+	Operations []BGPFilterOperation `json:"operations,omitempty" validate:"omitempty,dive"`
 }
 
 // BGPFilterRuleV6 defines a BGP filter rule consisting a single IPv6 CIDR block and a filter action for this CIDR.
@@ -87,7 +98,17 @@ type BGPFilterRuleV6 struct {
 
 	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty" validate:"omitempty,matchOperator"`
 
+	// If set, the rule only matches routes that carry all of the given BGP communities.
+	Communities *BGPFilterCommunityMatch `json:"communities,omitempty" validate:"omitempty"`
+
+	// If set, the rule only matches routes whose AS path satisfies the given match.
+	ASPath *BGPFilterASPathMatch `json:"asPath,omitempty" validate:"omitempty"`
+
 	Action BGPFilterAction `json:"action" validate:"required,filterAction"`
+
+	// Operations is an ordered list of route attribute modifications that are applied to
+	// matching routes before they are accepted. Operations may only be used with the Accept action.
+	Operations []BGPFilterOperation `json:"operations,omitempty" validate:"omitempty,dive"`
 }
 
 type BGPFilterPrefixLengthV4 struct {
@@ -108,6 +129,69 @@ type BGPFilterPrefixLengthV6 struct {
 	Max *int32 `json:"max,omitempty" validate:"omitempty,bgpFilterPrefixLengthV6"`
 }
 
+// BGPCommunityValue is a BGP standard community in the form `aa:nn`, or a BGP large community
+// in the form `aa:nn:mm`.
+// +kubebuilder:validation:Pattern=`^(\d+):(\d+)$|^(\d+):(\d+):(\d+)$`
+type BGPCommunityValue string
+
+type BGPFilterCommunityMatch struct {
+	// Values is the set of communities that a route must carry, all of which must be present for the
+	// route to match.
+	Values []BGPCommunityValue `json:"values" validate:"required"`
+}
+
+type BGPFilterASPathMatch struct {
+	// Prefix is a sequence of AS numbers that the AS path of the route must begin with, starting with
+	// the AS of the neighbor that advertised the route.
+	Prefix []numorstring.ASNumber `json:"prefix,omitempty"`
+
+	// Contains is an AS number that must appear somewhere in the AS path of the route.
+	Contains *numorstring.ASNumber `json:"contains,omitempty"`
+}
+
+// BGPFilterOperation is a single modification of the attributes of a route. Exactly one field
+// must be set.
+type BGPFilterOperation struct {
+	// AddCommunity adds a community to the route, in addition to any communities it already carries.
+	AddCommunity *BGPFilterAddCommunity `json:"addCommunity,omitempty"`
+
+	// SetCommunities removes all existing standard and large communities from the route and replaces them
+	// with the given set.
+	SetCommunities *BGPFilterSetCommunities `json:"setCommunities,omitempty"`
+
+	// SetLocalPreference sets the BGP LOCAL_PREF attribute of the route.
+	SetLocalPreference *BGPFilterSetLocalPreference `json:"setLocalPreference,omitempty"`
+
+	// SetMED sets the BGP MULTI_EXIT_DISC attribute of the route.
+	SetMED *BGPFilterSetMED `json:"setMED,omitempty"`
+
+	// PrependASPath prepends the given AS numbers to the AS path of the route.
+	PrependASPath *BGPFilterPrependASPath `json:"prependASPath,omitempty"`
+}
+
+type BGPFilterAddCommunity struct {
+	Value BGPCommunityValue `json:"value"`
+}
+
+type BGPFilterSetCommunities struct {
+	Values []BGPCommunityValue `json:"values,omitempty"`
+}
+
+type BGPFilterSetLocalPreference struct {
+	Value uint32 `json:"value"`
+}
+
+type BGPFilterSetMED struct {
+	Value uint32 `json:"value"`
+}
+
+type BGPFilterPrependASPath struct {
+	// Prefix is the sequence of AS numbers to prepend. The first AS number in the list ends up first in
+	// the resulting AS path.
+	// +kubebuilder:validation:MinItems=1
+	Prefix []numorstring.ASNumber `json:"prefix"`
+}
+
 type BGPFilterMatchSource string
 
 const (
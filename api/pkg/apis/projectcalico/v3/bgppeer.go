@@ -117,6 +117,11 @@ type BGPPeerSpec struct {
 	// and the ASNumber must not be empty.
 	// +optional
 	LocalWorkloadSelector string `json:"localWorkloadSelector,omitempty" validate:"omitempty,selector"`
+
+	// BFD overrides whether Bidirectional Forwarding Detection is used for the peerings generated by
+	// this BGPPeer resource.  BFD timers are taken from the BGPConfiguration of the node.
+	// +optional
+	BFD *BGPPeerBFD `json:"bfd,omitempty" validate:"omitempty"`
 }
 
 type SourceAddress string
@@ -132,6 +137,13 @@ type BGPPassword struct {
 	SecretKeyRef *k8sv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
+// BGPPeerBFD contains the Bidirectional Forwarding Detection settings of a BGPPeer.
+type BGPPeerBFD struct {
+	// Enabled sets whether BFD is used for the peerings generated by this BGPPeer resource.  When not
+	// set, BFD is used if it is enabled in the BGPConfiguration of the node.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // NewBGPPeer creates a new (zeroed) BGPPeer struct with the TypeMetadata initialised to the current
 // version.
 func NewBGPPeer() *BGPPeer {
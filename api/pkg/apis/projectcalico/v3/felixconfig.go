@@ -434,6 +434,21 @@ type FelixConfigurationSpec struct {
 	// set to false. This reduces the number of metrics reported, reducing Prometheus load. [Default: true]
 	PrometheusWireGuardMetricsEnabled *bool `json:"prometheusWireGuardMetricsEnabled,omitempty"`
 
+	// PrometheusRuleMetricsEnabled enables Prometheus counters of the packets, bytes and connections that match each
+	// policy rule, as seen by Felix's flow collector. Requires PrometheusMetricsEnabled. [Default: false]
+	PrometheusRuleMetricsEnabled *bool `json:"prometheusRuleMetricsEnabled,omitempty"`
+
+	// PrometheusRuleMetricsMaxRules limits the number of policy rules that metrics are reported for, bounding the number
+	// of series exported. Traffic that matches further rules is not counted until existing series expire. [Default: 1000]
+	// +kubebuilder:validation:Minimum=1
+	PrometheusRuleMetricsMaxRules *int `json:"prometheusRuleMetricsMaxRules,omitempty" validate:"omitempty,gte=1"`
+
+	// PrometheusRuleMetricsRetention is how long the metrics for a policy rule are reported after the rule last matched
+	// traffic. [Default: 10m]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	PrometheusRuleMetricsRetention *metav1.Duration `json:"prometheusRuleMetricsRetention,omitempty" configv1timescale:"seconds"`
+
 	// FailsafeInboundHostPorts is a list of ProtoPort struct objects including UDP/TCP/SCTP ports and CIDRs that Felix will
 	// allow incoming traffic to host endpoints on irrespective of the security policy. This is useful to avoid accidentally
 	// cutting off a host with incorrect configuration. For backwards compatibility, if the protocol is not specified,
@@ -835,6 +850,49 @@ type FelixConfigurationSpec struct {
 	// FlowLogGoldmaneServer is the flow server endpoint to which flow data should be published.
 	FlowLogsGoldmaneServer *string `json:"flowLogsGoldmaneServer,omitempty"`
 
+	// FlowLogsFileEnabled, when set to true, enables writing flow logs to rotating files on each node,
+	// as JSON lines. This is independent of FlowLogsGoldmaneServer, and allows per-node flow logs to be
+	// collected where Goldmane is not deployed. [Default: false]
+	FlowLogsFileEnabled *bool `json:"flowLogsFileEnabled,omitempty"`
+
+	// FlowLogsFileDirectory is the directory in which flow log files are written. [Default: /var/log/calico/flowlogs]
+	FlowLogsFileDirectory *string `json:"flowLogsFileDirectory,omitempty"`
+
+	// FlowLogsFileMaxFileSizeMB is the maximum size in MB of a flow log file before it is rotated. [Default: 100]
+	// +kubebuilder:validation:Minimum=1
+	FlowLogsFileMaxFileSizeMB *int `json:"flowLogsFileMaxFileSizeMB,omitempty" validate:"omitempty,gte=1"`
+
+	// FlowLogsFileMaxFiles is the number of rotated flow log files to retain, in addition to the file currently
+	// being written. [Default: 5]
+	// +kubebuilder:validation:Minimum=1
+	FlowLogsFileMaxFiles *int `json:"flowLogsFileMaxFiles,omitempty" validate:"omitempty,gte=1"`
+
+	// FlowLogsFileMaxAgeDays is the number of days to retain rotated flow log files for. Files older than this
+	// are removed even if there are fewer than FlowLogsFileMaxFiles of them. Set to 0 to remove files based
+	// only on FlowLogsFileMaxFiles. [Default: 7]
+	// +kubebuilder:validation:Minimum=0
+	FlowLogsFileMaxAgeDays *int `json:"flowLogsFileMaxAgeDays,omitempty" validate:"omitempty,gte=0"`
+
+	// DNSPolicyEnabled controls whether Felix snoops DNS responses to local workloads, so that it can enforce
+	// policy rules that match on destination domains. [Default: false]
+	DNSPolicyEnabled *bool `json:"dnsPolicyEnabled,omitempty"`
+
+	// DNSTrustedServers is the list of DNS servers whose responses Felix trusts when learning the IPs for
+	// domains in policy. Each entry is an IP, optionally with a port (for example "10.0.0.10:5353" or
+	// "[fd00::10]:53"), or a Kubernetes service of the form "k8s-service:<namespace>/<name>". If empty,
+	// no responses are trusted. [Default: empty]
+	DNSTrustedServers *[]string `json:"dnsTrustedServers,omitempty"`
+
+	// DNSCacheFile is the file in which Felix persists the IPs that it has learned for domains, so that they
+	// survive a restart. [Default: /var/run/calico/felix-dns-cache.json]
+	DNSCacheFile *string `json:"dnsCacheFile,omitempty"`
+
+	// DNSExtraTTL is extra time to keep IPs learned from DNS, on top of the TTL in the DNS response, to allow for
+	// clients that use an IP slightly after its TTL has expired. [Default: 0s]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	DNSExtraTTL *metav1.Duration `json:"dnsExtraTTL,omitempty" configv1timescale:"seconds"`
+
 	// BPFProfiling controls profiling of BPF programs. At the monent, it can be
 	// Disabled or Enabled. [Default: Disabled]
 	//+kubebuilder:validation:Enum=Enabled;Disabled
@@ -54,6 +54,51 @@ type IPAMConfigurationSpec struct {
 	// MaxBlocksPerHost, if non-zero, is the max number of blocks that can be
 	// affine to each host.
 	MaxBlocksPerHost int32 `json:"maxBlocksPerHost,omitempty"`
+
+	// IPQuotas limits the number of addresses that Calico IPAM allocates to pods in
+	// the selected namespaces.
+	// +optional
+	IPQuotas []IPQuota `json:"ipQuotas,omitempty" validate:"omitempty,dive"`
+}
+
+// +kubebuilder:validation:Enum=PerNamespace;Shared
+type IPQuotaScope string
+
+const (
+	// IPQuotaScopePerNamespace applies the quota's limit to each selected namespace separately.
+	IPQuotaScopePerNamespace IPQuotaScope = "PerNamespace"
+
+	// IPQuotaScopeShared applies the quota's limit to all of the selected namespaces together,
+	// for example to limit all of the namespaces that belong to one tenant.
+	IPQuotaScopeShared IPQuotaScope = "Shared"
+)
+
+// IPQuota limits the number of addresses allocated to pods in a set of namespaces.
+type IPQuota struct {
+	// Name identifies the quota in assignment errors and utilization reports.
+	Name string `json:"name" validate:"name"`
+
+	// NamespaceSelector selects the namespaces that the quota applies to, using the
+	// namespace labels.  The projectcalico.org/name label can be used to select a
+	// namespace by name.  If not specified, the quota applies to all namespaces.
+	// +optional
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"omitempty,selector"`
+
+	// IPPools lists the names of the IP pools that the quota covers.  Only addresses
+	// allocated from these pools count towards the quota.  If not specified, the quota
+	// covers all IP pools.
+	// +optional
+	IPPools []string `json:"ipPools,omitempty" validate:"omitempty,dive,name"`
+
+	// Scope controls whether the limit applies to each selected namespace separately
+	// (PerNamespace), or to all of the selected namespaces together (Shared).
+	// [Default: PerNamespace]
+	// +optional
+	Scope IPQuotaScope `json:"scope,omitempty" validate:"omitempty,oneof=PerNamespace Shared"`
+
+	// MaxIPs is the maximum number of addresses that may be allocated.
+	// +kubebuilder:validation:Minimum:=0
+	MaxIPs int `json:"maxIPs" validate:"gte=0"`
 }
 
 // NewIPAMConfiguration creates a new (zeroed) IPAMConfiguration struct with the TypeMetadata initialised to the current
@@ -43,6 +43,8 @@ type IPPool struct {
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
 	Spec IPPoolSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status of the IPPool.
+	Status *IPPoolStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // IPPoolSpec contains the specification for an IPPool resource.
@@ -89,6 +91,87 @@ type IPPoolSpec struct {
 	// Determines the mode how IP addresses should be assigned from this pool
 	// +optional
 	AssignmentMode *AssignmentMode `json:"assignmentMode,omitempty" validate:"omitempty,assignmentMode"`
+
+	// Drain requests that workloads are migrated off this pool. The pool must also be disabled so that
+	// no new addresses are assigned from it. Draining is carried out by the IP pool drain controller in
+	// calico-kube-controllers, which releases empty blocks, reports the remaining allocations in the
+	// pool status and, if enabled, evicts pods that still hold addresses from the pool.
+	// +optional
+	Drain *IPPoolDrain `json:"drain,omitempty" validate:"omitempty"`
+
+	// Topology splits the pool into sub-ranges for different topology domains, such as zones or racks,
+	// so that the addresses used within each domain stay contiguous. Calico IPAM only claims blocks
+	// for a node from the range for the node's domain. Nodes in a domain that has no range claim blocks
+	// from the rest of the pool.
+	// +optional
+	Topology *IPPoolTopology `json:"topology,omitempty" validate:"omitempty"`
+}
+
+// IPPoolTopology contains the topology-aware block allocation configuration of an IP pool.
+type IPPoolTopology struct {
+	// Key is the node label that identifies a node's topology domain, for example
+	// topology.kubernetes.io/zone or a rack label.
+	Key string `json:"key" validate:"labelName"`
+
+	// Ranges assigns a sub-range of the pool to each topology domain.
+	Ranges []IPPoolTopologyRange `json:"ranges" validate:"dive"`
+}
+
+// IPPoolTopologyRange assigns a sub-range of an IP pool to a topology domain.
+type IPPoolTopologyRange struct {
+	// Value is the value of the topology label on the nodes in the domain.
+	Value string `json:"value"`
+
+	// CIDR is the sub-range of the pool that the domain's blocks are taken from. It must be
+	// within the pool CIDR and be at least as large as a block.
+	CIDR string `json:"cidr" validate:"net"`
+}
+
+// IPPoolDrain contains the configuration for draining an IP pool.
+type IPPoolDrain struct {
+	// EvictPods enables eviction of pods that still hold addresses from the pool, so that they are
+	// recreated with addresses from another pool. Evictions honour PodDisruptionBudgets. [Default: false]
+	// +optional
+	EvictPods bool `json:"evictPods,omitempty"`
+
+	// EvictionBatchSize is the maximum number of pods to evict in each batch. [Default: 10]
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	EvictionBatchSize *int `json:"evictionBatchSize,omitempty" validate:"omitempty,gte=1"`
+
+	// EvictionInterval is the minimum time between eviction batches. [Default: 1m]
+	// +optional
+	EvictionInterval *metav1.Duration `json:"evictionInterval,omitempty" validate:"omitempty"`
+}
+
+// IPPoolStatus contains the status of an IP pool.
+type IPPoolStatus struct {
+	// Drain reports the progress of draining the pool. It is only set while the pool is being drained.
+	// +optional
+	Drain *IPPoolDrainStatus `json:"drain,omitempty"`
+}
+
+// IPPoolDrainStatus reports the addresses that remain allocated from a draining IP pool.
+type IPPoolDrainStatus struct {
+	// Blocks is the number of allocation blocks remaining in the pool.
+	Blocks int `json:"blocks"`
+
+	// AllocatedIPs is the number of addresses still allocated from the pool.
+	AllocatedIPs int `json:"allocatedIPs"`
+
+	// Namespaces lists the namespaces that have pods still holding addresses from the pool.
+	// +optional
+	Namespaces []IPPoolNamespaceAllocations `json:"namespaces,omitempty"`
+
+	// LastEviction is the time at which the last batch of pods was evicted.
+	// +optional
+	LastEviction *metav1.Time `json:"lastEviction,omitempty"`
+}
+
+// IPPoolNamespaceAllocations reports the number of addresses held by pods in a namespace.
+type IPPoolNamespaceAllocations struct {
+	Namespace    string `json:"namespace"`
+	AllocatedIPs int    `json:"allocatedIPs"`
 }
 
 type IPPoolAllowedUse string
@@ -87,6 +87,12 @@ type ControllersConfig struct {
 
 	// LoadBalancer enables and configures the LoadBalancer controller. Enabled by default, set to nil to disable.
 	LoadBalancer *LoadBalancerControllerConfig `json:"loadBalancer,omitempty"`
+
+	// RouteReflector enables and configures the route reflector controller. Disabled by default, set to nil to disable.
+	RouteReflector *RouteReflectorControllerConfig `json:"routeReflector,omitempty"`
+
+	// IPPoolDrain enables and configures the IP pool drain controller. Disabled by default, set to nil to disable.
+	IPPoolDrain *IPPoolDrainControllerConfig `json:"ipPoolDrain,omitempty"`
 }
 
 // NodeControllerConfig configures the node controller, which automatically cleans up configuration
@@ -177,6 +183,32 @@ const (
 	RequestedServicesOnly AssignIPs = "RequestedServicesOnly"
 )
 
+// RouteReflectorControllerConfig configures the route reflector controller, which keeps a target number
+// of route reflectors in each zone, sets their route reflector cluster ID, and maintains the BGPPeers that
+// connect them to each other and to the other nodes in their zone. The node-to-node mesh should be disabled
+// when this controller is in use.
+type RouteReflectorControllerConfig struct {
+	// NodeSelector selects the nodes that may be chosen as route reflectors. [Default: all()]
+	NodeSelector string `json:"nodeSelector,omitempty" validate:"omitempty,selector"`
+
+	// ZoneLabel is the node label used to group nodes into zones or racks. Each zone has its own route
+	// reflectors, and nodes only peer with the route reflectors in their zone. Nodes without the label
+	// form a zone of their own. [Default: topology.kubernetes.io/zone]
+	ZoneLabel string `json:"zoneLabel,omitempty" validate:"omitempty,labelName"`
+
+	// ReflectorsPerZone is the number of route reflectors to maintain in each zone. [Default: 3]
+	// +kubebuilder:validation:Minimum=1
+	ReflectorsPerZone *int `json:"reflectorsPerZone,omitempty" validate:"omitempty,gte=1"`
+}
+
+// IPPoolDrainControllerConfig configures the IP pool drain controller, which migrates workloads off IP pools
+// that have drain set. It releases empty blocks, reports the remaining allocations in the pool status and,
+// if requested by the pool, evicts pods that still hold addresses from it.
+type IPPoolDrainControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation of draining IP pools. [Default: 1m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
 // KubeControllersConfigurationStatus represents the status of the configuration. It's useful for admins to
 // be able to see the actual config that was applied, which can be modified by environment variables on the
 // kube-controllers process.
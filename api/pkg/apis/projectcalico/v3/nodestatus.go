@@ -76,6 +76,9 @@ type CalicoNodeStatusStatus struct {
 
 	// Routes reports routes known to the Calico BGP daemon on the node.
 	Routes CalicoNodeBGPRouteStatus `json:"routes,omitempty"`
+
+	// BFD holds node BFD session status.
+	BFD CalicoNodeBFDStatus `json:"bfd,omitempty"`
 }
 
 // CalicoNodeAgentStatus defines the observed state of agent status on the node.
@@ -117,6 +120,15 @@ type CalicoNodeBGPRouteStatus struct {
 	RoutesV6 []CalicoNodeRoute `json:"routesV6,omitempty"`
 }
 
+// CalicoNodeBFDStatus defines the observed state of BFD sessions on the node.
+type CalicoNodeBFDStatus struct {
+	// SessionsV4 represents IPv4 BFD sessions on the node.
+	SessionsV4 []CalicoNodeBFDSession `json:"sessionsV4,omitempty"`
+
+	// SessionsV6 represents IPv6 BFD sessions on the node.
+	SessionsV6 []CalicoNodeBFDSession `json:"sessionsV6,omitempty"`
+}
+
 // BGPDaemonStatus defines the observed state of BGP daemon.
 type BGPDaemonStatus struct {
 	// The state of the BGP Daemon.
@@ -151,6 +163,21 @@ type CalicoNodePeer struct {
 	Since string `json:"since,omitempty"`
 }
 
+// CalicoNodeBFDSession contains the status of a BFD session on the node.
+type CalicoNodeBFDSession struct {
+	// IP address of the peer at the other end of the BFD session.
+	PeerIP string `json:"peerIP,omitempty" validate:"omitempty,ip"`
+
+	// Interface the session runs over, empty for multihop sessions.
+	Interface string `json:"interface,omitempty"`
+
+	// State is the BFD session state.
+	State BFDSessionState `json:"state,omitempty"`
+
+	// Since the state last changed.
+	Since string `json:"since,omitempty"`
+}
+
 // CalicoNodeRoute contains the status of BGP routes on the node.
 type CalicoNodeRoute struct {
 	// Type indicates if the route is being used for forwarding or not.
@@ -212,6 +239,7 @@ const (
 	NodeStatusClassTypeAgent  NodeStatusClassType = "Agent"
 	NodeStatusClassTypeBGP    NodeStatusClassType = "BGP"
 	NodeStatusClassTypeRoutes NodeStatusClassType = "Routes"
+	NodeStatusClassTypeBFD    NodeStatusClassType = "BFD"
 )
 
 type BGPPeerType string
@@ -240,3 +268,12 @@ const (
 	BGPSessionStateEstablished BGPSessionState = "Established"
 	BGPSessionStateClose       BGPSessionState = "Close"
 )
+
+type BFDSessionState string
+
+const (
+	BFDSessionStateAdminDown BFDSessionState = "AdminDown"
+	BFDSessionStateDown      BFDSessionState = "Down"
+	BFDSessionStateInit      BFDSessionState = "Init"
+	BFDSessionStateUp        BFDSessionState = "Up"
+)
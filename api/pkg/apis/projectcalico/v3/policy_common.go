@@ -15,6 +15,8 @@
 package v3
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 )
 
@@ -61,6 +63,11 @@ type Rule struct {
 	// HTTP contains match criteria that apply to HTTP requests.
 	HTTP *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
 
+	// RateLimit is an optional field that limits the rate of traffic that matches the rule.  Traffic
+	// that matches the rule but exceeds the limit is dropped; traffic that is within the limit is
+	// given the rule's Action.  RateLimit may only be used with the Allow and Pass actions.
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty" validate:"omitempty"`
+
 	// Metadata contains additional information for this rule
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
 }
@@ -73,8 +80,32 @@ type HTTPPath struct {
 	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
 }
 
+// HTTPHeaderMatch specifies an HTTP request header to match. Exactly one of the following
+// must be set:
+// exact: <value>: which matches the header value exactly
+// prefix: <value-prefix>: which matches the header value prefix
+// regex: <regex>: which matches the whole header value against an RE2 regular expression
+// present: <bool>: which matches if the header is present (true) or absent (false)
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match.  Header names are case-insensitive.
+	Name    string `json:"name" validate:"required"`
+	Exact   string `json:"exact,omitempty" validate:"omitempty"`
+	Prefix  string `json:"prefix,omitempty" validate:"omitempty"`
+	Regex   string `json:"regex,omitempty" validate:"omitempty"`
+	Present *bool  `json:"present,omitempty" validate:"omitempty"`
+}
+
+// GRPCMethodMatch specifies a gRPC method to match.
+type GRPCMethodMatch struct {
+	// Service is the fully-qualified name of the gRPC service, e.g. "helloworld.Greeter".
+	Service string `json:"service" validate:"required"`
+	// Method is an optional field that restricts the match to a single method of the service,
+	// e.g. "SayHello".  If omitted, all methods of the service match.
+	Method string `json:"method,omitempty" validate:"omitempty"`
+}
+
 // HTTPMatch is an optional field that apply only to HTTP requests
-// The Methods and Path fields are joined with AND
+// The Methods, Paths, Headers, Hosts and GRPCMethods fields are joined with AND
 type HTTPMatch struct {
 	// Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed
 	// HTTP Methods (e.g. GET, PUT, etc.)
@@ -88,6 +119,60 @@ type HTTPMatch struct {
 	// - prefix: /bar
 	// NOTE: Each entry may ONLY specify either a `exact` or a `prefix` match. The validator will check for it.
 	Paths []HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	// Headers is an optional field that restricts the rule to apply to HTTP requests with headers that
+	// match all of the listed header matches.
+	// Multiple headers are AND'd together.
+	// e.g:
+	// - name: X-Tenant
+	//   exact: a
+	// - name: Authorization
+	//   present: true
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty"`
+	// Hosts is an optional field that restricts the rule to apply to HTTP requests for one of the listed
+	// hosts, as given by the :authority (or Host) header.  Any port in the request's host is ignored.
+	// A leading "*." matches any subdomain, e.g. "*.example.com".
+	// Multiple hosts are OR'd together.
+	Hosts []string `json:"hosts,omitempty" validate:"omitempty,dive,domain"`
+	// GRPCMethods is an optional field that restricts the rule to apply to gRPC requests for one of the
+	// listed services or methods.
+	// Multiple methods are OR'd together.
+	GRPCMethods []GRPCMethodMatch `json:"grpcMethods,omitempty" validate:"omitempty"`
+}
+
+// RuleRateLimitScope determines which traffic shares a rate limit bucket.
+type RuleRateLimitScope string
+
+const (
+	// RuleRateLimitScopeRule applies a single limit to all traffic that matches the rule.
+	RuleRateLimitScopeRule RuleRateLimitScope = "Rule"
+	// RuleRateLimitScopeSourceIP applies a separate limit to each source IP address.
+	RuleRateLimitScopeSourceIP RuleRateLimitScope = "SourceIP"
+)
+
+// RuleRateLimit limits the rate at which traffic is allowed to match a rule.  At least one of
+// ConnectionsPerSecond and PacketsPerSecond must be specified; if both are specified then
+// traffic must be within both limits.
+type RuleRateLimit struct {
+	// ConnectionsPerSecond is the maximum rate at which new connections that match the rule are
+	// accepted.
+	// +kubebuilder:validation:Minimum=1
+	ConnectionsPerSecond *uint32 `json:"connectionsPerSecond,omitempty" validate:"omitempty,gt=0"`
+	// PacketsPerSecond is the maximum rate at which packets that match the rule are accepted.
+	//
+	// Policy is only evaluated for packets that don't belong to an established flow; in all
+	// dataplanes, packets of established and related flows are accepted before policy.  So this
+	// is a limit on the packets that start new flows, much like ConnectionsPerSecond, and it
+	// can't be used to limit the bandwidth of an established flow.
+	// +kubebuilder:validation:Minimum=1
+	PacketsPerSecond *uint32 `json:"packetsPerSecond,omitempty" validate:"omitempty,gt=0"`
+	// Burst is the number of connections (or packets) that may be accepted in quick succession
+	// before the rate limit takes effect.  Defaults to the configured per-second rate.
+	// +kubebuilder:validation:Minimum=1
+	Burst *uint32 `json:"burst,omitempty" validate:"omitempty,gt=0"`
+	// Per selects whether the limit is shared by all traffic that matches the rule ("Rule") or
+	// whether each source IP address gets its own limit ("SourceIP").  Defaults to "Rule".
+	// +kubebuilder:validation:Enum=Rule;SourceIP
+	Per RuleRateLimitScope `json:"per,omitempty" validate:"omitempty,oneof=Rule SourceIP"`
 }
 
 // ICMPFields defines structure for ICMP and NotICMP sub-struct for ICMP code and type
@@ -154,6 +239,19 @@ type EntityRule struct {
 	// Ports and NotPorts can only be specified with Services on ingress rules.
 	Services *ServiceMatch `json:"services,omitempty" validate:"omitempty"`
 
+	// Domains is an optional field that restricts the rule to only apply to traffic that terminates
+	// at one of the given domains.  Each entry is either an exact domain name, such as
+	// "api.example.com", or a wildcard of the form "*.example.com", which matches any subdomain of
+	// example.com (but not example.com itself).
+	//
+	// Felix learns the IPs for each domain by snooping the DNS responses that are sent to local
+	// workloads, so the rule only matches IPs that have recently been returned by DNS.
+	//
+	// Domains can only be specified on the destination of egress rules, and cannot be specified
+	// on the same rule as Selector, NotSelector, NamespaceSelector, Nets, NotNets, Services or
+	// ServiceAccounts.
+	Domains []string `json:"domains,omitempty" validate:"omitempty,dive,domain"`
+
 	// Ports is an optional field that restricts the rule to only apply to traffic that has a
 	// source (destination) port that matches one of these ranges/values. This value is a
 	// list of integers or strings that represent ranges of ports.
@@ -217,6 +315,39 @@ const (
 	StagedActionIgnore StagedAction = "Ignore"
 )
 
+// PolicySchedule restricts the times at which a policy is in effect.  All the given criteria must
+// be satisfied for the policy to be in effect.
+type PolicySchedule struct {
+	// NotBefore, if set, is the time before which the policy is not in effect.
+	NotBefore *metav1.Time `json:"notBefore,omitempty" validate:"omitempty"`
+
+	// NotAfter, if set, is the time from which the policy is no longer in effect.  This is
+	// useful for temporary access that must expire on its own.
+	NotAfter *metav1.Time `json:"notAfter,omitempty" validate:"omitempty"`
+
+	// Windows, if set, restricts the policy to be in effect only during one of the given
+	// recurring windows.
+	Windows []PolicyScheduleWindow `json:"windows,omitempty" validate:"omitempty,dive"`
+}
+
+// PolicyScheduleWindow is a recurring window of time, such as a maintenance window.
+type PolicyScheduleWindow struct {
+	// Start is a cron expression, with the five fields "minute hour day-of-month month
+	// day-of-week", that gives the times at which the window opens.  For example, "0 2 * * 6"
+	// opens the window at 02:00 every Saturday.
+	Start string `json:"start" validate:"cron"`
+
+	// Duration is how long the window stays open each time it opens.  It must be at least one
+	// minute and no more than 31 days.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone in which Start is interpreted, for example
+	// "Europe/London".  [Default: UTC]
+	TimeZone string `json:"timeZone,omitempty" validate:"omitempty,timeZone"`
+}
+
 type RuleMetadata struct {
 	// Annotations is a set of key value pairs that give extra information about the rule
 	Annotations map[string]string `json:"annotations,omitempty"`
@@ -128,6 +128,11 @@ type StagedGlobalNetworkPolicySpec struct {
 	// If the policy is _not_ used on a particular node then the work
 	// done to preload the policy (and to maintain it) is wasted.
 	PerformanceHints []PolicyPerformanceHint `json:"performanceHints,omitempty" validate:"omitempty,unique,dive,oneof=AssumeNeededOnEveryNode"`
+
+	// Schedule optionally restricts the times at which the policy is in effect.  Outside its
+	// schedule, Felix treats the policy as if it did not exist.  If not set, the policy is always
+	// in effect.
+	Schedule *PolicySchedule `json:"schedule,omitempty" validate:"omitempty"`
 }
 
 // +genclient:nonNamespaced
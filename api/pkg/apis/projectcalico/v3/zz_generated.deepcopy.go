@@ -38,6 +38,42 @@ func (in *AutoHostEndpointConfig) DeepCopy() *AutoHostEndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfiguration) DeepCopyInto(out *BFDConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinRxInterval != nil {
+		in, out := &in.MinRxInterval, &out.MinRxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinTxInterval != nil {
+		in, out := &in.MinTxInterval, &out.MinTxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfiguration.
+func (in *BFDConfiguration) DeepCopy() *BFDConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPConfiguration) DeepCopyInto(out *BGPConfiguration) {
 	*out = *in
@@ -158,6 +194,11 @@ func (in *BGPConfigurationSpec) DeepCopyInto(out *BGPConfigurationSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -214,6 +255,69 @@ func (in *BGPFilter) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterASPathMatch) DeepCopyInto(out *BGPFilterASPathMatch) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = make([]numorstring.ASNumber, len(*in))
+		copy(*out, *in)
+	}
+	if in.Contains != nil {
+		in, out := &in.Contains, &out.Contains
+		*out = new(numorstring.ASNumber)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterASPathMatch.
+func (in *BGPFilterASPathMatch) DeepCopy() *BGPFilterASPathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterASPathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterAddCommunity) DeepCopyInto(out *BGPFilterAddCommunity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterAddCommunity.
+func (in *BGPFilterAddCommunity) DeepCopy() *BGPFilterAddCommunity {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterAddCommunity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterCommunityMatch) DeepCopyInto(out *BGPFilterCommunityMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]BGPCommunityValue, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterCommunityMatch.
+func (in *BGPFilterCommunityMatch) DeepCopy() *BGPFilterCommunityMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterCommunityMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPFilterList) DeepCopyInto(out *BGPFilterList) {
 	*out = *in
@@ -247,6 +351,47 @@ func (in *BGPFilterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterOperation) DeepCopyInto(out *BGPFilterOperation) {
+	*out = *in
+	if in.AddCommunity != nil {
+		in, out := &in.AddCommunity, &out.AddCommunity
+		*out = new(BGPFilterAddCommunity)
+		**out = **in
+	}
+	if in.SetCommunities != nil {
+		in, out := &in.SetCommunities, &out.SetCommunities
+		*out = new(BGPFilterSetCommunities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetLocalPreference != nil {
+		in, out := &in.SetLocalPreference, &out.SetLocalPreference
+		*out = new(BGPFilterSetLocalPreference)
+		**out = **in
+	}
+	if in.SetMED != nil {
+		in, out := &in.SetMED, &out.SetMED
+		*out = new(BGPFilterSetMED)
+		**out = **in
+	}
+	if in.PrependASPath != nil {
+		in, out := &in.PrependASPath, &out.PrependASPath
+		*out = new(BGPFilterPrependASPath)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterOperation.
+func (in *BGPFilterOperation) DeepCopy() *BGPFilterOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPFilterPrefixLengthV4) DeepCopyInto(out *BGPFilterPrefixLengthV4) {
 	*out = *in
@@ -299,6 +444,27 @@ func (in *BGPFilterPrefixLengthV6) DeepCopy() *BGPFilterPrefixLengthV6 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterPrependASPath) DeepCopyInto(out *BGPFilterPrependASPath) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = make([]numorstring.ASNumber, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterPrependASPath.
+func (in *BGPFilterPrependASPath) DeepCopy() *BGPFilterPrependASPath {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterPrependASPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPFilterRuleV4) DeepCopyInto(out *BGPFilterRuleV4) {
 	*out = *in
@@ -307,6 +473,23 @@ func (in *BGPFilterRuleV4) DeepCopyInto(out *BGPFilterRuleV4) {
 		*out = new(BGPFilterPrefixLengthV4)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = new(BGPFilterCommunityMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ASPath != nil {
+		in, out := &in.ASPath, &out.ASPath
+		*out = new(BGPFilterASPathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]BGPFilterOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -328,6 +511,23 @@ func (in *BGPFilterRuleV6) DeepCopyInto(out *BGPFilterRuleV6) {
 		*out = new(BGPFilterPrefixLengthV6)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = new(BGPFilterCommunityMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ASPath != nil {
+		in, out := &in.ASPath, &out.ASPath
+		*out = new(BGPFilterASPathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]BGPFilterOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -341,6 +541,59 @@ func (in *BGPFilterRuleV6) DeepCopy() *BGPFilterRuleV6 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSetCommunities) DeepCopyInto(out *BGPFilterSetCommunities) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]BGPCommunityValue, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSetCommunities.
+func (in *BGPFilterSetCommunities) DeepCopy() *BGPFilterSetCommunities {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSetCommunities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSetLocalPreference) DeepCopyInto(out *BGPFilterSetLocalPreference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSetLocalPreference.
+func (in *BGPFilterSetLocalPreference) DeepCopy() *BGPFilterSetLocalPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSetLocalPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSetMED) DeepCopyInto(out *BGPFilterSetMED) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSetMED.
+func (in *BGPFilterSetMED) DeepCopy() *BGPFilterSetMED {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSetMED)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPFilterSpec) DeepCopyInto(out *BGPFilterSpec) {
 	*out = *in
@@ -433,6 +686,27 @@ func (in *BGPPeer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerBFD) DeepCopyInto(out *BGPPeerBFD) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerBFD.
+func (in *BGPPeerBFD) DeepCopy() *BGPPeerBFD {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerBFD)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPeerList) DeepCopyInto(out *BGPPeerList) {
 	*out = *in
@@ -494,6 +768,11 @@ func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BGPPeerBFD)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -657,6 +936,48 @@ func (in *CalicoNodeAgentStatus) DeepCopy() *CalicoNodeAgentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNodeBFDSession) DeepCopyInto(out *CalicoNodeBFDSession) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeBFDSession.
+func (in *CalicoNodeBFDSession) DeepCopy() *CalicoNodeBFDSession {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNodeBFDSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNodeBFDStatus) DeepCopyInto(out *CalicoNodeBFDStatus) {
+	*out = *in
+	if in.SessionsV4 != nil {
+		in, out := &in.SessionsV4, &out.SessionsV4
+		*out = make([]CalicoNodeBFDSession, len(*in))
+		copy(*out, *in)
+	}
+	if in.SessionsV6 != nil {
+		in, out := &in.SessionsV6, &out.SessionsV6
+		*out = make([]CalicoNodeBFDSession, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeBFDStatus.
+func (in *CalicoNodeBFDStatus) DeepCopy() *CalicoNodeBFDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNodeBFDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNodeBGPRouteStatus) DeepCopyInto(out *CalicoNodeBGPRouteStatus) {
 	*out = *in
@@ -852,6 +1173,7 @@ func (in *CalicoNodeStatusStatus) DeepCopyInto(out *CalicoNodeStatusStatus) {
 	out.Agent = in.Agent
 	in.BGP.DeepCopyInto(&out.BGP)
 	in.Routes.DeepCopyInto(&out.Routes)
+	in.BFD.DeepCopyInto(&out.BFD)
 	return
 }
 
@@ -995,6 +1317,16 @@ func (in *ControllersConfig) DeepCopyInto(out *ControllersConfig) {
 		*out = new(LoadBalancerControllerConfig)
 		**out = **in
 	}
+	if in.RouteReflector != nil {
+		in, out := &in.RouteReflector, &out.RouteReflector
+		*out = new(RouteReflectorControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPPoolDrain != nil {
+		in, out := &in.IPPoolDrain, &out.IPPoolDrain
+		*out = new(IPPoolDrainControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1038,6 +1370,11 @@ func (in *EntityRule) DeepCopyInto(out *EntityRule) {
 		*out = new(ServiceMatch)
 		**out = **in
 	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]numorstring.Port, len(*in))
@@ -1329,6 +1666,21 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PrometheusRuleMetricsEnabled != nil {
+		in, out := &in.PrometheusRuleMetricsEnabled, &out.PrometheusRuleMetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrometheusRuleMetricsMaxRules != nil {
+		in, out := &in.PrometheusRuleMetricsMaxRules, &out.PrometheusRuleMetricsMaxRules
+		*out = new(int)
+		**out = **in
+	}
+	if in.PrometheusRuleMetricsRetention != nil {
+		in, out := &in.PrometheusRuleMetricsRetention, &out.PrometheusRuleMetricsRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.FailsafeInboundHostPorts != nil {
 		in, out := &in.FailsafeInboundHostPorts, &out.FailsafeInboundHostPorts
 		*out = new([]ProtoPort)
@@ -1633,6 +1985,55 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlowLogsFileEnabled != nil {
+		in, out := &in.FlowLogsFileEnabled, &out.FlowLogsFileEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsFileDirectory != nil {
+		in, out := &in.FlowLogsFileDirectory, &out.FlowLogsFileDirectory
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxFileSizeMB != nil {
+		in, out := &in.FlowLogsFileMaxFileSizeMB, &out.FlowLogsFileMaxFileSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxFiles != nil {
+		in, out := &in.FlowLogsFileMaxFiles, &out.FlowLogsFileMaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxAgeDays != nil {
+		in, out := &in.FlowLogsFileMaxAgeDays, &out.FlowLogsFileMaxAgeDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.DNSPolicyEnabled != nil {
+		in, out := &in.DNSPolicyEnabled, &out.DNSPolicyEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSTrustedServers != nil {
+		in, out := &in.DNSTrustedServers, &out.DNSTrustedServers
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.DNSCacheFile != nil {
+		in, out := &in.DNSCacheFile, &out.DNSCacheFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSExtraTTL != nil {
+		in, out := &in.DNSExtraTTL, &out.DNSExtraTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.RouteTableRanges != nil {
 		in, out := &in.RouteTableRanges, &out.RouteTableRanges
 		*out = new(RouteTableRanges)
@@ -1745,6 +2146,22 @@ func (in *FelixConfigurationSpec) DeepCopy() *FelixConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCMethodMatch) DeepCopyInto(out *GRPCMethodMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMethodMatch.
+func (in *GRPCMethodMatch) DeepCopy() *GRPCMethodMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCMethodMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalNetworkPolicy) DeepCopyInto(out *GlobalNetworkPolicy) {
 	*out = *in
@@ -1837,6 +2254,11 @@ func (in *GlobalNetworkPolicySpec) DeepCopyInto(out *GlobalNetworkPolicySpec) {
 		*out = make([]PolicyPerformanceHint, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(PolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1931,6 +2353,27 @@ func (in *GlobalNetworkSetSpec) DeepCopy() *GlobalNetworkSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	if in.Present != nil {
+		in, out := &in.Present, &out.Present
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPMatch) DeepCopyInto(out *HTTPMatch) {
 	*out = *in
@@ -1944,6 +2387,23 @@ func (in *HTTPMatch) DeepCopyInto(out *HTTPMatch) {
 		*out = make([]HTTPPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCMethods != nil {
+		in, out := &in.GRPCMethods, &out.GRPCMethods
+		*out = make([]GRPCMethodMatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2112,7 +2572,7 @@ func (in *IPAMConfiguration) DeepCopyInto(out *IPAMConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
@@ -2170,6 +2630,13 @@ func (in *IPAMConfigurationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMConfigurationSpec) DeepCopyInto(out *IPAMConfigurationSpec) {
 	*out = *in
+	if in.IPQuotas != nil {
+		in, out := &in.IPQuotas, &out.IPQuotas
+		*out = make([]IPQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2205,6 +2672,11 @@ func (in *IPPool) DeepCopyInto(out *IPPool) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(IPPoolStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2226,6 +2698,78 @@ func (in *IPPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolDrain) DeepCopyInto(out *IPPoolDrain) {
+	*out = *in
+	if in.EvictionBatchSize != nil {
+		in, out := &in.EvictionBatchSize, &out.EvictionBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.EvictionInterval != nil {
+		in, out := &in.EvictionInterval, &out.EvictionInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolDrain.
+func (in *IPPoolDrain) DeepCopy() *IPPoolDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolDrainControllerConfig) DeepCopyInto(out *IPPoolDrainControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolDrainControllerConfig.
+func (in *IPPoolDrainControllerConfig) DeepCopy() *IPPoolDrainControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolDrainControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolDrainStatus) DeepCopyInto(out *IPPoolDrainStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]IPPoolNamespaceAllocations, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastEviction != nil {
+		in, out := &in.LastEviction, &out.LastEviction
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolDrainStatus.
+func (in *IPPoolDrainStatus) DeepCopy() *IPPoolDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
 	*out = *in
@@ -2259,6 +2803,22 @@ func (in *IPPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolNamespaceAllocations) DeepCopyInto(out *IPPoolNamespaceAllocations) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolNamespaceAllocations.
+func (in *IPPoolNamespaceAllocations) DeepCopy() *IPPoolNamespaceAllocations {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolNamespaceAllocations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 	*out = *in
@@ -2277,6 +2837,16 @@ func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 		*out = new(AssignmentMode)
 		**out = **in
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(IPPoolDrain)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topology != nil {
+		in, out := &in.Topology, &out.Topology
+		*out = new(IPPoolTopology)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2290,6 +2860,85 @@ func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(IPPoolDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
+func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolTopology) DeepCopyInto(out *IPPoolTopology) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]IPPoolTopologyRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolTopology.
+func (in *IPPoolTopology) DeepCopy() *IPPoolTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolTopologyRange) DeepCopyInto(out *IPPoolTopologyRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolTopologyRange.
+func (in *IPPoolTopologyRange) DeepCopy() *IPPoolTopologyRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolTopologyRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPQuota) DeepCopyInto(out *IPQuota) {
+	*out = *in
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPQuota.
+func (in *IPQuota) DeepCopy() *IPQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(IPQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPReservation) DeepCopyInto(out *IPReservation) {
 	*out = *in
@@ -2617,6 +3266,11 @@ func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 		*out = make([]PolicyPerformanceHint, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(PolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2763,6 +3417,52 @@ func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySchedule) DeepCopyInto(out *PolicySchedule) {
+	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PolicyScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySchedule.
+func (in *PolicySchedule) DeepCopy() *PolicySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyScheduleWindow) DeepCopyInto(out *PolicyScheduleWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyScheduleWindow.
+func (in *PolicyScheduleWindow) DeepCopy() *PolicyScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixAdvertisement) DeepCopyInto(out *PrefixAdvertisement) {
 	*out = *in
@@ -2897,6 +3597,27 @@ func (in *ProtoPort) DeepCopy() *ProtoPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteReflectorControllerConfig) DeepCopyInto(out *RouteReflectorControllerConfig) {
+	*out = *in
+	if in.ReflectorsPerZone != nil {
+		in, out := &in.ReflectorsPerZone, &out.ReflectorsPerZone
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteReflectorControllerConfig.
+func (in *RouteReflectorControllerConfig) DeepCopy() *RouteReflectorControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteReflectorControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTableIDRange) DeepCopyInto(out *RouteTableIDRange) {
 	*out = *in
@@ -2984,6 +3705,11 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 		*out = new(HTTPMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RuleRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = new(RuleMetadata)
@@ -3025,6 +3751,37 @@ func (in *RuleMetadata) DeepCopy() *RuleMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleRateLimit) DeepCopyInto(out *RuleRateLimit) {
+	*out = *in
+	if in.ConnectionsPerSecond != nil {
+		in, out := &in.ConnectionsPerSecond, &out.ConnectionsPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PacketsPerSecond != nil {
+		in, out := &in.PacketsPerSecond, &out.PacketsPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleRateLimit.
+func (in *RuleRateLimit) DeepCopy() *RuleRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountControllerConfig) DeepCopyInto(out *ServiceAccountControllerConfig) {
 	*out = *in
@@ -3223,6 +3980,11 @@ func (in *StagedGlobalNetworkPolicySpec) DeepCopyInto(out *StagedGlobalNetworkPo
 		*out = make([]PolicyPerformanceHint, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(PolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3424,6 +4186,11 @@ func (in *StagedNetworkPolicySpec) DeepCopyInto(out *StagedNetworkPolicySpec) {
 		*out = make([]PolicyPerformanceHint, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(PolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -19,19 +19,29 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.AutoHostEndpointConfig":             schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration":                   schema_pkg_apis_projectcalico_v3_BFDConfiguration(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfiguration":                   schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationList":               schema_pkg_apis_projectcalico_v3_BGPConfigurationList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationSpec":               schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPDaemonStatus":                    schema_pkg_apis_projectcalico_v3_BGPDaemonStatus(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilter":                          schema_pkg_apis_projectcalico_v3_BGPFilter(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterASPathMatch":               schema_pkg_apis_projectcalico_v3_BGPFilterASPathMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterAddCommunity":              schema_pkg_apis_projectcalico_v3_BGPFilterAddCommunity(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterCommunityMatch":            schema_pkg_apis_projectcalico_v3_BGPFilterCommunityMatch(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterList":                      schema_pkg_apis_projectcalico_v3_BGPFilterList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterOperation":                 schema_pkg_apis_projectcalico_v3_BGPFilterOperation(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV4":            schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV4(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV6":            schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV6(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrependASPath":             schema_pkg_apis_projectcalico_v3_BGPFilterPrependASPath(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV4":                    schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV6":                    schema_pkg_apis_projectcalico_v3_BGPFilterRuleV6(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetCommunities":            schema_pkg_apis_projectcalico_v3_BGPFilterSetCommunities(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetLocalPreference":        schema_pkg_apis_projectcalico_v3_BGPFilterSetLocalPreference(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetMED":                    schema_pkg_apis_projectcalico_v3_BGPFilterSetMED(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSpec":                      schema_pkg_apis_projectcalico_v3_BGPFilterSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword":                        schema_pkg_apis_projectcalico_v3_BGPPassword(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeer":                            schema_pkg_apis_projectcalico_v3_BGPPeer(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerBFD":                         schema_pkg_apis_projectcalico_v3_BGPPeerBFD(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerList":                        schema_pkg_apis_projectcalico_v3_BGPPeerList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerSpec":                        schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BPFConntrackTimeouts":               schema_pkg_apis_projectcalico_v3_BPFConntrackTimeouts(ref),
@@ -39,6 +49,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinityList":                  schema_pkg_apis_projectcalico_v3_BlockAffinityList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinitySpec":                  schema_pkg_apis_projectcalico_v3_BlockAffinitySpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeAgentStatus":              schema_pkg_apis_projectcalico_v3_CalicoNodeAgentStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession":               schema_pkg_apis_projectcalico_v3_CalicoNodeBFDSession(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDStatus":                schema_pkg_apis_projectcalico_v3_CalicoNodeBFDStatus(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus":           schema_pkg_apis_projectcalico_v3_CalicoNodeBGPRouteStatus(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPStatus":                schema_pkg_apis_projectcalico_v3_CalicoNodeBGPStatus(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodePeer":                     schema_pkg_apis_projectcalico_v3_CalicoNodePeer(ref),
@@ -58,12 +70,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfiguration":                 schema_pkg_apis_projectcalico_v3_FelixConfiguration(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationList":             schema_pkg_apis_projectcalico_v3_FelixConfigurationList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationSpec":             schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMethodMatch":                    schema_pkg_apis_projectcalico_v3_GRPCMethodMatch(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicy":                schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicy(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicyList":            schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicyList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicySpec":            schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicySpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSet":                   schema_pkg_apis_projectcalico_v3_GlobalNetworkSet(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetList":               schema_pkg_apis_projectcalico_v3_GlobalNetworkSetList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetSpec":               schema_pkg_apis_projectcalico_v3_GlobalNetworkSetSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch":                    schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch":                          schema_pkg_apis_projectcalico_v3_HTTPMatch(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath":                           schema_pkg_apis_projectcalico_v3_HTTPPath(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HealthTimeoutOverride":              schema_pkg_apis_projectcalico_v3_HealthTimeoutOverride(ref),
@@ -76,8 +90,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfigurationSpec":              schema_pkg_apis_projectcalico_v3_IPAMConfigurationSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration":                  schema_pkg_apis_projectcalico_v3_IPIPConfiguration(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool":                             schema_pkg_apis_projectcalico_v3_IPPool(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrain":                        schema_pkg_apis_projectcalico_v3_IPPoolDrain(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainControllerConfig":        schema_pkg_apis_projectcalico_v3_IPPoolDrainControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainStatus":                  schema_pkg_apis_projectcalico_v3_IPPoolDrainStatus(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolList":                         schema_pkg_apis_projectcalico_v3_IPPoolList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolNamespaceAllocations":         schema_pkg_apis_projectcalico_v3_IPPoolNamespaceAllocations(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec":                         schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolStatus":                       schema_pkg_apis_projectcalico_v3_IPPoolStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopology":                     schema_pkg_apis_projectcalico_v3_IPPoolTopology(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopologyRange":                schema_pkg_apis_projectcalico_v3_IPPoolTopologyRange(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPQuota":                            schema_pkg_apis_projectcalico_v3_IPQuota(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservation":                      schema_pkg_apis_projectcalico_v3_IPReservation(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationList":                  schema_pkg_apis_projectcalico_v3_IPReservationList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationSpec":                  schema_pkg_apis_projectcalico_v3_IPReservationSpec(ref),
@@ -95,15 +117,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSetSpec":                     schema_pkg_apis_projectcalico_v3_NetworkSetSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig":               schema_pkg_apis_projectcalico_v3_NodeControllerConfig(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig":             schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule":                     schema_pkg_apis_projectcalico_v3_PolicySchedule(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyScheduleWindow":               schema_pkg_apis_projectcalico_v3_PolicyScheduleWindow(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement":                schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Profile":                            schema_pkg_apis_projectcalico_v3_Profile(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileList":                        schema_pkg_apis_projectcalico_v3_ProfileList(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileSpec":                        schema_pkg_apis_projectcalico_v3_ProfileSpec(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProtoPort":                          schema_pkg_apis_projectcalico_v3_ProtoPort(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteReflectorControllerConfig":     schema_pkg_apis_projectcalico_v3_RouteReflectorControllerConfig(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableIDRange":                  schema_pkg_apis_projectcalico_v3_RouteTableIDRange(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableRange":                    schema_pkg_apis_projectcalico_v3_RouteTableRange(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule":                               schema_pkg_apis_projectcalico_v3_Rule(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata":                       schema_pkg_apis_projectcalico_v3_RuleMetadata(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleRateLimit":                      schema_pkg_apis_projectcalico_v3_RuleRateLimit(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig":     schema_pkg_apis_projectcalico_v3_ServiceAccountControllerConfig(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountMatch":                schema_pkg_apis_projectcalico_v3_ServiceAccountMatch(ref),
 		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock":              schema_pkg_apis_projectcalico_v3_ServiceClusterIPBlock(ref),
@@ -481,6 +507,47 @@ func schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BFDConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BFDConfiguration contains the Bidirectional Forwarding Detection (BFD) settings used to detect the failure of a BGP session faster than the BGP hold timer allows.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled sets whether BFD is used for BGP sessions. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minRxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinRxInterval is the minimum interval between received BFD control packets that this node is able to support. [Default: 10ms]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"minTxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinTxInterval is the desired minimum interval between BFD control packets sent by this node. [Default: 100ms]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"multiplier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiplier is the number of consecutive BFD control packets that may be missed before the session is declared down. [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -723,11 +790,17 @@ func schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"bfd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFD configures Bidirectional Forwarding Detection for the BGP sessions of the node, including the node-to-node mesh.  Whether BFD is enabled can be overridden for individual BGPPeers.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -819,6 +892,88 @@ func schema_pkg_apis_projectcalico_v3_BGPFilter(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BGPFilterASPathMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is a sequence of AS numbers that the AS path of the route must begin with, starting with the AS of the neighbor that advertised the route.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"contains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains is an AS number that must appear somewhere in the AS path of the route.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterAddCommunity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterCommunityMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values is the set of communities that a route must carry, all of which must be present for the route to match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"values"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPFilterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -868,6 +1023,51 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterList(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BGPFilterOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPFilterOperation is a single modification of the attributes of a route. Exactly one field must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addCommunity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddCommunity adds a community to the route, in addition to any communities it already carries.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterAddCommunity"),
+						},
+					},
+					"setCommunities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetCommunities removes all existing standard and large communities from the route and replaces them with the given set.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetCommunities"),
+						},
+					},
+					"setLocalPreference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetLocalPreference sets the BGP LOCAL_PREF attribute of the route.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetLocalPreference"),
+						},
+					},
+					"setMED": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetMED sets the BGP MULTI_EXIT_DISC attribute of the route.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetMED"),
+						},
+					},
+					"prependASPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrependASPath prepends the given AS numbers to the AS path of the route.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrependASPath"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterAddCommunity", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrependASPath", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetCommunities", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetLocalPreference", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSetMED"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV4(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -916,6 +1116,34 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV6(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BGPFilterPrependASPath(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the sequence of AS numbers to prepend. The first AS number in the list ends up first in the resulting AS path.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"prefix"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -952,6 +1180,18 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"communities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the rule only matches routes that carry all of the given BGP communities.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterCommunityMatch"),
+						},
+					},
+					"asPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the rule only matches routes whose AS path satisfies the given match.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterASPathMatch"),
+						},
+					},
 					"action": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -959,12 +1199,26 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref common.ReferenceCallba
 							Format:  "",
 						},
 					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations is an ordered list of route attribute modifications that are applied to matching routes before they are accepted. Operations may only be used with the Accept action.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterOperation"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV4"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterASPathMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterCommunityMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterOperation", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV4"},
 	}
 }
 
@@ -1004,6 +1258,18 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterRuleV6(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"communities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the rule only matches routes that carry all of the given BGP communities.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterCommunityMatch"),
+						},
+					},
+					"asPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the rule only matches routes whose AS path satisfies the given match.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterASPathMatch"),
+						},
+					},
 					"action": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -1011,12 +1277,92 @@ func schema_pkg_apis_projectcalico_v3_BGPFilterRuleV6(ref common.ReferenceCallba
 							Format:  "",
 						},
 					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations is an ordered list of route attribute modifications that are applied to matching routes before they are accepted. Operations may only be used with the Accept action.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterOperation"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV6"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterASPathMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterCommunityMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterOperation", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV6"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterSetCommunities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterSetLocalPreference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPFilterSetMED(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"value"},
+			},
+		},
 	}
 }
 
@@ -1152,7 +1498,27 @@ func schema_pkg_apis_projectcalico_v3_BGPPeer(ref common.ReferenceCallback) comm
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_BGPPeerBFD(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BGPPeerBFD contains the Bidirectional Forwarding Detection settings of a BGPPeer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled sets whether BFD is used for the peerings generated by this BGPPeer resource.  When not set, BFD is used if it is enabled in the BGPConfiguration of the node.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_BGPPeerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1312,11 +1678,17 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"bfd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFD overrides whether Bidirectional Forwarding Detection is used for the peerings generated by this BGPPeer resource.  BFD timers are taken from the BGPConfiguration of the node.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerBFD"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerBFD", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1554,6 +1926,90 @@ func schema_pkg_apis_projectcalico_v3_CalicoNodeAgentStatus(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_CalicoNodeBFDSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalicoNodeBFDSession contains the status of a BFD session on the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"peerIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP address of the peer at the other end of the BFD session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interface the session runs over, empty for multihop sessions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the BFD session state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"since": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Since the state last changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_CalicoNodeBFDStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalicoNodeBFDStatus defines the observed state of BFD sessions on the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sessionsV4": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionsV4 represents IPv4 BFD sessions on the node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession"),
+									},
+								},
+							},
+						},
+					},
+					"sessionsV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionsV6 represents IPv6 BFD sessions on the node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_CalicoNodeBGPRouteStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1962,11 +2418,18 @@ func schema_pkg_apis_projectcalico_v3_CalicoNodeStatusStatus(ref common.Referenc
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus"),
 						},
 					},
+					"bfd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFD holds node BFD session status.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeAgentStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeAgentStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2177,11 +2640,23 @@ func schema_pkg_apis_projectcalico_v3_ControllersConfig(ref common.ReferenceCall
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig"),
 						},
 					},
+					"routeReflector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RouteReflector enables and configures the route reflector controller. Disabled by default, set to nil to disable.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteReflectorControllerConfig"),
+						},
+					},
+					"ipPoolDrain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPPoolDrain enables and configures the IP pool drain controller. Disabled by default, set to nil to disable.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainControllerConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteReflectorControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig"},
 	}
 }
 
@@ -2261,6 +2736,21 @@ func schema_pkg_apis_projectcalico_v3_EntityRule(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceMatch"),
 						},
 					},
+					"domains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domains is an optional field that restricts the rule to only apply to traffic that terminates at one of the given domains.  Each entry is either an exact domain name, such as \"api.example.com\", or a wildcard of the form \"*.example.com\", which matches any subdomain of example.com (but not example.com itself).\n\nFelix learns the IPs for each domain by snooping the DNS responses that are sent to local workloads, so the rule only matches IPs that have recently been returned by DNS.\n\nDomains can only be specified on the destination of egress rules, and cannot be specified on the same rule as Selector, NotSelector, NamespaceSelector, Nets, NotNets, Services or ServiceAccounts.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"ports": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Ports is an optional field that restricts the rule to only apply to traffic that has a source (destination) port that matches one of these ranges/values. This value is a list of integers or strings that represent ranges of ports.\n\nSince only some protocols have ports, if any ports are specified it requires the Protocol match in the Rule to be set to \"TCP\" or \"UDP\".",
@@ -2833,6 +3323,26 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"prometheusRuleMetricsEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusRuleMetricsEnabled enables Prometheus counters of the packets, bytes and connections that match each policy rule, as seen by Felix's flow collector. Requires PrometheusMetricsEnabled. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"prometheusRuleMetricsMaxRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusRuleMetricsMaxRules limits the number of policy rules that metrics are reported for, bounding the number of series exported. Traffic that matches further rules is not counted until existing series expire. [Default: 1000]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"prometheusRuleMetricsRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusRuleMetricsRetention is how long the metrics for a policy rule are reported after the rule last matched traffic. [Default: 10m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"failsafeInboundHostPorts": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FailsafeInboundHostPorts is a list of ProtoPort struct objects including UDP/TCP/SCTP ports and CIDRs that Felix will allow incoming traffic to host endpoints on irrespective of the security policy. This is useful to avoid accidentally cutting off a host with incorrect configuration. For backwards compatibility, if the protocol is not specified, it defaults to \"tcp\". If a CIDR is not specified, it will allow traffic from all addresses. To disable all inbound host ports, use the value \"[]\". The default value allows ssh access, DHCP, BGP, etcd and the Kubernetes API. [Default: tcp:22, udp:68, tcp:179, tcp:2379, tcp:2380, tcp:5473, tcp:6443, tcp:6666, tcp:6667 ]",
@@ -3403,6 +3913,76 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"flowLogsFileEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileEnabled, when set to true, enables writing flow logs to rotating files on each node, as JSON lines. This is independent of FlowLogsGoldmaneServer, and allows per-node flow logs to be collected where Goldmane is not deployed. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileDirectory is the directory in which flow log files are written. [Default: /var/log/calico/flowlogs]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flowLogsFileMaxFileSizeMB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxFileSizeMB is the maximum size in MB of a flow log file before it is rotated. [Default: 100]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxFiles is the number of rotated flow log files to retain, in addition to the file currently being written. [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flowLogsFileMaxAgeDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlowLogsFileMaxAgeDays is the number of days to retain rotated flow log files for. Files older than this are removed even if there are fewer than FlowLogsFileMaxFiles of them. Set to 0 to remove files based only on FlowLogsFileMaxFiles. [Default: 7]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dnsPolicyEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSPolicyEnabled controls whether Felix snoops DNS responses to local workloads, so that it can enforce policy rules that match on destination domains. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dnsTrustedServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSTrustedServers is the list of DNS servers whose responses Felix trusts when learning the IPs for domains in policy. Each entry is an IP, optionally with a port (for example \"10.0.0.10:5353\" or \"[fd00::10]:53\"), or a Kubernetes service of the form \"k8s-service:<namespace>/<name>\". If empty, no responses are trusted. [Default: empty]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dnsCacheFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSCacheFile is the file in which Felix persists the IPs that it has learned for domains, so that they survive a restart. [Default: /var/run/calico/felix-dns-cache.json]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsExtraTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSExtraTTL is extra time to keep IPs learned from DNS, on top of the TTL in the DNS response, to allow for clients that use an IP slightly after its TTL has expired. [Default: 0s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"bpfProfiling": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BPFProfiling controls profiling of BPF programs. At the monent, it can be Disabled or Enabled. [Default: Disabled]",
@@ -3598,6 +4178,35 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_GRPCMethodMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCMethodMatch specifies a gRPC method to match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service is the fully-qualified name of the gRPC service, e.g. \"helloworld.Greeter\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is an optional field that restricts the match to a single method of the service, e.g. \"SayHello\".  If omitted, all methods of the service match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"service"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3807,11 +4416,17 @@ func schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicySpec(ref common.Referen
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally restricts the times at which the policy is in effect.  Outside its schedule, Felix treats the policy as if it did not exist.  If not set, the policy is always in effect.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
@@ -3932,11 +4547,57 @@ func schema_pkg_apis_projectcalico_v3_GlobalNetworkSetSpec(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch specifies an HTTP request header to match. Exactly one of the following must be set: exact: <value>: which matches the header value exactly prefix: <value-prefix>: which matches the header value prefix regex: <regex>: which matches the whole header value against an RE2 regular expression present: <bool>: which matches if the header is present (true) or absent (false)",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match.  Header names are case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exact": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"present": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods and Path fields are joined with AND",
+				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods, Paths, Headers, Hosts and GRPCMethods fields are joined with AND",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"methods": {
@@ -3968,17 +4629,60 @@ func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) co
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath"},
-	}
-}
-
-func schema_pkg_apis_projectcalico_v3_HTTPPath(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is an optional field that restricts the rule to apply to HTTP requests with headers that match all of the listed header matches. Multiple headers are AND'd together. e.g: - name: X-Tenant\n  exact: a\n- name: Authorization\n  present: true",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"hosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hosts is an optional field that restricts the rule to apply to HTTP requests for one of the listed hosts, as given by the :authority (or Host) header.  Any port in the request's host is ignored. A leading \"*.\" matches any subdomain, e.g. \"*.example.com\". Multiple hosts are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"grpcMethods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPCMethods is an optional field that restricts the rule to apply to gRPC requests for one of the listed services or methods. Multiple methods are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMethodMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMethodMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_HTTPPath(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Description: "HTTPPath specifies an HTTP path to match. It may be either of the form: exact: <path>: which matches the path exactly or prefix: <path-prefix>: which matches the path prefix",
 				Type:        []string{"object"},
@@ -4329,10 +5033,26 @@ func schema_pkg_apis_projectcalico_v3_IPAMConfigurationSpec(ref common.Reference
 							Format:      "int32",
 						},
 					},
+					"ipQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPQuotas limits the number of addresses that Calico IPAM allocates to pods in the selected namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPQuota"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"strictAffinity"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPQuota"},
 	}
 }
 
@@ -4377,160 +5097,456 @@ func schema_pkg_apis_projectcalico_v3_IPPool(ref common.ReferenceCallback) commo
 					},
 					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the IPPool.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolDrain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolDrain contains the configuration for draining an IP pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"evictPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictPods enables eviction of pods that still hold addresses from the pool, so that they are recreated with addresses from another pool. Evictions honour PodDisruptionBudgets. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"evictionBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionBatchSize is the maximum number of pods to evict in each batch. [Default: 10]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionInterval is the minimum time between eviction batches. [Default: 1m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolDrainControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolDrainControllerConfig configures the IP pool drain controller, which migrates workloads off IP pools that have drain set. It releases empty blocks, reports the remaining allocations in the pool status and, if requested by the pool, evicts pods that still hold addresses from it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reconcilerPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilerPeriod is the period to perform reconciliation of draining IP pools. [Default: 1m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolDrainStatus reports the addresses that remain allocated from a draining IP pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"blocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blocks is the number of allocation blocks remaining in the pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"allocatedIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllocatedIPs is the number of addresses still allocated from the pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces lists the namespaces that have pods still holding addresses from the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolNamespaceAllocations"),
+									},
+								},
+							},
+						},
+					},
+					"lastEviction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastEviction is the time at which the last batch of pods was evicted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"blocks", "allocatedIPs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolNamespaceAllocations", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolList contains a list of IPPool resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolNamespaceAllocations(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolNamespaceAllocations reports the number of addresses held by pods in a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"allocatedIPs": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"namespace", "allocatedIPs"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolSpec contains the specification for an IPPool resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pool CIDR.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vxlanMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains configuration for VXLAN tunneling for this pool. If not specified, then this is defaulted to \"Never\" (i.e. VXLAN tunneling is disabled).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipipMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains configuration for IPIP tunneling for this pool. If not specified, then this is defaulted to \"Never\" (i.e. IPIP tunneling is disabled).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"natOutgoing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When natOutgoing is true, packets sent from Calico networked containers in this pool to destinations outside of this pool will be masqueraded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When disabled is true, Calico IPAM will not assign addresses from this pool.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disableBGPExport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable exporting routes from this IP Pool's CIDR over BGP. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"blockSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The block size to use for IP address assignments from this pool. Defaults to 26 for IPv4 and 122 for IPv6.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allows IPPool to allocate for a specific node by label selector.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration"),
+						},
+					},
+					"nat-outgoing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowedUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedUse controls what the IP pool will be used for.  If not specified or empty, defaults to [\"Tunnel\", \"Workload\"] for back-compatibility",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"assignmentMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Determines the mode how IP addresses should be assigned from this pool",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"drain": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Drain requests that workloads are migrated off this pool. The pool must also be disabled so that no new addresses are assigned from it. Draining is carried out by the IP pool drain controller in calico-kube-controllers, which releases empty blocks, reports the remaining allocations in the pool status and, if enabled, evicts pods that still hold addresses from the pool.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrain"),
 						},
 					},
-					"spec": {
+					"topology": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec"),
+							Description: "Topology splits the pool into sub-ranges for different topology domains, such as zones or racks, so that the addresses used within each domain stay contiguous. Calico IPAM only claims blocks for a node from the range for the node's domain. Nodes in a domain that has no range claim blocks from the rest of the pool.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopology"),
 						},
 					},
 				},
+				Required: []string{"cidr"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrain", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopology"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_IPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_IPPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPPoolList contains a list of IPPool resources.",
+				Description: "IPPoolStatus contains the status of an IP pool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"drain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Drain reports the progress of draining the pool. It is only set while the pool is being drained.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainStatus"),
 						},
 					},
-					"apiVersion": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolDrainStatus"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolTopology contains the topology-aware block allocation configuration of an IP pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Key is the node label that identifies a node's topology domain, for example topology.kubernetes.io/zone or a rack label.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"ranges": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Ranges assigns a sub-range of the pool to each topology domain.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool"),
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopologyRange"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"key", "ranges"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolTopologyRange"},
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_IPPoolTopologyRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPPoolSpec contains the specification for an IPPool resource.",
+				Description: "IPPoolTopologyRange assigns a sub-range of an IP pool to a topology domain.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cidr": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The pool CIDR.",
+							Description: "Value is the value of the topology label on the nodes in the domain.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"vxlanMode": {
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains configuration for VXLAN tunneling for this pool. If not specified, then this is defaulted to \"Never\" (i.e. VXLAN tunneling is disabled).",
+							Description: "CIDR is the sub-range of the pool that the domain's blocks are taken from. It must be within the pool CIDR and be at least as large as a block.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipipMode": {
+				},
+				Required: []string{"value", "cidr"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPQuota limits the number of addresses allocated to pods in a set of namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains configuration for IPIP tunneling for this pool. If not specified, then this is defaulted to \"Never\" (i.e. IPIP tunneling is disabled).",
+							Description: "Name identifies the quota in assignment errors and utilization reports.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"natOutgoing": {
-						SchemaProps: spec.SchemaProps{
-							Description: "When natOutgoing is true, packets sent from Calico networked containers in this pool to destinations outside of this pool will be masqueraded.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"disabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "When disabled is true, Calico IPAM will not assign addresses from this pool.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"disableBGPExport": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Disable exporting routes from this IP Pool's CIDR over BGP. [Default: false]",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"blockSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The block size to use for IP address assignments from this pool. Defaults to 26 for IPv4 and 122 for IPv6.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"nodeSelector": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allows IPPool to allocate for a specific node by label selector.",
+							Description: "NamespaceSelector selects the namespaces that the quota applies to, using the namespace labels.  The projectcalico.org/name label can be used to select a namespace by name.  If not specified, the quota applies to all namespaces.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ipip": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
-							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration"),
-						},
-					},
-					"nat-outgoing": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: this field is only used for APIv1 backwards compatibility. Setting this field is not allowed, this field is for internal use only.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"allowedUses": {
+					"ipPools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowedUse controls what the IP pool will be used for.  If not specified or empty, defaults to [\"Tunnel\", \"Workload\"] for back-compatibility",
+							Description: "IPPools lists the names of the IP pools that the quota covers.  Only addresses allocated from these pools count towards the quota.  If not specified, the quota covers all IP pools.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4543,19 +5559,25 @@ func schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"assignmentMode": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Determines the mode how IP addresses should be assigned from this pool",
+							Description: "Scope controls whether the limit applies to each selected namespace separately (PerNamespace), or to all of the selected namespaces together (Shared). [Default: PerNamespace]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"maxIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIPs is the maximum number of addresses that may be allocated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"cidr"},
+				Required: []string{"name", "maxIPs"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration"},
 	}
 }
 
@@ -5087,11 +6109,17 @@ func schema_pkg_apis_projectcalico_v3_NetworkPolicySpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally restricts the times at which the policy is in effect.  Outside its schedule, Felix treats the policy as if it did not exist.  If not set, the policy is always in effect.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
@@ -5273,6 +6301,84 @@ func schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_PolicySchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicySchedule restricts the times at which a policy is in effect.  All the given criteria must be satisfied for the policy to be in effect.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"notBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotBefore, if set, is the time before which the policy is not in effect.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotAfter, if set, is the time from which the policy is no longer in effect.  This is useful for temporary access that must expire on its own.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows, if set, restricts the policy to be in effect only during one of the given recurring windows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyScheduleWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyScheduleWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PolicyScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyScheduleWindow is a recurring window of time, such as a maintenance window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression, with the five fields \"minute hour day-of-month month day-of-week\", that gives the times at which the window opens.  For example, \"0 2 * * 6\" opens the window at 02:00 every Saturday.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open each time it opens.  It must be at least one minute and no more than 31 days.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which Start is interpreted, for example \"Europe/London\".  [Default: UTC]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5489,6 +6595,40 @@ func schema_pkg_apis_projectcalico_v3_ProtoPort(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_RouteReflectorControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteReflectorControllerConfig configures the route reflector controller, which keeps a target number of route reflectors in each zone, sets their route reflector cluster ID, and maintains the BGPPeers that connect them to each other and to the other nodes in their zone. The node-to-node mesh should be disabled when this controller is in use.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes that may be chosen as route reflectors. [Default: all()]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneLabel is the node label used to group nodes into zones or racks. Each zone has its own route reflectors, and nodes only peer with the route reflectors in their zone. Nodes without the label form a zone of their own. [Default: topology.kubernetes.io/zone]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reflectorsPerZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReflectorsPerZone is the number of route reflectors to maintain in each zone. [Default: 3]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_RouteTableIDRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5608,6 +6748,12 @@ func schema_pkg_apis_projectcalico_v3_Rule(ref common.ReferenceCallback) common.
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch"),
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit is an optional field that limits the rate of traffic that matches the rule.  Traffic that matches the rule but exceeds the limit is dropped; traffic that is within the limit is given the rule's Action.  RateLimit may only be used with the Allow and Pass actions.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleRateLimit"),
+						},
+					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metadata contains additional information for this rule",
@@ -5619,7 +6765,7 @@ func schema_pkg_apis_projectcalico_v3_Rule(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata", "github.com/projectcalico/api/pkg/lib/numorstring.Protocol"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleRateLimit", "github.com/projectcalico/api/pkg/lib/numorstring.Protocol"},
 	}
 }
 
@@ -5651,6 +6797,47 @@ func schema_pkg_apis_projectcalico_v3_RuleMetadata(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit limits the rate at which traffic is allowed to match a rule.  At least one of ConnectionsPerSecond and PacketsPerSecond must be specified; if both are specified then traffic must be within both limits.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"connectionsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionsPerSecond is the maximum rate at which new connections that match the rule are accepted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"packetsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketsPerSecond is the maximum rate at which packets that match the rule are accepted.\n\nPolicy is only evaluated for packets that don't belong to an established flow; in all dataplanes, packets of established and related flows are accepted before policy.  So this is a limit on the packets that start new flows, much like ConnectionsPerSecond, and it can't be used to limit the bandwidth of an established flow.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of connections (or packets) that may be accepted in quick succession before the rate limit takes effect.  Defaults to the configured per-second rate.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"per": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Per selects whether the limit is shared by all traffic that matches the rule (\"Rule\") or whether each source IP address gets its own limit (\"SourceIP\").  Defaults to \"Rule\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_ServiceAccountControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6008,11 +7195,17 @@ func schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicySpec(ref common.R
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally restricts the times at which the policy is in effect.  Outside its schedule, Felix treats the policy as if it did not exist.  If not set, the policy is always in effect.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
@@ -6371,11 +7564,17 @@ func schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref common.Referen
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally restricts the times at which the policy is in effect.  Outside its schedule, Felix treats the policy as if it did not exist.  If not set, the policy is always in effect.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicySchedule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule"},
 	}
 }
 
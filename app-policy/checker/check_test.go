@@ -525,6 +525,67 @@ func TestCheckStorePolicyMatch(t *testing.T) {
 	Expect(status.Code).To(Equal(PERMISSION_DENIED))
 }
 
+// Header and host matches should be evaluated against the request.
+func TestCheckStorePolicyHeaderMatch(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{
+			{
+				Name:            "tier1",
+				IngressPolicies: []string{"policy1"},
+				DefaultAction:   "Deny",
+			},
+		},
+	}
+	store.PolicyByID[types.ProtoToPolicyID(&proto.PolicyID{Tier: "tier1", Name: "policy1"})] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{
+				Action: "allow",
+				HttpMatch: &proto.HTTPMatch{
+					Headers: []*proto.HTTPMatch_HeaderMatch{
+						{Name: "x-tenant", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "a"}},
+					},
+					Hosts: []string{"tenant-a.example.com"},
+				},
+			},
+		},
+	}
+
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/steve",
+		},
+		Destination: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/sally",
+		},
+		Request: &authz.AttributeContext_Request{
+			Http: &authz.AttributeContext_HttpRequest{
+				Method:  "GET",
+				Host:    "tenant-a.example.com",
+				Headers: map[string]string{"x-tenant": "a"},
+			},
+		},
+	}}
+	flow := NewCheckRequestToFlowAdapter(req)
+
+	status := checkStore(store, store.Endpoint, rules.RuleDirIngress, flow)
+	Expect(status.Code).To(Equal(OK))
+
+	http := req.GetAttributes().GetRequest().GetHttp()
+	http.Headers["x-tenant"] = "b"
+
+	status = checkStore(store, store.Endpoint, rules.RuleDirIngress, flow)
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+
+	http.Headers["x-tenant"] = "a"
+	http.Host = "tenant-b.example.com"
+
+	status = checkStore(store, store.Endpoint, rules.RuleDirIngress, flow)
+	Expect(status.Code).To(Equal(PERMISSION_DENIED))
+}
+
 // And endpoint with no Tiers should evaluate Profiles.
 func TestCheckStoreProfileOnly(t *testing.T) {
 	RegisterTestingT(t)
@@ -1020,6 +1081,8 @@ type MockFlow struct {
 	Protocol        int
 	HttpMethod      *string
 	HttpPath        *string
+	HttpHost        *string
+	HttpHeaders     map[string]string
 	SourcePrincipal *string
 	DestPrincipal   *string
 	SourceLabels    map[string]string
@@ -1054,6 +1117,14 @@ func (m *MockFlow) GetHttpPath() *string {
 	return m.HttpPath
 }
 
+func (m *MockFlow) GetHttpHost() *string {
+	return m.HttpHost
+}
+
+func (m *MockFlow) GetHttpHeaders() map[string]string {
+	return m.HttpHeaders
+}
+
 func (m *MockFlow) GetSourcePrincipal() *string {
 	return m.SourcePrincipal
 }
@@ -17,7 +17,9 @@ package checker
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
@@ -60,6 +62,8 @@ type L4Flow interface {
 type L7Flow interface {
 	GetHttpMethod() *string
 	GetHttpPath() *string
+	GetHttpHost() *string
+	GetHttpHeaders() map[string]string
 	GetSourcePrincipal() *string
 	GetDestPrincipal() *string
 	GetSourceLabels() map[string]string
@@ -86,6 +90,7 @@ func match(policyNamespace string, rule *proto.Rule, req *requestCache) bool {
 			"DestPort":   req.GetDestPort(),
 			"HttpMethod": req.GetHttpMethod(),
 			"HttpPath":   req.GetHttpPath(),
+			"HttpHost":   req.GetHttpHost(),
 		}).Debug("Checking rule on request")
 	}
 	return matchSource(policyNamespace, rule, req) &&
@@ -158,7 +163,7 @@ func computeNamespaceMatch(
 // Rule matches, false otherwise.
 func matchRequest(rule *proto.Rule, req *requestCache) bool {
 	log.WithField("request", req).Debug("Matching request.")
-	return matchHTTP(rule.GetHttpMatch(), req.GetHttpMethod(), req.GetHttpPath())
+	return matchHTTP(rule.GetHttpMatch(), req)
 }
 
 // matchServiceAccounts checks if the service account part of the Rule matches the request. It
@@ -253,7 +258,7 @@ func matchNamespace(nsMatch *namespaceMatch, ns *namespace) bool {
 
 // matchHTTP checks if the HTTP part of the Rule matches the request. It returns true if the Rule
 // matches, false otherwise.
-func matchHTTP(rule *proto.HTTPMatch, httpMethod, httpPath *string) bool {
+func matchHTTP(rule *proto.HTTPMatch, req L7Flow) bool {
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.WithFields(log.Fields{
 			"rule": rule,
@@ -264,7 +269,11 @@ func matchHTTP(rule *proto.HTTPMatch, httpMethod, httpPath *string) bool {
 		return true
 	}
 
-	return matchHTTPMethods(rule.GetMethods(), httpMethod) && matchHTTPPaths(rule.GetPaths(), httpPath)
+	return matchHTTPMethods(rule.GetMethods(), req.GetHttpMethod()) &&
+		matchHTTPPaths(rule.GetPaths(), req.GetHttpPath()) &&
+		matchHTTPHeaders(rule.GetHeaders(), req.GetHttpHeaders()) &&
+		matchHTTPHosts(rule.GetHosts(), req.GetHttpHost()) &&
+		matchGRPCMethods(rule.GetGrpcMethods(), req.GetHttpPath(), req.GetHttpHeaders())
 }
 
 // matchHTTPMethods checks if the HTTP methods match. It returns true if the methods match, false
@@ -345,6 +354,156 @@ func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath *string) bool {
 	return false
 }
 
+// matchHTTPHeaders checks if the HTTP headers match. It returns true if all of the header matches
+// match, false otherwise.
+func matchHTTPHeaders(headerMatches []*proto.HTTPMatch_HeaderMatch, reqHeaders map[string]string) bool {
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.WithFields(log.Fields{
+			"headerMatches": headerMatches,
+			"reqHeaders":    reqHeaders,
+		}).Debug("Matching HTTP Headers")
+	}
+	if len(headerMatches) == 0 {
+		log.Debug("Rule has 0 HTTP Headers, matched.")
+		return true
+	}
+	if reqHeaders == nil {
+		log.Debug("Request has nil HTTP Headers.")
+		return true
+	}
+	for _, headerMatch := range headerMatches {
+		// Envoy presents header names in lower case, and Felix lower-cases the names in the rule.
+		value, present := reqHeaders[headerMatch.GetName()]
+		switch headerMatch.GetHeaderMatch().(type) {
+		case *proto.HTTPMatch_HeaderMatch_Present:
+			if present != headerMatch.GetPresent() {
+				log.Debugf("HTTP Header %s presence not matched.", headerMatch.GetName())
+				return false
+			}
+		case *proto.HTTPMatch_HeaderMatch_Exact:
+			if !present || value != headerMatch.GetExact() {
+				log.Debugf("HTTP Header %s exact not matched.", headerMatch.GetName())
+				return false
+			}
+		case *proto.HTTPMatch_HeaderMatch_Prefix:
+			if !present || !strings.HasPrefix(value, headerMatch.GetPrefix()) {
+				log.Debugf("HTTP Header %s prefix not matched.", headerMatch.GetName())
+				return false
+			}
+		case *proto.HTTPMatch_HeaderMatch_Regex:
+			re, err := headerRegexp(headerMatch.GetRegex())
+			if err != nil {
+				// The regex is checked by the validator, so this shouldn't happen.  Fail closed.
+				log.WithError(err).Errorf("Invalid HTTP Header regex %q.", headerMatch.GetRegex())
+				return false
+			}
+			if !present || !re.MatchString(value) {
+				log.Debugf("HTTP Header %s regex not matched.", headerMatch.GetName())
+				return false
+			}
+		default:
+			log.Errorf("Unknown HTTP Header match type for header %s.", headerMatch.GetName())
+			return false
+		}
+	}
+	log.Debug("HTTP Headers matched.")
+	return true
+}
+
+// headerRegexps caches the compiled header regexes, which are shared across requests.
+var headerRegexps sync.Map
+
+// headerRegexp returns the compiled form of a header regex.  As in Envoy, the regex has to match
+// the whole header value.
+func headerRegexp(expr string) (*regexp.Regexp, error) {
+	if re, ok := headerRegexps.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return nil, err
+	}
+	headerRegexps.Store(expr, re)
+	return re, nil
+}
+
+// matchHTTPHosts checks if the HTTP host matches. It returns true if the host matches, false
+// otherwise.
+func matchHTTPHosts(hosts []string, reqHost *string) bool {
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.WithFields(log.Fields{
+			"hosts":   hosts,
+			"reqHost": reqHost,
+		}).Debug("Matching HTTP Hosts")
+	}
+	if len(hosts) == 0 {
+		log.Debug("Rule has 0 HTTP Hosts, matched.")
+		return true
+	}
+	if reqHost == nil {
+		log.Debug("Request has nil HTTP Host.")
+		return true
+	}
+	host := *reqHost
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	// Host names are case-insensitive.
+	host = strings.ToLower(host)
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if strings.HasPrefix(h, "*.") {
+			if strings.HasSuffix(host, h[1:]) {
+				log.Debugf("HTTP Host wildcard %s matched.", h)
+				return true
+			}
+		} else if host == h {
+			log.Debug("HTTP Host matched.")
+			return true
+		}
+	}
+	log.Debug("HTTP Host not matched.")
+	return false
+}
+
+// matchGRPCMethods checks if the gRPC service and method match. It returns true if the service and
+// method match, false otherwise.
+func matchGRPCMethods(methods []*proto.HTTPMatch_GRPCMethodMatch, reqPath *string, reqHeaders map[string]string) bool {
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.WithFields(log.Fields{
+			"methods": methods,
+			"reqPath": reqPath,
+		}).Debug("Matching gRPC Methods")
+	}
+	if len(methods) == 0 {
+		log.Debug("Rule has 0 gRPC Methods, matched.")
+		return true
+	}
+	if reqPath == nil {
+		log.Debug("nil HTTP Path.")
+		return true
+	}
+	if reqHeaders != nil && !strings.HasPrefix(reqHeaders["content-type"], "application/grpc") {
+		log.Debug("Request is not gRPC, not matched.")
+		return false
+	}
+	// gRPC requests are sent to the path /<service>/<method>.
+	parts := strings.Split(strings.TrimPrefix(*reqPath, "/"), "/")
+	if len(parts) != 2 {
+		log.Debugf("Invalid gRPC Path \"%s\", not matched.", *reqPath)
+		return false
+	}
+	service, method := parts[0], parts[1]
+	for _, m := range methods {
+		if m.GetService() == service && (m.GetMethod() == "" || m.GetMethod() == method) {
+			log.Debug("gRPC Method matched.")
+			return true
+		}
+	}
+	log.Debug("gRPC Method not matched.")
+	return false
+}
+
 // matchSrcIPSets checks if the source IP is within the IP sets and not in the not IP sets. It
 // returns true if the IP sets match, false otherwise.
 func matchSrcIPSets(r *proto.Rule, req *requestCache) bool {
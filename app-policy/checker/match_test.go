@@ -142,11 +142,115 @@ func TestMatchHTTPPaths(t *testing.T) {
 	}
 }
 
+// HTTP Headers clause with empty list will match any headers; all header matches must match.
+func TestMatchHTTPHeaders(t *testing.T) {
+	exact := func(name, v string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: v}}
+	}
+	prefix := func(name, v string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, HeaderMatch: &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: v}}
+	}
+	regex := func(name, v string) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, HeaderMatch: &proto.HTTPMatch_HeaderMatch_Regex{Regex: v}}
+	}
+	present := func(name string, v bool) *proto.HTTPMatch_HeaderMatch {
+		return &proto.HTTPMatch_HeaderMatch{Name: name, HeaderMatch: &proto.HTTPMatch_HeaderMatch_Present{Present: v}}
+	}
+	testCases := []struct {
+		title   string
+		headers []*proto.HTTPMatch_HeaderMatch
+		reqHdrs map[string]string
+		result  bool
+	}{
+		{"empty", nil, map[string]string{"x-tenant": "a"}, true},
+		{"nil request headers", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a")}, nil, true},
+		{"exact", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a")}, map[string]string{"x-tenant": "a"}, true},
+		{"exact fail", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a")}, map[string]string{"x-tenant": "b"}, false},
+		{"exact missing", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a")}, map[string]string{}, false},
+		{"prefix", []*proto.HTTPMatch_HeaderMatch{prefix("x-version", "v1.")}, map[string]string{"x-version": "v1.2"}, true},
+		{"prefix fail", []*proto.HTTPMatch_HeaderMatch{prefix("x-version", "v1.")}, map[string]string{"x-version": "v2.0"}, false},
+		{"regex", []*proto.HTTPMatch_HeaderMatch{regex("x-id", "[0-9]+")}, map[string]string{"x-id": "1234"}, true},
+		{"regex matches whole value", []*proto.HTTPMatch_HeaderMatch{regex("x-id", "[0-9]+")}, map[string]string{"x-id": "a1234"}, false},
+		{"invalid regex", []*proto.HTTPMatch_HeaderMatch{regex("x-id", "a(")}, map[string]string{"x-id": "a("}, false},
+		{"present", []*proto.HTTPMatch_HeaderMatch{present("authorization", true)}, map[string]string{"authorization": ""}, true},
+		{"present fail", []*proto.HTTPMatch_HeaderMatch{present("authorization", true)}, map[string]string{}, false},
+		{"absent", []*proto.HTTPMatch_HeaderMatch{present("x-debug", false)}, map[string]string{}, true},
+		{"absent fail", []*proto.HTTPMatch_HeaderMatch{present("x-debug", false)}, map[string]string{"x-debug": "1"}, false},
+		{"multiple", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a"), present("authorization", true)},
+			map[string]string{"x-tenant": "a", "authorization": "Bearer foo"}, true},
+		{"multiple fail", []*proto.HTTPMatch_HeaderMatch{exact("x-tenant", "a"), present("authorization", true)},
+			map[string]string{"x-tenant": "a"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHeaders(tc.headers, tc.reqHdrs)).To(Equal(tc.result))
+		})
+	}
+}
+
+// HTTP Hosts clause with empty list will match any host.
+func TestMatchHTTPHosts(t *testing.T) {
+	testCases := []struct {
+		title   string
+		hosts   []string
+		reqHost string
+		result  bool
+	}{
+		{"empty", []string{}, "api.example.com", true},
+		{"exact", []string{"api.example.com"}, "api.example.com", true},
+		{"case-insensitive", []string{"api.example.com"}, "API.Example.com", true},
+		{"port ignored", []string{"api.example.com"}, "api.example.com:8080", true},
+		{"exact fail", []string{"api.example.com"}, "www.example.com", false},
+		{"wildcard", []string{"*.example.com"}, "a.b.example.com", true},
+		{"wildcard needs subdomain", []string{"*.example.com"}, "example.com", false},
+		{"multiple", []string{"www.example.com", "api.example.com"}, "api.example.com", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHosts(tc.hosts, &tc.reqHost)).To(Equal(tc.result))
+		})
+	}
+}
+
+// gRPC Methods clause with empty list will match any request.
+func TestMatchGRPCMethods(t *testing.T) {
+	grpcHeaders := map[string]string{"content-type": "application/grpc+proto"}
+	greeter := []*proto.HTTPMatch_GRPCMethodMatch{{Service: "helloworld.Greeter"}}
+	sayHello := []*proto.HTTPMatch_GRPCMethodMatch{{Service: "helloworld.Greeter", Method: "SayHello"}}
+	testCases := []struct {
+		title   string
+		methods []*proto.HTTPMatch_GRPCMethodMatch
+		reqPath string
+		reqHdrs map[string]string
+		result  bool
+	}{
+		{"empty", nil, "/helloworld.Greeter/SayHello", grpcHeaders, true},
+		{"service", greeter, "/helloworld.Greeter/SayGoodbye", grpcHeaders, true},
+		{"method", sayHello, "/helloworld.Greeter/SayHello", grpcHeaders, true},
+		{"method fail", sayHello, "/helloworld.Greeter/SayGoodbye", grpcHeaders, false},
+		{"service fail", greeter, "/helloworld.Other/SayHello", grpcHeaders, false},
+		{"not gRPC", sayHello, "/helloworld.Greeter/SayHello", map[string]string{"content-type": "application/json"}, false},
+		{"invalid path", greeter, "/helloworld.Greeter", grpcHeaders, false},
+		{"nil request headers", sayHello, "/helloworld.Greeter/SayHello", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchGRPCMethods(tc.methods, &tc.reqPath, tc.reqHdrs)).To(Equal(tc.result))
+		})
+	}
+}
+
 // An omitted HTTP Match clause always matches.
 func TestMatchHTTPNil(t *testing.T) {
 	RegisterTestingT(t)
 
-	Expect(matchHTTP(nil, nil, nil)).To(BeTrue())
+	Expect(matchHTTP(nil, nil)).To(BeTrue())
 }
 
 // Test HTTPPaths panic on invalid data.
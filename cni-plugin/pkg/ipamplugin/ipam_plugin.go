@@ -17,6 +17,7 @@ package ipamplugin
 import (
 	"context"
 	"encoding/json"
+	goerrors "errors"
 	"flag"
 	"fmt"
 	"net"
@@ -187,7 +188,7 @@ func cmdAdd(args *skel.CmdArgs) error {
 		}
 		err := assignIPWithLock()
 		if err != nil {
-			return err
+			return quotaError(err)
 		}
 
 		var ipNetwork net.IPNet
@@ -282,7 +283,7 @@ func cmdAdd(args *skel.CmdArgs) error {
 		}
 		logger.Infof("Calico CNI IPAM assigned addresses IPv4=%v IPv6=%v", v4ips, v6ips)
 		if err != nil {
-			return err
+			return quotaError(err)
 		}
 
 		// Check if IPv4 address assignment fails but IPv6 address assignment succeeds. Release IPs for the successful IPv6 address assignment.
@@ -344,6 +345,17 @@ func cmdAdd(args *skel.CmdArgs) error {
 	return cnitypes.PrintResult(r, conf.CNIVersion)
 }
 
+// quotaError converts an IPAM quota error into a CNI error, so that the pod's events name the
+// quota that its namespace has used up rather than reporting a generic assignment failure.
+// The assignment can succeed once addresses are released, so it's reported as temporary.
+func quotaError(err error) error {
+	var qerr ipam.QuotaExceededError
+	if goerrors.As(err, &qerr) {
+		return cnitypes.NewError(cnitypes.ErrTryAgainLater, "IP quota exceeded", qerr.Error())
+	}
+	return err
+}
+
 type unlockFn func()
 
 // acquireIPAMLockBestEffort attempts to acquire the IPAM file lock, blocking if needed.  If an error occurs
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
@@ -483,6 +484,14 @@ type bgpPeer struct {
 	Filters         []string             `json:"filters"`
 	PassiveMode     bool                 `json:"passive_mode"`
 	LocalBGPPeer    bool                 `json:"local_bgp_peer"`
+	BFD             string               `json:"bfd"`
+}
+
+type bfdConfig struct {
+	Enabled       bool   `json:"enabled"`
+	MinRxInterval string `json:"min_rx_interval,omitempty"`
+	MinTxInterval string `json:"min_tx_interval,omitempty"`
+	Multiplier    int    `json:"multiplier,omitempty"`
 }
 
 type bgpPrefix struct {
@@ -1173,6 +1182,7 @@ func (c *client) updateBGPConfigCache(resName string, v3res *apiv3.BGPConfigurat
 		c.getNodeMeshRestartTimeKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getNodeMeshPasswordKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getIgnoredInterfacesKVPair(v3res, model.GlobalBGPConfigKey{})
+		c.getBFDKVPair(v3res, model.GlobalBGPConfigKey{})
 
 		// Cache the updated BGP configuration
 		c.globalBGPConfig = v3res
@@ -1183,6 +1193,7 @@ func (c *client) updateBGPConfigCache(resName string, v3res *apiv3.BGPConfigurat
 		c.getPrefixAdvertisementsKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName})
 		c.getListenPortKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName}, updatePeersV1, updateReasons)
 		c.getLogSeverityKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName})
+		c.getBFDKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName})
 	} else {
 		log.Warningf("Bad value for BGPConfiguration resource name: %s.", resName)
 	}
@@ -1462,6 +1473,40 @@ func (c *client) getIgnoredInterfacesKVPair(v3res *apiv3.BGPConfiguration, key i
 	}
 }
 
+func (c *client) getBFDKVPair(v3res *apiv3.BGPConfiguration, key interface{}) {
+	bfdKey := getBGPConfigKey("bfd", key)
+	if v3res != nil && v3res.Spec.BFD != nil {
+		bfd := bfdConfig{
+			Enabled: v3res.Spec.BFD.Enabled != nil && *v3res.Spec.BFD.Enabled,
+		}
+		if v3res.Spec.BFD.MinRxInterval != nil {
+			bfd.MinRxInterval = birdInterval(v3res.Spec.BFD.MinRxInterval.Duration)
+		}
+		if v3res.Spec.BFD.MinTxInterval != nil {
+			bfd.MinTxInterval = birdInterval(v3res.Spec.BFD.MinTxInterval.Duration)
+		}
+		if v3res.Spec.BFD.Multiplier != nil {
+			bfd.Multiplier = *v3res.Spec.BFD.Multiplier
+		}
+		value, err := json.Marshal(bfd)
+		if err != nil {
+			log.WithError(err).Warning("Error while marshalling BFD configuration")
+			return
+		}
+		c.updateCache(api.UpdateTypeKVUpdated, getKVPair(bfdKey, string(value)))
+	} else {
+		c.updateCache(api.UpdateTypeKVDeleted, getKVPair(bfdKey))
+	}
+}
+
+// birdInterval formats a duration as a BIRD time value, using milliseconds where that is exact.
+func birdInterval(d time.Duration) string {
+	if d%time.Millisecond == 0 {
+		return fmt.Sprintf("%d ms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%d us", d.Microseconds())
+}
+
 func getNodeName(nodeName string) string {
 	return strings.TrimPrefix(nodeName, perNodeConfigNamePrefix)
 }
@@ -1923,6 +1968,14 @@ func (c *client) setPeerConfigFieldsFromV3Resource(peers []*bgpPeer, v3res *apiv
 		if v3res.Spec.MaxRestartTime != nil {
 			peer.RestartTime = fmt.Sprintf("%v", int(math.Round(v3res.Spec.MaxRestartTime.Duration.Seconds())))
 		}
+		// An empty value means that the BFD setting from the BGPConfiguration applies.
+		if v3res.Spec.BFD != nil && v3res.Spec.BFD.Enabled != nil {
+			if *v3res.Spec.BFD.Enabled {
+				peer.BFD = "on"
+			} else {
+				peer.BFD = "off"
+			}
+		}
 	}
 }
 
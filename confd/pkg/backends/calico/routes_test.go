@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -722,4 +723,31 @@ var _ = Describe("Update BGP Config Cache", func() {
 		c.getIgnoredInterfacesKVPair(res, model.GlobalBGPConfigKey{})
 		Expect(c.cache["/calico/bgp/v1/global/ignored_interfaces"]).To(Equal("iface-1,iface-2"))
 	})
+
+	It("should update cache value when BFD is set in BGPConfiguration", func() {
+		By("No value cached")
+		Expect(c.cache).NotTo(HaveKey("/calico/bgp/v1/host/node1/bfd"))
+
+		By("After updating")
+		enabled := true
+		multiplier := 3
+		res := &apiv3.BGPConfiguration{
+			Spec: apiv3.BGPConfigurationSpec{
+				BFD: &apiv3.BFDConfiguration{
+					Enabled:       &enabled,
+					MinRxInterval: &metav1.Duration{Duration: 50 * time.Millisecond},
+					MinTxInterval: &metav1.Duration{Duration: 2500 * time.Microsecond},
+					Multiplier:    &multiplier,
+				},
+			},
+		}
+		c.getBFDKVPair(res, model.NodeBGPConfigKey{Nodename: "node1"})
+		Expect(c.cache["/calico/bgp/v1/host/node1/bfd"]).To(MatchJSON(
+			`{"enabled":true,"min_rx_interval":"50 ms","min_tx_interval":"2500 us","multiplier":3}`,
+		))
+
+		By("After removing the BFD configuration")
+		c.getBFDKVPair(&apiv3.BGPConfiguration{}, model.NodeBGPConfigKey{Nodename: "node1"})
+		Expect(c.cache).NotTo(HaveKey("/calico/bgp/v1/host/node1/bfd"))
+	})
 })
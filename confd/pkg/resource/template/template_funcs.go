@@ -60,6 +60,21 @@ func filterStatement(fields filterArgs) (string, error) {
 		return "", err
 	}
 
+	if len(fields.operations) > 0 {
+		if fields.action != v3.Accept {
+			return "", fmt.Errorf("operations can only be used with the Accept action in BGPFilter")
+		}
+		var statements []string
+		for _, op := range fields.operations {
+			opStatements, err := filterOperation(op)
+			if err != nil {
+				return "", err
+			}
+			statements = append(statements, opStatements...)
+		}
+		actionStatement = strings.Join(append(statements, actionStatement), " ")
+	}
+
 	var conditions []string
 	if fields.cidr != "" {
 		if fields.operator == "" {
@@ -88,6 +103,20 @@ func filterStatement(fields filterArgs) (string, error) {
 		conditions = append(conditions, ifaceCondition)
 	}
 
+	if fields.communities != nil {
+		for _, c := range fields.communities.Values {
+			communityCondition, err := filterMatchCommunity(c)
+			if err != nil {
+				return "", err
+			}
+			conditions = append(conditions, communityCondition)
+		}
+	}
+
+	if fields.asPath != nil {
+		conditions = append(conditions, filterMatchASPath(fields.asPath)...)
+	}
+
 	conditionExpr := strings.Join(conditions, "&&")
 	if conditionExpr != "" {
 		return fmt.Sprintf("if (%s) then { %s }", conditionExpr, actionStatement), nil
@@ -173,6 +202,91 @@ func filterMatchInterface(iface string) (string, error) {
 	return fmt.Sprintf("((defined(ifname))&&(ifname ~ \"%s\"))", iface), nil
 }
 
+// filterCommunity converts a community value of the form "aa:nn" or "aa:nn:mm" into the BIRD pair or
+// large community literal, along with the name of the route attribute holding that kind of community.
+// e.g. input of "65000:100" produces output of ("(65000,100)", "bgp_community")
+func filterCommunity(community v3.BGPCommunityValue) (string, string, error) {
+	parts := strings.Split(string(community), ":")
+	bitSize := 16
+	attr := "bgp_community"
+	switch len(parts) {
+	case 2:
+	case 3:
+		bitSize = 32
+		attr = "bgp_large_community"
+	default:
+		return "", "", fmt.Errorf("unexpected community found in BGPFilter: %s", community)
+	}
+	for _, p := range parts {
+		if _, err := strconv.ParseUint(p, 10, bitSize); err != nil {
+			return "", "", fmt.Errorf("unexpected community found in BGPFilter: %s", community)
+		}
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, ",")), attr, nil
+}
+
+func filterMatchCommunity(community v3.BGPCommunityValue) (string, error) {
+	value, attr, err := filterCommunity(community)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s ~ %s)", value, attr), nil
+}
+
+func filterMatchASPath(asPath *v3.BGPFilterASPathMatch) []string {
+	var conditions []string
+	if len(asPath.Prefix) > 0 {
+		var asns []string
+		for _, asn := range asPath.Prefix {
+			asns = append(asns, asn.String())
+		}
+		conditions = append(conditions,
+			fmt.Sprintf("((defined(bgp_path))&&(bgp_path ~ [= %s * =]))", strings.Join(asns, " ")))
+	}
+	if asPath.Contains != nil {
+		conditions = append(conditions,
+			fmt.Sprintf("((defined(bgp_path))&&(bgp_path ~ [= * %s * =]))", asPath.Contains.String()))
+	}
+	return conditions
+}
+
+// filterOperation produces the BIRD statements that modify the attributes of a route for a single
+// BGPFilter operation.
+// e.g. input of {SetLocalPreference: {Value: 200}} produces output of ["bgp_local_pref = 200;"]
+func filterOperation(op v3.BGPFilterOperation) ([]string, error) {
+	switch {
+	case op.AddCommunity != nil:
+		value, attr, err := filterCommunity(op.AddCommunity.Value)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%s.add(%s);", attr, value)}, nil
+	case op.SetCommunities != nil:
+		statements := []string{"bgp_community.delete([(*,*)]);", "bgp_large_community.delete([(*,*,*)]);"}
+		for _, c := range op.SetCommunities.Values {
+			value, attr, err := filterCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			statements = append(statements, fmt.Sprintf("%s.add(%s);", attr, value))
+		}
+		return statements, nil
+	case op.SetLocalPreference != nil:
+		return []string{fmt.Sprintf("bgp_local_pref = %d;", op.SetLocalPreference.Value)}, nil
+	case op.SetMED != nil:
+		return []string{fmt.Sprintf("bgp_med = %d;", op.SetMED.Value)}, nil
+	case op.PrependASPath != nil:
+		// Prepend in reverse so that the first AS number in the list ends up first in the path.
+		var statements []string
+		for i := len(op.PrependASPath.Prefix) - 1; i >= 0; i-- {
+			statements = append(statements, fmt.Sprintf("bgp_path.prepend(%s);", op.PrependASPath.Prefix[i].String()))
+		}
+		return statements, nil
+	default:
+		return nil, fmt.Errorf("empty operation found in BGPFilter")
+	}
+}
+
 // BGPFilterFunctionName returns a formatted name for use as a BIRD function, truncating and hashing if the provided
 // name would result in a function name longer than the max allowable length of 64 chars.
 // e.g. input of ("my-bgp-filter", "import", "4") would result in output of "'bgp_my-bpg-filter_importFilterV4'"
@@ -199,7 +313,10 @@ type filterArgs struct {
 	prefixLengthV6 *v3.BGPFilterPrefixLengthV6
 	source         v3.BGPFilterMatchSource
 	iface          string
+	communities    *v3.BGPFilterCommunityMatch
+	asPath         *v3.BGPFilterASPathMatch
 	action         v3.BGPFilterAction
+	operations     []v3.BGPFilterOperation
 }
 
 // BGPFilterBIRDFuncs generates a set of BIRD functions for BGPFilter resources that have been packaged into KVPairs.
@@ -306,7 +423,10 @@ func BGPFilterBIRDFuncs(pairs memkv.KVPairs, version int) ([]string, error) {
 						prefixLengthV4: importV4.PrefixLength,
 						source:         importV4.Source,
 						iface:          importV4.Interface,
+						communities:    importV4.Communities,
+						asPath:         importV4.ASPath,
 						action:         importV4.Action,
+						operations:     importV4.Operations,
 					})
 				}
 			} else {
@@ -317,7 +437,10 @@ func BGPFilterBIRDFuncs(pairs memkv.KVPairs, version int) ([]string, error) {
 						prefixLengthV6: importV6.PrefixLength,
 						source:         importV6.Source,
 						iface:          importV6.Interface,
+						communities:    importV6.Communities,
+						asPath:         importV6.ASPath,
 						action:         importV6.Action,
+						operations:     importV6.Operations,
 					})
 				}
 			}
@@ -353,7 +476,10 @@ func BGPFilterBIRDFuncs(pairs memkv.KVPairs, version int) ([]string, error) {
 						prefixLengthV4: exportV4.PrefixLength,
 						source:         exportV4.Source,
 						iface:          exportV4.Interface,
+						communities:    exportV4.Communities,
+						asPath:         exportV4.ASPath,
 						action:         exportV4.Action,
+						operations:     exportV4.Operations,
 					})
 				}
 			} else {
@@ -364,7 +490,10 @@ func BGPFilterBIRDFuncs(pairs memkv.KVPairs, version int) ([]string, error) {
 						prefixLengthV6: exportV6.PrefixLength,
 						source:         exportV6.Source,
 						iface:          exportV6.Interface,
+						communities:    exportV6.Communities,
+						asPath:         exportV6.ASPath,
 						action:         exportV6.Action,
+						operations:     exportV6.Operations,
 					})
 				}
 			}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kelseyhightower/memkv"
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
 )
 
 func Test_hashToIPv4_invalid_range(t *testing.T) {
@@ -185,6 +186,114 @@ func Test_BGPFilterBIRDFuncs(t *testing.T) {
 	}
 }
 
+func Test_BGPFilterBIRDFuncs_routeAttributes(t *testing.T) {
+	asn := numorstring.ASNumber(65100)
+	testFilter := v3.BGPFilter{}
+	testFilter.ObjectMeta.Name = "test-bgpfilter"
+	testFilter.Spec = v3.BGPFilterSpec{
+		ImportV4: []v3.BGPFilterRuleV4{
+			{
+				Action:      "Accept",
+				Communities: &v3.BGPFilterCommunityMatch{Values: []v3.BGPCommunityValue{"65000:100", "65000:1:2"}},
+				Operations: []v3.BGPFilterOperation{
+					{SetLocalPreference: &v3.BGPFilterSetLocalPreference{Value: 200}},
+				},
+			},
+			{Action: "Reject", ASPath: &v3.BGPFilterASPathMatch{Contains: &asn}},
+		},
+		ExportV4: []v3.BGPFilterRuleV4{
+			{
+				Action:        "Accept",
+				MatchOperator: "In",
+				CIDR:          "77.7.0.0/16",
+				Operations: []v3.BGPFilterOperation{
+					{PrependASPath: &v3.BGPFilterPrependASPath{Prefix: []numorstring.ASNumber{65000, 65001}}},
+					{AddCommunity: &v3.BGPFilterAddCommunity{Value: "65000:300"}},
+					{SetMED: &v3.BGPFilterSetMED{Value: 50}},
+				},
+			},
+		},
+		ImportV6: []v3.BGPFilterRuleV6{
+			{Action: "Accept", ASPath: &v3.BGPFilterASPathMatch{Prefix: []numorstring.ASNumber{65001, 65002}}},
+		},
+		ExportV6: []v3.BGPFilterRuleV6{
+			{
+				Action: "Accept",
+				Operations: []v3.BGPFilterOperation{
+					{SetCommunities: &v3.BGPFilterSetCommunities{Values: []v3.BGPCommunityValue{"65000:1", "4200000000:1:1"}}},
+				},
+			},
+		},
+	}
+	expectedBIRDCfgStrV4 := []string{
+		"# v4 BGPFilter test-bgpfilter",
+		"function 'bgp_test-bgpfilter_importFilterV4'() {",
+		"  if (((65000,100) ~ bgp_community)&&((65000,1,2) ~ bgp_large_community)) then { bgp_local_pref = 200; accept; }",
+		"  if (((defined(bgp_path))&&(bgp_path ~ [= * 65100 * =]))) then { reject; }",
+		"}",
+		"function 'bgp_test-bgpfilter_exportFilterV4'() {",
+		"  if ((net ~ 77.7.0.0/16)) then { bgp_path.prepend(65001); bgp_path.prepend(65000); bgp_community.add((65000,300)); bgp_med = 50; accept; }",
+		"}",
+	}
+	expectedBIRDCfgStrV6 := []string{
+		"# v6 BGPFilter test-bgpfilter",
+		"function 'bgp_test-bgpfilter_importFilterV6'() {",
+		"  if (((defined(bgp_path))&&(bgp_path ~ [= 65001 65002 * =]))) then { accept; }",
+		"}",
+		"function 'bgp_test-bgpfilter_exportFilterV6'() {",
+		"  bgp_community.delete([(*,*)]); bgp_large_community.delete([(*,*,*)]); bgp_community.add((65000,1)); bgp_large_community.add((4200000000,1,1)); accept;",
+		"}",
+	}
+
+	jsonFilter, err := json.Marshal(testFilter)
+	if err != nil {
+		t.Errorf("Error formatting BGPFilter into JSON: %s", err)
+	}
+	kvps := []memkv.KVPair{
+		{Key: "test-bgpfilter", Value: string(jsonFilter)},
+	}
+
+	v4BIRDCfgResult, err := BGPFilterBIRDFuncs(kvps, 4)
+	if err != nil {
+		t.Errorf("Unexpected error while generating v4 BIRD BGPFilter functions: %s", err)
+	}
+	if !reflect.DeepEqual(v4BIRDCfgResult, expectedBIRDCfgStrV4) {
+		t.Errorf("Generated v4 BIRD config differs from expectation:\n Generated = %s,\n Expected = %s",
+			v4BIRDCfgResult, expectedBIRDCfgStrV4)
+	}
+
+	v6BIRDCfgResult, err := BGPFilterBIRDFuncs(kvps, 6)
+	if err != nil {
+		t.Errorf("Unexpected error while generating v6 BIRD BGPFilter functions: %s", err)
+	}
+	if !reflect.DeepEqual(v6BIRDCfgResult, expectedBIRDCfgStrV6) {
+		t.Errorf("Generated v6 BIRD config differs from expectation:\n Generated = %s,\n Expected = %s",
+			v6BIRDCfgResult, expectedBIRDCfgStrV6)
+	}
+}
+
+func Test_BGPFilterBIRDFuncs_invalidOperations(t *testing.T) {
+	for _, rule := range []v3.BGPFilterRuleV4{
+		{Action: "Reject", Operations: []v3.BGPFilterOperation{{SetMED: &v3.BGPFilterSetMED{Value: 50}}}},
+		{Action: "Accept", Operations: []v3.BGPFilterOperation{{}}},
+		{Action: "Accept", Operations: []v3.BGPFilterOperation{{AddCommunity: &v3.BGPFilterAddCommunity{Value: "65536:1"}}}},
+		{Action: "Accept", Communities: &v3.BGPFilterCommunityMatch{Values: []v3.BGPCommunityValue{"no-export"}}},
+	} {
+		testFilter := v3.BGPFilter{}
+		testFilter.Spec = v3.BGPFilterSpec{ExportV4: []v3.BGPFilterRuleV4{rule}}
+		jsonFilter, err := json.Marshal(testFilter)
+		if err != nil {
+			t.Errorf("Error formatting BGPFilter into JSON: %s", err)
+		}
+		kvps := []memkv.KVPair{
+			{Key: "test-bgpfilter", Value: string(jsonFilter)},
+		}
+		if _, err := BGPFilterBIRDFuncs(kvps, 4); err == nil {
+			t.Errorf("Expected error while generating BIRD BGPFilter functions for rule %+v", rule)
+		}
+	}
+}
+
 func Test_ValidateHashToIpv4Method(t *testing.T) {
 	expectedRouterId := "207.94.5.27"
 	nodeName := "Testrobin123"
@@ -31,6 +31,7 @@ execute_test_suite() {
         run_extra_test test_bgp_reachable_by
         run_extra_test test_bgp_filters
         run_extra_test test_bgp_local_bgp_peer
+        run_extra_test test_bgp_bfd
     fi
 
     if [ "$DATASTORE_TYPE" = etcdv3 ]; then
@@ -44,6 +45,7 @@ execute_test_suite() {
         run_extra_test test_bgp_ignored_interfaces
         run_extra_test test_bgp_reachable_by
         run_extra_test test_bgp_filters
+        run_extra_test test_bgp_bfd
         echo "Extra etcdv3 tests passed"
     fi
 
@@ -1447,6 +1449,88 @@ EOF
     fi
 }
 
+test_bgp_bfd() {
+    # For KDD, run Typha and clean up the output directory.
+    if [ "$DATASTORE_TYPE" = kubernetes ]; then
+        start_typha
+        rm -f /etc/calico/confd/config/*
+    fi
+
+    # Run confd as a background process.
+    echo "Running confd as background process"
+    BGP_LOGSEVERITYSCREEN="debug" confd -confdir=/etc/calico/confd >$LOGPATH/logd1 2>&1 &
+    CONFD_PID=$!
+    echo "Running with PID " $CONFD_PID
+
+    # Enable BFD on all sessions with a custom receive interval and multiplier.
+    $CALICOCTL apply -f - <<EOF
+kind: BGPConfiguration
+apiVersion: projectcalico.org/v3
+metadata:
+  name: default
+spec:
+  logSeverityScreen: Info
+  nodeToNodeMeshEnabled: true
+  bfd:
+    enabled: true
+    minRxInterval: 50ms
+    multiplier: 3
+---
+kind: Node
+apiVersion: projectcalico.org/v3
+metadata:
+  name: kube-master
+spec:
+  bgp:
+    ipv4Address: 10.192.0.2/16
+    ipv6Address: "2001::103/64"
+---
+kind: Node
+apiVersion: projectcalico.org/v3
+metadata:
+  name: kube-node-1
+spec:
+  bgp:
+    ipv4Address: 10.192.0.3/16
+    ipv6Address: "2001::102/64"
+---
+kind: Node
+apiVersion: projectcalico.org/v3
+metadata:
+  name: kube-node-2
+spec:
+  bgp:
+    ipv4Address: 10.192.0.4/16
+    ipv6Address: "2001::104/64"
+EOF
+
+    test_confd_templates bfd
+
+    # Kill confd.
+    kill -9 $CONFD_PID
+
+    # Remove the BFD configuration.
+    $CALICOCTL apply -f - <<EOF
+kind: BGPConfiguration
+apiVersion: projectcalico.org/v3
+metadata:
+  name: default
+spec:
+  logSeverityScreen: Info
+  nodeToNodeMeshEnabled: true
+EOF
+
+    if [ "$DATASTORE_TYPE" = etcdv3 ]; then
+      $CALICOCTL delete node kube-master
+      $CALICOCTL delete node kube-node-1
+      $CALICOCTL delete node kube-node-2
+    fi
+    # For KDD, kill Typha.
+    if [ "$DATASTORE_TYPE" = kubernetes ]; then
+        kill_typha
+    fi
+}
+
 test_bgp_reachable_by() {
   test_bgp_reachable_by_for_global_peers
   test_bgp_reachable_by_for_route_reflectors
@@ -49,6 +49,26 @@ CALI_MAP_NAMED(cali_v4_ip_sets, cali_ip_sets,,
 	1024*1024,
 	BPF_F_NO_PREALLOC)
 
+// Well-known ID of the IP set that holds Felix's DNSTrustedServers as ip,port members.
+// WARNING: must be kept in sync with the definition in bpf/ipsets/ipsets.go.
+#define CALI_IP_SET_ID_DNS_TRUSTED_SERVERS 1
+
+static CALI_BPF_INLINE bool ip_set_contains_ip_port(__u64 set_id, ipv46_addr_t *addr, __u16 port, __u8 protocol)
+{
+	union ip_set_lpm_key key = {
+		.ip = {
+			// Members with a port use the full length of the key.
+			.mask = 64 /* ID */ + sizeof(ipv46_addr_t) * 8 /* IP */ + 16 /* Port */ + 8 /* protocol */,
+			.set_id = bpf_cpu_to_be64(set_id),
+			.addr = *addr,
+			.port = port,
+			.protocol = protocol,
+		},
+	};
+
+	return cali_ip_sets_lookup_elem(&key) != NULL;
+}
+
 #define RULE_START(id)
 
 #define RULE_END(id, action) \
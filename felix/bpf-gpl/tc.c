@@ -440,6 +440,17 @@ static CALI_BPF_INLINE void calico_tc_process_ct_lookup(struct cali_tc_ctx *ctx)
 		}
 	}
 
+	if (CALI_F_FROM_WEP &&
+			ip_set_contains_ip_port(CALI_IP_SET_ID_DNS_TRUSTED_SERVERS,
+						&ctx->state->ip_dst, ctx->state->dport, ctx->state->ip_proto)) {
+		/* Send flows to trusted DNS servers through the host namespace, in both
+		 * directions, so that the iptables/nftables rules can snoop the responses
+		 * for domain-based policy.
+		 */
+		CALI_DEBUG("Flow to trusted DNS server, skip FIB.");
+		ctx->state->flags |= CALI_ST_SKIP_FIB;
+	}
+
 syn_force_policy:
 	/* DNAT in state is set correctly now */
 
@@ -535,6 +535,10 @@ func (b *Block) Add64(dst, src Reg) {
 	b.add(Add64, dst, src, 0, 0, "")
 }
 
+func (b *Block) Sub64(dst, src Reg) {
+	b.add(Sub64, dst, src, 0, 0, "")
+}
+
 func (b *Block) AddImm64(dst Reg, imm int32) {
 	b.add(AddImm64, dst, 0, 0, imm, "")
 }
@@ -28,6 +28,12 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
+// DNSTrustedServersSetID is the well-known ID of the IP set that holds the DNSTrustedServers.  The
+// BPF programs look it up to send flows to those servers through the host namespace, where the
+// DNS responses get snooped.
+// WARNING: must be kept in sync with the definition in bpf-gpl/policy.h.
+const DNSTrustedServersSetID uint64 = 1
+
 var (
 	bpfIPSetsGauge = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "felix_bpf_num_ip_sets",
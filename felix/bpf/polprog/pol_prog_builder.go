@@ -19,12 +19,14 @@ import (
 	"math"
 	"math/bits"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	. "github.com/projectcalico/calico/felix/bpf/asm"
 	"github.com/projectcalico/calico/felix/bpf/ipsets"
 	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
 	"github.com/projectcalico/calico/felix/bpf/state"
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/proto"
@@ -51,6 +53,7 @@ type Builder struct {
 	stateMapFD         maps.FD
 	staticJumpMapFD    maps.FD
 	policyJumpMapFD    maps.FD
+	rateLimitMapFD     maps.FD
 	policyMapIndex     int
 	policyMapStride    int
 	policyDebugEnabled bool
@@ -117,6 +120,9 @@ var (
 	// port+proto+pad, the dst key is also aligned in the same way. <sweat :-)>
 	offSrcIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
 	offDstIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
+	// Key and value scratch space for rule rate limit buckets.
+	offRateLimitKey   = nextOffset(ratelimit.KeySize, 8)
+	offRateLimitValue = nextOffset(ratelimit.ValueSize, 8)
 
 	// Offsets within the cal_tc_state struct.
 	// WARNING: must be kept in sync with the definitions in bpf-gpl/types.h.
@@ -143,6 +149,10 @@ var (
 
 	stateOffFlags = FieldOffset{Offset: stateEventHdrSize + 360, Field: "state->flags"}
 
+	// Offsets within the rate limit map value.
+	rateLimitOffCredit = FieldOffset{Offset: ratelimit.ValueOffCredit, Field: "bucket->credit_ns"}
+	rateLimitOffLast   = FieldOffset{Offset: ratelimit.ValueOffLast, Field: "bucket->last_ns"}
+
 	skbCb0 = FieldOffset{Offset: 12*4 + 0*4, Field: "skb->cb[0]"}
 	skbCb1 = FieldOffset{Offset: 12*4 + 1*4, Field: "skb->cb[1]"}
 
@@ -750,6 +760,9 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 		// If all the match criteria are met, we fall through to the end of the rule
 		// so all that's left to do is to jump to the relevant action.
 		// TODO log and log-and-xxx actions
+		if rule.GetRateLimit() != nil {
+			p.writeRateLimits(rule)
+		}
 		if p.flowLogsEnabled || p.policyDebugEnabled {
 			p.writeRecordRuleHit(rule, actionLabel)
 		}
@@ -759,6 +772,111 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 	p.b.LabelNextInsn(p.endOfRuleLabel())
 }
 
+// writeRateLimits emits a token bucket check for each of the rule's rate limits; packets that
+// are over any of the limits go to the deny label.  Since the policy program only sees the
+// first packet of a flow, packet and connection limits both end up limiting new flows.
+func (p *Builder) writeRateLimits(rule Rule) {
+	if p.rateLimitMapFD == 0 {
+		log.WithField("rule", rule.RuleId).Warn("No rate limit map, ignoring rule rate limit.")
+		return
+	}
+	rl := rule.GetRateLimit()
+	if rl.ConnectionsPerSecond > 0 {
+		p.writeRateLimit(rule.MatchID, ratelimit.KindConnections, rl.ConnectionsPerSecond, rl.Burst, rl.PerSourceIp)
+	}
+	if rl.PacketsPerSecond > 0 {
+		p.writeRateLimit(rule.MatchID, ratelimit.KindPackets, rl.PacketsPerSecond, rl.Burst, rl.PerSourceIp)
+	}
+}
+
+// writeRateLimit emits a single token bucket check.  The bucket's credit is kept in nanoseconds:
+// it accrues in real time up to burst intervals' worth, and each packet spends one interval
+// (1s/rate).  Buckets are updated without locking so, under contention, a few extra packets
+// may get through.
+func (p *Builder) writeRateLimit(matchID RuleMatchID, kind, rate, burst uint32, perSourceIP bool) {
+	if burst == 0 {
+		burst = rate
+	}
+	interval := int64(time.Second) / int64(rate)
+	maxCredit := interval * int64(burst)
+	foundLabel := fmt.Sprintf("rule_%d_limit_%d_found", p.ruleID, kind)
+	elapsedLabel := fmt.Sprintf("rule_%d_limit_%d_elapsed", p.ruleID, kind)
+	cappedLabel := fmt.Sprintf("rule_%d_limit_%d_capped", p.ruleID, kind)
+	spendLabel := fmt.Sprintf("rule_%d_limit_%d_spend", p.ruleID, kind)
+	withinLimitLabel := fmt.Sprintf("rule_%d_limit_%d_ok", p.ruleID, kind)
+
+	p.b.AddCommentF("Rate limit %d/s, burst %d, per source IP: %v", rate, burst, perSourceIP)
+	p.b.LoadImm64(R1, int64(matchID))
+	p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffRuleID)
+	p.b.MovImm64(R1, int32(kind)) // Also zeroes the padding.
+	p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffKind)
+	p.b.MovImm64(R1, 0)
+	p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffAddr)
+	p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffAddr+8)
+	if perSourceIP {
+		if !p.forIPv6 {
+			p.b.Load32(R1, R9, stateOffIPSrc)
+			p.b.StoreStack32(R1, offRateLimitKey+ratelimit.KeyOffAddr)
+		} else {
+			ipOffset := stateOffIPSrc
+			p.b.Load64(R1, R9, ipOffset)
+			p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffAddr)
+			ipOffset.Offset += 8
+			p.b.Load64(R1, R9, ipOffset)
+			p.b.StoreStack64(R1, offRateLimitKey+ratelimit.KeyOffAddr+8)
+		}
+	}
+
+	p.b.LoadMapFD(R1, uint32(p.rateLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offRateLimitKey))
+	p.b.Call(HelperMapLookupElem)
+	p.b.JumpNEImm64(R0, 0, foundLabel)
+
+	// No bucket yet, create a full one and spend this packet's interval from it.
+	p.b.Call(HelperKtimeGetNs)
+	p.b.StoreStack64(R0, offRateLimitValue+ratelimit.ValueOffLast)
+	p.b.LoadImm64(R1, maxCredit-interval)
+	p.b.StoreStack64(R1, offRateLimitValue+ratelimit.ValueOffCredit)
+	p.b.LoadMapFD(R1, uint32(p.rateLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offRateLimitKey))
+	p.b.Mov64(R3, R10)
+	p.b.AddImm64(R3, int32(offRateLimitValue))
+	p.b.MovImm64(R4, 0) // BPF_ANY
+	p.b.Call(HelperMapUpdateElem)
+	p.b.Jump(withinLimitLabel)
+
+	// Existing bucket, top up the credit by the time since the last packet.  R7 is preserved
+	// across helper calls.
+	p.b.LabelNextInsn(foundLabel)
+	p.b.Mov64(R7, R0)
+	p.b.Call(HelperKtimeGetNs)
+	p.b.Load64(R1, R7, rateLimitOffLast)
+	// Another CPU may have updated the bucket since we read the clock; don't let the
+	// elapsed time go negative.
+	p.b.JumpGE64(R0, R1, elapsedLabel)
+	p.b.Mov64(R0, R1)
+	p.b.LabelNextInsn(elapsedLabel)
+	p.b.Store64(R7, R0, rateLimitOffLast)
+	p.b.Sub64(R0, R1)
+	p.b.Load64(R2, R7, rateLimitOffCredit)
+	p.b.Add64(R2, R0)
+	p.b.LoadImm64(R3, maxCredit)
+	p.b.JumpLE64(R2, R3, cappedLabel)
+	p.b.Mov64(R2, R3)
+	p.b.LabelNextInsn(cappedLabel)
+	p.b.LoadImm64(R3, interval)
+	p.b.JumpGE64(R2, R3, spendLabel)
+	p.b.AddComment("Over the rate limit, deny")
+	p.b.Store64(R7, R2, rateLimitOffCredit)
+	p.b.Jump("deny")
+	p.b.LabelNextInsn(spendLabel)
+	p.b.Sub64(R2, R3)
+	p.b.Store64(R7, R2, rateLimitOffCredit)
+	p.b.LabelNextInsn(withinLimitLabel)
+}
+
 func (p *Builder) writeProtoMatch(negate bool, protocol *proto.Protocol) {
 	if negate {
 		p.b.AddCommentF("If protocol == %s, skip to next rule", protocolToName(protocol))
@@ -1265,6 +1383,13 @@ func WithPolicyMapIndexAndStride(entryPointIdx, stride int) Option {
 	}
 }
 
+// WithRateLimitMap provides the map that holds the token buckets for rule rate limits.
+func WithRateLimitMap(fd maps.FD) Option {
+	return func(b *Builder) {
+		b.rateLimitMapFD = fd
+	}
+}
+
 func WithIPv6() Option {
 	return func(p *Builder) {
 		p.forIPv6 = true
@@ -208,3 +208,44 @@ func TestProgramSplitting(t *testing.T) {
 	Expect(len(progs)).To(BeNumerically(">=", 6))
 	Expect(len(progs)).To(BeNumerically("<=", 8))
 }
+
+func TestRateLimit(t *testing.T) {
+	RegisterTestingT(t)
+
+	build := func(opts ...Option) []asm.Insns {
+		opts = append(opts, WithAllowDenyJumps(666, 777), WithPolicyDebugEnabled())
+		pg := NewBuilder(idalloc.New(), 1, 2, 3, 4, opts...)
+		progs, err := pg.Instructions(Rules{
+			Tiers: []Tier{{
+				Policies: []Policy{{
+					Rules: []Rule{{
+						Rule: &proto.Rule{
+							Action: "Allow",
+							RateLimit: &proto.RuleRateLimit{
+								ConnectionsPerSecond: 10,
+								PacketsPerSecond:     100,
+								Burst:                20,
+								PerSourceIp:          true,
+							},
+						},
+						MatchID: 0x1234,
+					}},
+				}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return progs
+	}
+
+	progs := build(WithRateLimitMap(5))
+	labels, comments := aggregateCommentsAndLabels(&progs[0])
+	Expect(comments).To(ContainElement("Rate limit 10/s, burst 20, per source IP: true"))
+	Expect(comments).To(ContainElement("Rate limit 100/s, burst 20, per source IP: true"))
+	Expect(labels).To(ContainElement("rule_1_limit_0_ok"))
+	Expect(labels).To(ContainElement("rule_1_limit_1_ok"))
+
+	// Without the map, the limits are skipped.
+	progs = build()
+	_, comments = aggregateCommentsAndLabels(&progs[0])
+	Expect(comments).NotTo(ContainElement(ContainSubstring("Rate limit")))
+}
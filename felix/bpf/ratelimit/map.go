@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+// The rate limit map holds the token buckets for policy rules that have a rate limit.  It is
+// only accessed by the generated policy programs; see polprog for the code that reads and
+// updates it.
+//
+//	struct cali_rate_limit_key {
+//		__u64 rule_id;
+//		__u32 kind;
+//		__u32 pad;
+//		__u8  addr[16]; // Source IP for per-source limits, zero otherwise.
+//	};
+//
+//	struct cali_rate_limit_value {
+//		__u64 credit_ns;  // Available credit, in nanoseconds of accrued rate.
+//		__u64 last_ns;    // Time of the last update, from bpf_ktime_get_ns().
+//	};
+const (
+	KeySize   = 32
+	ValueSize = 16
+
+	KeyOffRuleID = 0
+	KeyOffKind   = 8
+	KeyOffAddr   = 16
+
+	ValueOffCredit = 0
+	ValueOffLast   = 8
+)
+
+// Kinds of limit; a rule may have one of each.
+const (
+	KindConnections uint32 = 0
+	KindPackets     uint32 = 1
+)
+
+var MapParameters = maps.MapParameters{
+	Type:       "lru_hash",
+	KeySize:    KeySize,
+	ValueSize:  ValueSize,
+	MaxEntries: 64 * 1024,
+	Name:       "cali_rate_lim",
+}
+
+func Map() maps.Map {
+	return maps.NewPinnedMap(MapParameters)
+}
@@ -16,6 +16,7 @@ package calc
 
 import (
 	"reflect"
+	"time"
 
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
@@ -26,6 +27,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/packedmap"
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -37,6 +39,11 @@ type ruleScanner interface {
 	OnProfileInactive(model.ProfileRulesKey)
 }
 
+type policyScheduleState struct {
+	inEffect  bool
+	nextCheck time.Time
+}
+
 type FelixSender interface {
 	SendUpdateToFelix(update model.KVPair)
 }
@@ -72,6 +79,11 @@ type ActiveRulesCalculator struct {
 	// Caches for ALP policies for stat collector.
 	allALPPolicies set.Set[model.PolicyKey]
 
+	// Schedule state of policies that have a schedule.  Policies outside their schedule are
+	// kept in allPolicies but are left out of the label index.
+	policySchedules map[model.PolicyKey]*policyScheduleState
+	nowFunc         func() time.Time
+
 	// Policy/profile ID to matching endpoint sets.
 	policyIDToEndpointKeys  multidict.Multidict[any, any]
 	profileIDToEndpointKeys multidict.Multidict[string, any]
@@ -105,6 +117,9 @@ func NewActiveRulesCalculator() *ActiveRulesCalculator {
 
 		allALPPolicies: set.New[model.PolicyKey](),
 
+		policySchedules: make(map[model.PolicyKey]*policyScheduleState),
+		nowFunc:         time.Now,
+
 		// Policy/profile ID to matching endpoint sets.
 		policyIDToEndpointKeys:  multidict.New[any, any](),
 		profileIDToEndpointKeys: multidict.New[string, any](),
@@ -194,12 +209,16 @@ func (arc *ActiveRulesCalculator) OnUpdate(update api.Update) (_ bool) {
 		// Update the tier/policy/profile counts.
 		arc.updateStats()
 	case model.PolicyKey:
-		oldPolicy, _ := arc.allPolicies.Get(key)
-		oldPolicyWasForceProgrammed := policyForceProgrammed(oldPolicy)
+		cachedPolicy, _ := arc.allPolicies.Get(key)
+		oldPolicy := cachedPolicy
+		if !arc.policyInEffect(key) {
+			// The old policy was outside its schedule so it was never applied.
+			oldPolicy = nil
+		}
 		if update.Value != nil {
 			log.Debugf("Updating ARC for policy %v", key)
 			policy := update.Value.(*model.Policy)
-			if reflect.DeepEqual(oldPolicy, policy) {
+			if reflect.DeepEqual(cachedPolicy, policy) {
 				if log.IsLevelEnabled(log.DebugLevel) {
 					log.WithField("key", update.Key).Debug("No-op policy change; ignoring.")
 				}
@@ -207,37 +226,11 @@ func (arc *ActiveRulesCalculator) OnUpdate(update api.Update) (_ bool) {
 			}
 			arc.allPolicies.Set(key, policy)
 
-			// If the policy transitions to be force-programmed, simulate
-			// a match with a dummy endpoint key.
-			newPolicyForceProgrammed := policyForceProgrammed(policy)
-			if !oldPolicyWasForceProgrammed && newPolicyForceProgrammed {
-				log.Debugf("Policy %v force-programmed.", key)
-				arc.onMatchStarted(key, forceProgrammedDummyKey)
-			}
-
-			// Update the index, which will call us back if the selector no
-			// longer matches.  Note: we can't skip this even if the
-			// policy is force-programmed because we're also responsible
-			// for propagating the notification to the policy resolver.
-			sel, err := selector.Parse(policy.Selector)
-			if err != nil {
-				log.WithError(err).Panic("Failed to parse selector")
-			}
-			arc.labelIndex.UpdateSelector(key, sel)
-
-			// If the policy transitions to not be force-programmed,
-			// remove the dummy match.  We do this after adding the
-			// selector into the index to avoid flapping.
-			if oldPolicyWasForceProgrammed && !newPolicyForceProgrammed {
-				log.Debugf("Policy %v no longer force-programmed.", key)
-				arc.onMatchStopped(key, forceProgrammedDummyKey)
-			}
-
-			if arc.policyIDToEndpointKeys.ContainsKey(key) {
-				// If we get here, the selector still matches something,
-				// update the rules.
-				log.Debug("Policy updated while active, telling listener")
-				arc.sendPolicyUpdate(key, policy)
+			if arc.updatePolicySchedule(key, policy, arc.nowFunc()) {
+				arc.applyPolicy(key, oldPolicy, policy)
+			} else {
+				log.Debugf("Policy %v is outside its schedule.", key)
+				arc.withdrawPolicy(key, oldPolicy)
 			}
 
 			// update ALP policies set.
@@ -249,13 +242,8 @@ func (arc *ActiveRulesCalculator) OnUpdate(update api.Update) (_ bool) {
 		} else {
 			log.Debugf("Removing policy %v from ARC", key)
 			arc.allPolicies.Delete(key)
-			if oldPolicyWasForceProgrammed {
-				log.Debugf("Policy %v being deleted, was force-programmed.", key)
-				arc.onMatchStopped(key, forceProgrammedDummyKey)
-			}
-			arc.labelIndex.DeleteSelector(key)
-			// No need to call updatePolicy() because we'll have got a matchStopped
-			// callback.
+			delete(arc.policySchedules, key)
+			arc.withdrawPolicy(key, oldPolicy)
 
 			// update ALP policies set.
 			if arc.allALPPolicies.Contains(key) {
@@ -283,6 +271,108 @@ func (arc *ActiveRulesCalculator) OnUpdate(update api.Update) (_ bool) {
 	return
 }
 
+// applyPolicy adds the policy's selector to the index so that it becomes active if it matches
+// any local endpoints.  oldPolicy is the previously-applied version of the policy, if any.
+func (arc *ActiveRulesCalculator) applyPolicy(key model.PolicyKey, oldPolicy, policy *model.Policy) {
+	// If the policy transitions to be force-programmed, simulate
+	// a match with a dummy endpoint key.
+	oldPolicyWasForceProgrammed := policyForceProgrammed(oldPolicy)
+	newPolicyForceProgrammed := policyForceProgrammed(policy)
+	if !oldPolicyWasForceProgrammed && newPolicyForceProgrammed {
+		log.Debugf("Policy %v force-programmed.", key)
+		arc.onMatchStarted(key, forceProgrammedDummyKey)
+	}
+
+	// Update the index, which will call us back if the selector no
+	// longer matches.  Note: we can't skip this even if the
+	// policy is force-programmed because we're also responsible
+	// for propagating the notification to the policy resolver.
+	sel, err := selector.Parse(policy.Selector)
+	if err != nil {
+		log.WithError(err).Panic("Failed to parse selector")
+	}
+	arc.labelIndex.UpdateSelector(key, sel)
+
+	// If the policy transitions to not be force-programmed,
+	// remove the dummy match.  We do this after adding the
+	// selector into the index to avoid flapping.
+	if oldPolicyWasForceProgrammed && !newPolicyForceProgrammed {
+		log.Debugf("Policy %v no longer force-programmed.", key)
+		arc.onMatchStopped(key, forceProgrammedDummyKey)
+	}
+
+	if arc.policyIDToEndpointKeys.ContainsKey(key) {
+		// If we get here, the selector still matches something,
+		// update the rules.
+		log.Debug("Policy updated while active, telling listener")
+		arc.sendPolicyUpdate(key, policy)
+	}
+}
+
+// withdrawPolicy removes the policy's selector from the index, making the policy inactive.
+// oldPolicy is the previously-applied version of the policy, if any.
+func (arc *ActiveRulesCalculator) withdrawPolicy(key model.PolicyKey, oldPolicy *model.Policy) {
+	if policyForceProgrammed(oldPolicy) {
+		log.Debugf("Policy %v being withdrawn, was force-programmed.", key)
+		arc.onMatchStopped(key, forceProgrammedDummyKey)
+	}
+	arc.labelIndex.DeleteSelector(key)
+	// No need to call updatePolicy() because we'll have got a matchStopped
+	// callback.
+}
+
+// policyInEffect returns false if the policy has a schedule and is currently outside it.
+func (arc *ActiveRulesCalculator) policyInEffect(key model.PolicyKey) bool {
+	state, ok := arc.policySchedules[key]
+	return !ok || state.inEffect
+}
+
+// updatePolicySchedule evaluates the policy's schedule (if any), records when it next needs
+// to be checked, and returns whether the policy is currently in effect.
+func (arc *ActiveRulesCalculator) updatePolicySchedule(key model.PolicyKey, policy *model.Policy, now time.Time) bool {
+	if policy.Schedule == nil {
+		delete(arc.policySchedules, key)
+		return true
+	}
+	inEffect, next, err := schedule.Evaluate(policy.Schedule, now)
+	if err != nil {
+		// Validation should prevent this; fall back to treating the policy as unscheduled.
+		log.WithError(err).WithField("policy", key).Warn("Failed to evaluate policy schedule, ignoring it.")
+		inEffect, next = true, time.Time{}
+	}
+	arc.policySchedules[key] = &policyScheduleState{inEffect: inEffect, nextCheck: next}
+	return inEffect
+}
+
+// OnScheduleTick re-evaluates the schedules of any policies that are due to be checked,
+// applying or withdrawing policies that have moved into or out of their schedule.  Returns
+// true if any policy changed state.
+func (arc *ActiveRulesCalculator) OnScheduleTick(now time.Time) (changed bool) {
+	for key, state := range arc.policySchedules {
+		if state.nextCheck.IsZero() || now.Before(state.nextCheck) {
+			continue
+		}
+		policy, ok := arc.allPolicies.Get(key)
+		if !ok {
+			log.WithField("policy", key).Panic("Scheduled policy missing from allPolicies.")
+		}
+		wasInEffect := state.inEffect
+		inEffect := arc.updatePolicySchedule(key, policy, now)
+		if inEffect == wasInEffect {
+			continue
+		}
+		changed = true
+		if inEffect {
+			log.WithField("policy", key).Info("Policy schedule started, applying policy.")
+			arc.applyPolicy(key, nil, policy)
+		} else {
+			log.WithField("policy", key).Info("Policy schedule ended, withdrawing policy.")
+			arc.withdrawPolicy(key, policy)
+		}
+	}
+	return
+}
+
 func policyForceProgrammed(policy *model.Policy) bool {
 	if policy == nil {
 		return false
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+type arcRecorder struct {
+	activePolicies map[model.PolicyKey]*model.Policy
+}
+
+func (r *arcRecorder) OnPolicyActive(key model.PolicyKey, policy *model.Policy) {
+	r.activePolicies[key] = policy
+}
+
+func (r *arcRecorder) OnPolicyInactive(key model.PolicyKey) {
+	delete(r.activePolicies, key)
+}
+
+func (r *arcRecorder) OnProfileActive(model.ProfileRulesKey, *model.ProfileRules) {}
+
+func (r *arcRecorder) OnProfileInactive(model.ProfileRulesKey) {}
+
+var _ = Describe("ActiveRulesCalculator policy schedules", func() {
+	var (
+		arc      *ActiveRulesCalculator
+		recorder *arcRecorder
+		now      time.Time
+	)
+
+	// Saturday 1st March 2025; the window below is open from 02:00 to 06:00 every Saturday.
+	saturday := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	polKey := model.PolicyKey{Tier: "default", Name: "maintenance"}
+	scheduledPolicy := func() *model.Policy {
+		return &model.Policy{
+			Selector: "all()",
+			Schedule: &v3.PolicySchedule{
+				Windows: []v3.PolicyScheduleWindow{{
+					Start:    "0 2 * * sat",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				}},
+			},
+		}
+	}
+	updatePolicy := func(policy *model.Policy) {
+		kvp := model.KVPair{Key: polKey}
+		if policy != nil {
+			kvp.Value = policy
+		}
+		arc.OnUpdate(api.Update{KVPair: kvp})
+	}
+
+	BeforeEach(func() {
+		recorder = &arcRecorder{activePolicies: map[model.PolicyKey]*model.Policy{}}
+		arc = NewActiveRulesCalculator()
+		arc.RuleScanner = recorder
+		arc.nowFunc = func() time.Time { return now }
+
+		now = saturday
+		arc.OnUpdate(api.Update{KVPair: model.KVPair{
+			Key: model.WorkloadEndpointKey{
+				Hostname:       "localhost",
+				OrchestratorID: "k8s",
+				WorkloadID:     "default/pod",
+				EndpointID:     "eth0",
+			},
+			Value: &model.WorkloadEndpoint{Labels: map[string]string{"app": "web"}},
+		}})
+	})
+
+	It("should only activate the policy once its window opens", func() {
+		updatePolicy(scheduledPolicy())
+		Expect(recorder.activePolicies).To(BeEmpty())
+
+		Expect(arc.OnScheduleTick(saturday.Add(time.Hour))).To(BeFalse())
+		Expect(recorder.activePolicies).To(BeEmpty())
+
+		Expect(arc.OnScheduleTick(saturday.Add(2 * time.Hour))).To(BeTrue())
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+	})
+
+	It("should deactivate the policy when its window closes and reactivate it next time", func() {
+		now = saturday.Add(3 * time.Hour)
+		updatePolicy(scheduledPolicy())
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+
+		Expect(arc.OnScheduleTick(saturday.Add(5 * time.Hour))).To(BeFalse())
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+
+		Expect(arc.OnScheduleTick(saturday.Add(6 * time.Hour))).To(BeTrue())
+		Expect(recorder.activePolicies).To(BeEmpty())
+
+		Expect(arc.OnScheduleTick(saturday.Add(7*24*time.Hour + 2*time.Hour))).To(BeTrue())
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+	})
+
+	It("should withdraw an expired force-programmed policy", func() {
+		policy := &model.Policy{
+			Selector:         "app == 'db'",
+			PerformanceHints: []v3.PolicyPerformanceHint{v3.PerfHintAssumeNeededOnEveryNode},
+			Schedule: &v3.PolicySchedule{
+				NotAfter: &metav1.Time{Time: saturday.Add(time.Hour)},
+			},
+		}
+		updatePolicy(policy)
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+
+		Expect(arc.OnScheduleTick(saturday.Add(time.Hour))).To(BeTrue())
+		Expect(recorder.activePolicies).To(BeEmpty())
+		Expect(arc.policyIDToEndpointKeys.ContainsKey(polKey)).To(BeFalse())
+	})
+
+	It("should handle a policy's schedule being removed or the policy deleted", func() {
+		updatePolicy(scheduledPolicy())
+		Expect(recorder.activePolicies).To(BeEmpty())
+
+		updatePolicy(&model.Policy{Selector: "all()"})
+		Expect(recorder.activePolicies).To(HaveKey(polKey))
+		Expect(arc.policySchedules).To(BeEmpty())
+
+		updatePolicy(scheduledPolicy())
+		Expect(recorder.activePolicies).To(BeEmpty())
+
+		updatePolicy(nil)
+		Expect(recorder.activePolicies).To(BeEmpty())
+		Expect(arc.policySchedules).To(BeEmpty())
+		Expect(arc.OnScheduleTick(saturday.Add(2 * time.Hour))).To(BeFalse())
+	})
+})
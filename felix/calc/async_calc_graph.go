@@ -31,6 +31,10 @@ import (
 const (
 	tickInterval    = 10 * time.Millisecond
 	leakyBucketSize = 10
+
+	// scheduleInterval is how often we check whether any scheduled policies have moved into or
+	// out of their schedule.
+	scheduleInterval = time.Second
 )
 
 var (
@@ -81,6 +85,7 @@ type AsyncCalcGraph struct {
 
 	flushTicks       <-chan time.Time
 	healthTicks      <-chan time.Time
+	scheduleTicks    <-chan time.Time
 	flushLeakyBucket int
 	dirty            bool
 
@@ -184,6 +189,10 @@ func (acg *AsyncCalcGraph) loop() {
 			}
 		case <-acg.healthTicks:
 			acg.reportHealth()
+		case now := <-acg.scheduleTicks:
+			if acg.CalcGraph.OnScheduleTick(now) {
+				acg.dirty = true
+			}
 		case <-acg.debugHangC:
 			log.Warning("Debug hang simulation timer popped, hanging the calculation graph!!")
 			time.Sleep(1 * time.Hour)
@@ -256,5 +265,6 @@ func (acg *AsyncCalcGraph) Start() {
 	log.Info("Starting AsyncCalcGraph")
 	acg.flushTicks = time.NewTicker(tickInterval).C
 	acg.healthTicks = time.NewTicker(healthInterval).C
+	acg.scheduleTicks = time.NewTicker(scheduleInterval).C
 	go acg.loop()
 }
@@ -15,6 +15,8 @@
 package calc
 
 import (
+	"time"
+
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
@@ -141,6 +143,12 @@ func (g *CalcGraph) OnStatusUpdated(update api.SyncStatus) {
 	g.AllUpdDispatcher.OnStatusUpdated(update)
 }
 
+// OnScheduleTick re-evaluates the schedules of time-windowed policies.  Returns true if any
+// policy moved into or out of its schedule.
+func (g *CalcGraph) OnScheduleTick(now time.Time) bool {
+	return g.activeRulesCalculator.OnScheduleTick(now)
+}
+
 func (g *CalcGraph) Flush() {
 	g.policyResolver.Flush()
 }
@@ -308,7 +316,12 @@ func NewCalculationGraph(
 	ruleScanner.OnIPSetActive = func(ipSet *IPSetData) {
 		log.WithField("ipSet", ipSet).Info("IPSet now active")
 		callbacks.OnIPSetAdded(ipSet.UniqueID(), ipSet.DataplaneProtocolType())
-		if ipSet.Service != "" {
+		if len(ipSet.Domains) > 0 {
+			// Domain IP sets have fixed membership; the dataplane resolves the domains to IPs.
+			for _, domain := range ipSet.Domains {
+				callbacks.OnIPSetMemberAdded(ipSet.UniqueID(), labelindex.IPSetMember{Domain: domain})
+			}
+		} else if ipSet.Service != "" {
 			serviceIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Service)
 		} else {
 			ipsetMemberIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Selector, ipSet.NamedPortProtocol, ipSet.NamedPort)
@@ -317,9 +330,12 @@ func NewCalculationGraph(
 	}
 	ruleScanner.OnIPSetInactive = func(ipSet *IPSetData) {
 		log.WithField("ipSet", ipSet).Info("IPSet now inactive")
-		if ipSet.Service != "" {
+		switch {
+		case len(ipSet.Domains) > 0:
+			// Domain IP sets aren't tracked by an index; removing the IP set removes its members.
+		case ipSet.Service != "":
 			serviceIndex.DeleteIPSet(ipSet.UniqueID())
-		} else {
+		default:
 			ipsetMemberIndex.DeleteIPSet(ipSet.UniqueID())
 		}
 		callbacks.OnIPSetRemoved(ipSet.UniqueID())
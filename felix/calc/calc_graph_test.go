@@ -33,6 +33,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
 )
 
 var testIP = mustParseIP("10.0.0.1")
@@ -372,4 +373,24 @@ var _ = Describe("specific scenario tests", func() {
 
 		Expect(mockDataplane.NumEventsRecorded()).To(Equal(numEventsBeforeSendingDupe))
 	})
+
+	It("should add a node-scoped remote endpoint from Typha to IP sets", func() {
+		validationFilter.OnUpdates(localEp1WithPolicy.KVDeltas(empty))
+		validationFilter.OnStatusUpdated(api.InSync)
+		eventBuf.Flush()
+
+		// Send the remote endpoint as a node-scoped Typha client on this host would receive it.
+		su, err := syncproto.SerializeUpdate(api.Update{
+			KVPair:     model.KVPair{Key: remoteWlEpKey1, Value: &remoteWlEp1, Revision: "1"},
+			UpdateType: api.UpdateTypeKVNew,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		upd, err := su.ScopedToNode(localHostname).ToUpdate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upd.Value.(*model.WorkloadEndpoint).Mac).To(BeNil(), "Expected a slimmed endpoint")
+		validationFilter.OnUpdates([]api.Update{upd})
+		eventBuf.Flush()
+
+		Expect(mockDataplane.IPSets()[allSelectorId].Contains("10.0.0.5/32")).To(BeTrue())
+	})
 })
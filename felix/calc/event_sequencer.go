@@ -820,6 +820,9 @@ func (buf *EventSequencer) flushAddedIPSets() {
 }
 
 func memberToProto(member labelindex.IPSetMember) string {
+	if member.Domain != "" {
+		return member.Domain
+	}
 	switch member.Protocol {
 	case labelindex.ProtocolNone:
 		return member.CIDR.String()
@@ -17,6 +17,7 @@ package calc
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"strings"
 
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
@@ -164,6 +165,41 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		if len(in.HTTPMatch.Methods) > 0 {
 			out.HttpMatch.Methods = in.HTTPMatch.Methods
 		}
+		for _, headerMatch := range in.HTTPMatch.Headers {
+			// Envoy presents header names in lower case.
+			protoMatch := &proto.HTTPMatch_HeaderMatch{Name: strings.ToLower(headerMatch.Name)}
+			if headerMatch.Exact != "" {
+				protoMatch.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Exact{Exact: headerMatch.Exact}
+			} else if headerMatch.Prefix != "" {
+				protoMatch.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: headerMatch.Prefix}
+			} else if headerMatch.Regex != "" {
+				protoMatch.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Regex{Regex: headerMatch.Regex}
+			} else if headerMatch.Present != nil {
+				protoMatch.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Present{Present: *headerMatch.Present}
+			} else {
+				log.WithField("match", headerMatch).Error("Ignoring unknown header match type")
+				continue
+			}
+			out.HttpMatch.Headers = append(out.HttpMatch.Headers, protoMatch)
+		}
+		if len(in.HTTPMatch.Hosts) > 0 {
+			out.HttpMatch.Hosts = in.HTTPMatch.Hosts
+		}
+		for _, grpcMatch := range in.HTTPMatch.GRPCMethods {
+			out.HttpMatch.GrpcMethods = append(out.HttpMatch.GrpcMethods, &proto.HTTPMatch_GRPCMethodMatch{
+				Service: grpcMatch.Service,
+				Method:  grpcMatch.Method,
+			})
+		}
+	}
+
+	if in.RateLimit != nil {
+		out.RateLimit = &proto.RuleRateLimit{
+			ConnectionsPerSecond: in.RateLimit.ConnectionsPerSecond,
+			PacketsPerSecond:     in.RateLimit.PacketsPerSecond,
+			Burst:                in.RateLimit.Burst,
+			PerSourceIp:          in.RateLimit.PerSourceIP,
+		}
 	}
 
 	if in.Metadata != nil {
@@ -38,6 +38,7 @@ var icmpType11 = 11
 var icmpCode13 = 13
 var proto123 = numorstring.ProtocolFromInt(uint8(123))
 var protoTCP = numorstring.ProtocolFromStringV1("tcp")
+var headerPresent = true
 
 var fullyLoadedParsedRule = ParsedRule{
 	Action:    "allow",
@@ -219,6 +220,38 @@ var _ = DescribeTable("ParsedRulesToProtoRules",
 		&proto.Rule{
 			DstIpPortSetIds: []string{"ipPortSetID"},
 		}),
+	Entry("HTTP header, host and gRPC method matches",
+		ParsedRule{
+			HTTPMatch: &model.HTTPMatch{
+				Headers: []v3.HTTPHeaderMatch{
+					{Name: "X-Tenant", Exact: "a"},
+					{Name: "X-Version", Prefix: "v1."},
+					{Name: "X-Request-Id", Regex: "^[0-9]+$"},
+					{Name: "Authorization", Present: &headerPresent},
+				},
+				Hosts:       []string{"api.example.com"},
+				GRPCMethods: []v3.GRPCMethodMatch{{Service: "helloworld.Greeter", Method: "SayHello"}},
+			},
+		},
+		&proto.Rule{
+			HttpMatch: &proto.HTTPMatch{
+				Headers: []*proto.HTTPMatch_HeaderMatch{
+					{Name: "x-tenant", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "a"}},
+					{Name: "x-version", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: "v1."}},
+					{Name: "x-request-id", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Regex{Regex: "^[0-9]+$"}},
+					{Name: "authorization", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Present{Present: true}},
+				},
+				Hosts:       []string{"api.example.com"},
+				GrpcMethods: []*proto.HTTPMatch_GRPCMethodMatch{{Service: "helloworld.Greeter", Method: "SayHello"}},
+			},
+		}),
+	Entry("Rate limited rule",
+		ParsedRule{
+			RateLimit: &model.RuleRateLimit{ConnectionsPerSecond: 10, Burst: 20, PerSourceIP: true},
+		},
+		&proto.Rule{
+			RateLimit: &proto.RuleRateLimit{ConnectionsPerSecond: 10, Burst: 20, PerSourceIp: true},
+		}),
 	Entry("fully-loaded rule",
 		fullyLoadedParsedRule,
 		fullyLoadedProtoRule),
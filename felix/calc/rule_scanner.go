@@ -16,6 +16,7 @@ package calc
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/projectcalico/api/pkg/lib/numorstring"
@@ -100,6 +101,9 @@ type IPSetData struct {
 	// Type of the ip set to represent for this service. This allows us to create service
 	// IP sets with and without port information.
 	ServiceIncludePorts bool
+	// The domain names that this IP set represents, sorted and lower-cased.  The dataplane
+	// resolves these to the IPs that it has learned from DNS.
+	Domains []string
 	// cachedUID holds the calculated unique ID of this IP set, or "" if it hasn't been calculated
 	// yet.
 	cachedUID string
@@ -119,13 +123,19 @@ func (d *IPSetData) String() string {
 	if d.ServiceIncludePorts {
 		parts = append(parts, "serviceIncludePorts=true")
 	}
+	if len(d.Domains) > 0 {
+		parts = append(parts, fmt.Sprintf("domains:%v", d.Domains))
+	}
 	parts = append(parts, fmt.Sprintf("uniqueID:%q", d.UniqueID()))
 	return "IPSetData{" + strings.Join(parts, ", ") + "}"
 }
 
 func (d *IPSetData) UniqueID() string {
 	if d.cachedUID == "" {
-		if d.Service != "" {
+		if len(d.Domains) > 0 {
+			// Domain IP set.
+			d.cachedUID = hash.MakeUniqueID("d", strings.Join(d.Domains, ","))
+		} else if d.Service != "" {
 			// Service based IP set.
 			if d.ServiceIncludePorts {
 				// Service IP set including its ports
@@ -151,6 +161,9 @@ func (d *IPSetData) UniqueID() string {
 // DataplaneProtocolType returns the dataplane driver protocol type of this IP set.
 // One of the proto.IPSetUpdate_IPSetType constants.
 func (d *IPSetData) DataplaneProtocolType() proto.IPSetUpdate_IPSetType {
+	if len(d.Domains) > 0 {
+		return proto.IPSetUpdate_DOMAIN
+	}
 	if d.NamedPortProtocol != labelindex.ProtocolNone {
 		return proto.IPSetUpdate_IP_AND_PORT
 	}
@@ -368,6 +381,8 @@ type ParsedRule struct {
 	// does not implement the match, but other dataplanes such as Dikastes do.
 	HTTPMatch *model.HTTPMatch
 
+	RateLimit *model.RuleRateLimit
+
 	Metadata *model.RuleMetadata
 }
 
@@ -443,6 +458,12 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		srcSelIPSets = append(srcSelIPSets, &IPSetData{Service: svc, ServiceIncludePorts: false})
 	}
 
+	// Domains are rendered as a single IP set that the dataplane fills in with the IPs that
+	// it learns for any of the domains.
+	if len(rule.DstDomains) > 0 {
+		dstSelIPSets = append(dstSelIPSets, &IPSetData{Domains: normaliseDomains(rule.DstDomains)})
+	}
+
 	parsedRule = &ParsedRule{
 		Action: rule.Action,
 
@@ -495,6 +516,7 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		OriginalDstService:                rule.DstService,
 		OriginalDstServiceNamespace:       rule.DstServiceNamespace,
 		HTTPMatch:                         rule.HTTPMatch,
+		RateLimit:                         rule.RateLimit,
 
 		// Pass through metadata (used by iptables backend)
 		Metadata: rule.Metadata,
@@ -513,6 +535,18 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 	return
 }
 
+// normaliseDomains returns a sorted, de-duplicated, lower-case copy of the given domains so that
+// equivalent domain lists share an IP set.
+func normaliseDomains(domains []string) []string {
+	s := set.New[string]()
+	for _, d := range domains {
+		s.Add(strings.ToLower(d))
+	}
+	out := s.Slice()
+	sort.Strings(out)
+	return out
+}
+
 // Converts a list of named ports to a list of IPSets.
 func namedPortsToIPSets(namedPorts []string, positiveSelectors []selector.Selector, proto labelindex.IPSetPortProtocol) []*IPSetData {
 	var ipSets []*IPSetData
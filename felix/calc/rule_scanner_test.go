@@ -50,6 +50,8 @@ var (
 
 	combinedSrcSelsOnlySelID = selectorID("(a == 'b') && !(has(foo3))")
 	combinedDstSelsOnlySelID = selectorID("(b == 'c') && !(d in {'a', 'b'})")
+
+	domainSetID = (&IPSetData{Domains: []string{"*.example.com", "api.example.com"}}).UniqueID()
 )
 
 var _ = DescribeTable("RuleScanner rule conversion should generate correct ParsedRule for",
@@ -162,6 +164,13 @@ var _ = DescribeTable("RuleScanner rule conversion should generate correct Parse
 			OriginalSrcServiceNamespace: "default",
 		}),
 
+	// Domains.
+	Entry("dest domains",
+		model.Rule{DstDomains: []string{"*.Example.com", "api.example.com", "*.example.com"}},
+		ParsedRule{
+			DstIPSetIDs: []string{domainSetID},
+		}),
+
 	// Selectors.
 	Entry("source selector", model.Rule{SrcSelector: sel1}, ParsedRule{SrcIPSetIDs: []string{sel1ID}}),
 	Entry("dest selector", model.Rule{DstSelector: sel1}, ParsedRule{DstIPSetIDs: []string{sel1ID}}),
@@ -237,6 +246,10 @@ var _ = Describe("ParsedRule", func() {
 				// as either IPPortIPSetIDs or IPSetIDs.
 				continue
 			}
+			if name == "DstDomains" {
+				// Domains are rendered on the ParsedRule as a domain IP set in DstIPSetIDs.
+				continue
+			}
 			if strings.HasSuffix(name, "Net") {
 				// Deprecated XXXNet fields.
 				continue
@@ -317,7 +330,7 @@ func (ur *scanUpdateRecorder) OnProfileInactive(key model.ProfileRulesKey) {
 }
 
 func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
@@ -325,7 +338,7 @@ func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
 }
 
 func (ur *scanUpdateRecorder) ipSetInactive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
@@ -51,6 +51,14 @@ func (a *TupleAsFlow) GetHttpPath() *string {
 	return nil
 }
 
+func (a *TupleAsFlow) GetHttpHost() *string {
+	return nil
+}
+
+func (a *TupleAsFlow) GetHttpHeaders() map[string]string {
+	return nil
+}
+
 func (a *TupleAsFlow) GetSourcePrincipal() *string {
 	return nil
 }
@@ -15,11 +15,16 @@
 package collector
 
 import (
+	"maps"
+	"slices"
+
 	log "github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/collector/file"
 	"github.com/projectcalico/calico/felix/collector/flowlog"
 	"github.com/projectcalico/calico/felix/collector/goldmane"
+	"github.com/projectcalico/calico/felix/collector/rulemetrics"
 	"github.com/projectcalico/calico/felix/collector/types"
 	"github.com/projectcalico/calico/felix/config"
 	"github.com/projectcalico/calico/felix/rules"
@@ -29,6 +34,7 @@ import (
 const (
 	// Log dispatcher names
 	FlowLogsGoldmaneReporterName = "goldmane"
+	FlowLogsFileReporterName     = "file"
 )
 
 // New creates the required dataplane stats collector, reporters and aggregators.
@@ -72,36 +78,52 @@ func New(
 			dispatchers[FlowLogsGoldmaneReporterName] = gd
 		}
 	}
+	if configParams.FlowLogsFileEnabled {
+		log.Infof("Creating Flow Logs FileReporter with directory %v", configParams.FlowLogsFileDirectory)
+		dispatchers[FlowLogsFileReporterName] = file.NewReporter(
+			configParams.FlowLogsFileDirectory,
+			configParams.FlowLogsFileMaxFileSizeMB,
+			configParams.FlowLogsFileMaxFiles,
+			configParams.FlowLogsFileMaxAgeDays,
+		)
+	}
 	if len(dispatchers) > 0 {
 		log.Info("Creating Flow Logs Reporter")
 		cw := flowlog.NewReporter(dispatchers, configParams.FlowLogsFlushInterval, healthAggregator)
-		configureFlowAggregation(configParams, cw)
+		configureFlowAggregation(configParams, cw, slices.Sorted(maps.Keys(dispatchers)))
 		statsCollector.RegisterMetricsReporter(cw)
 	}
+	if configParams.PrometheusRuleMetricsEnabled {
+		log.Info("Creating per-rule Prometheus metrics reporter")
+		statsCollector.RegisterMetricsReporter(rulemetrics.New(
+			configParams.PrometheusRuleMetricsMaxRules,
+			configParams.PrometheusRuleMetricsRetention,
+		))
+	}
 
 	return statsCollector
 }
 
 // configureFlowAggregation adds appropriate aggregators to the FlowLogReporter, depending on configuration.
-func configureFlowAggregation(configParams *config.Config, fr *flowlog.FlowLogReporter) {
-	if configParams.FlowLogsGoldmaneServer != "" {
-		log.Info("Creating golemane Aggregator for allowed")
-		gaa := flowlog.NewAggregator().
-			DisplayDebugTraceLogs(configParams.FlowLogsCollectorDebugTrace).
-			IncludeLabels(true).
-			IncludePolicies(true).
-			IncludeService(true).
-			ForAction(rules.RuleActionAllow)
-		log.Info("Adding Flow Logs Aggregator (allowed) for goldmane")
-		fr.AddAggregator(gaa, []string{FlowLogsGoldmaneReporterName})
-		log.Info("Creating goldmane Aggregator for denied")
-		gad := flowlog.NewAggregator().
-			DisplayDebugTraceLogs(configParams.FlowLogsCollectorDebugTrace).
-			IncludeLabels(true).
-			IncludePolicies(true).
-			IncludeService(true).
-			ForAction(rules.RuleActionDeny)
-		log.Info("Adding Flow Logs Aggregator (denied) for goldmane")
-		fr.AddAggregator(gad, []string{FlowLogsGoldmaneReporterName})
-	}
+func configureFlowAggregation(configParams *config.Config, fr *flowlog.FlowLogReporter, dispatchers []string) {
+	// Goldmane and the local file reporter consume the same aggregated flows, so share a single pair
+	// of aggregators between them.
+	log.Info("Creating Flow Logs Aggregator for allowed")
+	aa := flowlog.NewAggregator().
+		DisplayDebugTraceLogs(configParams.FlowLogsCollectorDebugTrace).
+		IncludeLabels(true).
+		IncludePolicies(true).
+		IncludeService(true).
+		ForAction(rules.RuleActionAllow)
+	log.WithField("dispatchers", dispatchers).Info("Adding Flow Logs Aggregator (allowed)")
+	fr.AddAggregator(aa, dispatchers)
+	log.Info("Creating Flow Logs Aggregator for denied")
+	ad := flowlog.NewAggregator().
+		DisplayDebugTraceLogs(configParams.FlowLogsCollectorDebugTrace).
+		IncludeLabels(true).
+		IncludePolicies(true).
+		IncludeService(true).
+		ForAction(rules.RuleActionDeny)
+	log.WithField("dispatchers", dispatchers).Info("Adding Flow Logs Aggregator (denied)")
+	fr.AddAggregator(ad, dispatchers)
 }
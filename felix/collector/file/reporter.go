@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/projectcalico/calico/felix/collector/flowlog"
+	"github.com/projectcalico/calico/felix/collector/utils"
+)
+
+const (
+	// FlowLogFilename is the name of the file that flow logs are written to. Rotated files are
+	// named with a timestamp suffix.
+	FlowLogFilename = "flows.log"
+)
+
+// FileReporter is a flow log dispatcher that writes flow logs to rotating files on the local node,
+// one JSON object per line.
+type FileReporter struct {
+	directory  string
+	maxSizeMB  int
+	maxBackups int
+	maxAgeDays int
+
+	writer *lumberjack.Logger
+}
+
+// NewReporter returns a FileReporter that writes to the given directory.  Rotated files are removed
+// once there are more than maxBackups of them or, if maxAgeDays is non-zero, once they are older
+// than maxAgeDays.
+func NewReporter(directory string, maxSizeMB, maxBackups, maxAgeDays int) *FileReporter {
+	return &FileReporter{
+		directory:  directory,
+		maxSizeMB:  maxSizeMB,
+		maxBackups: maxBackups,
+		maxAgeDays: maxAgeDays,
+	}
+}
+
+// Start creates the flow log directory, if needed. It is safe to call again after a failure.
+func (f *FileReporter) Start() error {
+	if f.writer != nil {
+		return nil
+	}
+	if err := os.MkdirAll(f.directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create flow log directory %s: %w", f.directory, err)
+	}
+	f.writer = &lumberjack.Logger{
+		Filename:   filepath.Join(f.directory, FlowLogFilename),
+		MaxSize:    f.maxSizeMB,
+		MaxBackups: f.maxBackups,
+		MaxAge:     f.maxAgeDays,
+	}
+	return nil
+}
+
+func (f *FileReporter) Report(logSlice any) error {
+	switch logs := logSlice.(type) {
+	case []*flowlog.FlowLog:
+		if f.writer == nil {
+			return fmt.Errorf("flow log file reporter has not been started")
+		}
+		if logrus.IsLevelEnabled(logrus.DebugLevel) {
+			logrus.WithField("num", len(logs)).Debug("Dispatching flow logs to file")
+		}
+		for _, l := range logs {
+			b, err := json.Marshal(ToOutput(l))
+			if err != nil {
+				logrus.WithError(err).Warn("Failed to marshal flow log")
+				continue
+			}
+			// Each line is written separately, so that rotation never splits a flow log across files.
+			if _, err := f.writer.Write(append(b, '\n')); err != nil {
+				logrus.WithError(err).WithField("directory", f.directory).Warn("Failed to write flow logs to file")
+				return err
+			}
+		}
+	default:
+		logrus.Panic("Unexpected kind of log dispatcher")
+	}
+	return nil
+}
+
+// Close closes the current flow log file.
+func (f *FileReporter) Close() error {
+	if f.writer == nil {
+		return nil
+	}
+	return f.writer.Close()
+}
+
+// FlowLogOutput is the JSON representation of a flow log written to file.
+type FlowLogOutput struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+
+	SourceType      string   `json:"source_type"`
+	SourceNamespace string   `json:"source_namespace"`
+	SourceNameAggr  string   `json:"source_name_aggr"`
+	SourceLabels    []string `json:"source_labels"`
+
+	DestType             string   `json:"dest_type"`
+	DestNamespace        string   `json:"dest_namespace"`
+	DestNameAggr         string   `json:"dest_name_aggr"`
+	DestLabels           []string `json:"dest_labels"`
+	DestServiceNamespace string   `json:"dest_service_namespace"`
+	DestServiceName      string   `json:"dest_service_name"`
+	DestServicePortName  string   `json:"dest_service_port_name"`
+	DestServicePortNum   int      `json:"dest_service_port_num"`
+
+	Proto    string `json:"proto"`
+	DestPort int    `json:"dest_port"`
+	Reporter string `json:"reporter"`
+	Action   string `json:"action"`
+
+	EnforcedPolicies []string `json:"enforced_policies"`
+	PendingPolicies  []string `json:"pending_policies"`
+
+	PacketsIn         int `json:"packets_in"`
+	PacketsOut        int `json:"packets_out"`
+	BytesIn           int `json:"bytes_in"`
+	BytesOut          int `json:"bytes_out"`
+	NumFlows          int `json:"num_flows"`
+	NumFlowsStarted   int `json:"num_flows_started"`
+	NumFlowsCompleted int `json:"num_flows_completed"`
+}
+
+// ToOutput converts a FlowLog to its file representation. Labels and policies are sorted so
+// that output is stable.
+func ToOutput(l *flowlog.FlowLog) *FlowLogOutput {
+	return &FlowLogOutput{
+		StartTime: l.StartTime.Unix(),
+		EndTime:   l.EndTime.Unix(),
+
+		SourceType:      string(l.SrcMeta.Type),
+		SourceNamespace: l.SrcMeta.Namespace,
+		SourceNameAggr:  l.SrcMeta.AggregatedName,
+		SourceLabels:    sortedLabels(l.SrcLabels),
+
+		DestType:             string(l.DstMeta.Type),
+		DestNamespace:        l.DstMeta.Namespace,
+		DestNameAggr:         l.DstMeta.AggregatedName,
+		DestLabels:           sortedLabels(l.DstLabels),
+		DestServiceNamespace: l.DstService.Namespace,
+		DestServiceName:      l.DstService.Name,
+		DestServicePortName:  l.DstService.PortName,
+		DestServicePortNum:   l.DstService.PortNum,
+
+		Proto:    utils.ProtoToString(l.Tuple.Proto),
+		DestPort: l.Tuple.L4Dst,
+		Reporter: string(l.Reporter),
+		Action:   string(l.Action),
+
+		EnforcedPolicies: sortedPolicies(l.FlowEnforcedPolicySet),
+		PendingPolicies:  sortedPolicies(l.FlowPendingPolicySet),
+
+		PacketsIn:         l.PacketsIn,
+		PacketsOut:        l.PacketsOut,
+		BytesIn:           l.BytesIn,
+		BytesOut:          l.BytesOut,
+		NumFlows:          l.NumFlows,
+		NumFlowsStarted:   l.NumFlowsStarted,
+		NumFlowsCompleted: l.NumFlowsCompleted,
+	}
+}
+
+func sortedLabels(labels map[string]string) []string {
+	flat := utils.FlattenLabels(labels)
+	slices.Sort(flat)
+	return flat
+}
+
+func sortedPolicies(policies flowlog.FlowPolicySet) []string {
+	out := []string{}
+	for p := range policies {
+		out = append(out, p)
+	}
+	slices.Sort(out)
+	return out
+}
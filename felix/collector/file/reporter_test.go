@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/collector/file"
+	"github.com/projectcalico/calico/felix/collector/flowlog"
+	"github.com/projectcalico/calico/felix/collector/types/endpoint"
+	"github.com/projectcalico/calico/felix/collector/types/tuple"
+)
+
+func newFlowLog(port int) *flowlog.FlowLog {
+	start := time.Unix(1700000000, 0)
+	fl := &flowlog.FlowLog{
+		StartTime: start,
+		EndTime:   start.Add(5 * time.Minute),
+		FlowMeta: flowlog.FlowMeta{
+			Tuple: tuple.Make(flowlog.EmptyIP, flowlog.EmptyIP, 6, -1, port),
+			SrcMeta: endpoint.Metadata{
+				Type:           endpoint.Wep,
+				Namespace:      "ns-client",
+				Name:           flowlog.FieldNotIncluded,
+				AggregatedName: "client-*",
+			},
+			DstMeta: endpoint.Metadata{
+				Type:           endpoint.Wep,
+				Namespace:      "ns-server",
+				Name:           flowlog.FieldNotIncluded,
+				AggregatedName: "server-*",
+			},
+			DstService: flowlog.FlowService{Namespace: "ns-server", Name: "server", PortName: "http", PortNum: 80},
+			Action:     flowlog.ActionAllow,
+			Reporter:   flowlog.ReporterDst,
+		},
+		FlowLabels: flowlog.FlowLabels{
+			SrcLabels: map[string]string{"app": "client", "env": "prod"},
+			DstLabels: map[string]string{"app": "server"},
+		},
+		FlowEnforcedPolicySet: flowlog.FlowPolicySet{
+			"0|default|ns-server/default.allow-clients|allow|0": {},
+		},
+	}
+	fl.PacketsIn = 10
+	fl.BytesIn = 1000
+	fl.NumFlows = 1
+	fl.NumFlowsStarted = 1
+	return fl
+}
+
+func TestReport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := filepath.Join(t.TempDir(), "flowlogs")
+	r := file.NewReporter(dir, 100, 5, 7)
+	defer r.Close()
+
+	// Reporting before the reporter is started should fail.
+	g.Expect(r.Report([]*flowlog.FlowLog{newFlowLog(80)})).To(HaveOccurred())
+
+	// Starting the reporter should create the directory.
+	g.Expect(r.Start()).NotTo(HaveOccurred())
+	g.Expect(dir).To(BeADirectory())
+
+	g.Expect(r.Report([]*flowlog.FlowLog{newFlowLog(80), newFlowLog(8080)})).NotTo(HaveOccurred())
+	g.Expect(r.Report([]*flowlog.FlowLog{newFlowLog(443)})).NotTo(HaveOccurred())
+
+	f, err := os.Open(filepath.Join(dir, file.FlowLogFilename))
+	g.Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+
+	var written []file.FlowLogOutput
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var out file.FlowLogOutput
+		g.Expect(json.Unmarshal(scanner.Bytes(), &out)).To(Succeed())
+		written = append(written, out)
+	}
+	g.Expect(scanner.Err()).NotTo(HaveOccurred())
+	g.Expect(written).To(HaveLen(3))
+
+	g.Expect(written[0]).To(Equal(file.FlowLogOutput{
+		StartTime:            1700000000,
+		EndTime:              1700000300,
+		SourceType:           "wep",
+		SourceNamespace:      "ns-client",
+		SourceNameAggr:       "client-*",
+		SourceLabels:         []string{"app=client", "env=prod"},
+		DestType:             "wep",
+		DestNamespace:        "ns-server",
+		DestNameAggr:         "server-*",
+		DestLabels:           []string{"app=server"},
+		DestServiceNamespace: "ns-server",
+		DestServiceName:      "server",
+		DestServicePortName:  "http",
+		DestServicePortNum:   80,
+		Proto:                "tcp",
+		DestPort:             80,
+		Reporter:             "dst",
+		Action:               "allow",
+		EnforcedPolicies:     []string{"0|default|ns-server/default.allow-clients|allow|0"},
+		PendingPolicies:      []string{},
+		PacketsIn:            10,
+		BytesIn:              1000,
+		NumFlows:             1,
+		NumFlowsStarted:      1,
+	}))
+	g.Expect(written[1].DestPort).To(Equal(8080))
+	g.Expect(written[2].DestPort).To(Equal(443))
+}
+
+func TestRotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	r := file.NewReporter(dir, 1, 2, 0)
+	defer r.Close()
+	g.Expect(r.Start()).NotTo(HaveOccurred())
+
+	// Write several MB of flow logs. Only the current file and two rotated files should be retained,
+	// none of which exceed the maximum size.
+	logs := make([]*flowlog.FlowLog, 1000)
+	for i := range logs {
+		logs[i] = newFlowLog(i)
+	}
+	for range 10 {
+		g.Expect(r.Report(logs)).NotTo(HaveOccurred())
+	}
+
+	// Old files are removed in the background.
+	g.Eventually(func() ([]os.DirEntry, error) {
+		return os.ReadDir(dir)
+	}, "5s", "100ms").Should(HaveLen(3))
+
+	entries, err := os.ReadDir(dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, e := range entries {
+		info, err := e.Info()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(info.Size()).To(BeNumerically("<=", 1024*1024))
+	}
+}
+
+func TestMaxAge(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// Rotated files are timestamped in their names, which is what the maximum age is checked against.
+	dir := t.TempDir()
+	backupName := func(age time.Duration) string {
+		return filepath.Join(dir, "flows-"+time.Now().Add(-age).UTC().Format("2006-01-02T15-04-05.000")+".log")
+	}
+	oldFile := backupName(10 * 24 * time.Hour)
+	recentFile := backupName(time.Hour)
+	for _, name := range []string{oldFile, recentFile} {
+		g.Expect(os.WriteFile(name, []byte("{}\n"), 0o644)).To(Succeed())
+	}
+
+	r := file.NewReporter(dir, 100, 5, 7)
+	defer r.Close()
+	g.Expect(r.Start()).NotTo(HaveOccurred())
+	g.Expect(r.Report([]*flowlog.FlowLog{newFlowLog(80)})).NotTo(HaveOccurred())
+
+	// Old files are removed in the background.
+	g.Eventually(oldFile, "5s", "100ms").ShouldNot(BeAnExistingFile())
+	g.Expect(recentFile).To(BeAnExistingFile())
+	g.Expect(filepath.Join(dir, file.FlowLogFilename)).To(BeAnExistingFile())
+}
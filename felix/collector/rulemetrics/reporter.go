@@ -0,0 +1,222 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rulemetrics reports Prometheus counters of the traffic matched by each policy rule, using the
+// metric updates generated by the collector.
+package rulemetrics
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/collector/types"
+	"github.com/projectcalico/calico/felix/collector/types/metric"
+	"github.com/projectcalico/calico/felix/collector/types/tuple"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+var (
+	ruleLabels    = []string{"tier", "namespace", "policy", "rule_direction", "rule_index", "action"}
+	trafficLabels = append(append([]string{}, ruleLabels...), "traffic_direction")
+
+	counterRulePackets = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_policy_rule_packets_total",
+		Help: "Number of packets that matched a policy rule, by traffic direction relative to the local endpoint.",
+	}, trafficLabels)
+	counterRuleBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_policy_rule_bytes_total",
+		Help: "Number of bytes that matched a policy rule, by traffic direction relative to the local endpoint.",
+	}, trafficLabels)
+	counterRuleConnections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_policy_rule_connections_total",
+		Help: "Number of connections that matched a policy rule.",
+	}, ruleLabels)
+	gaugeRulesTracked = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "felix_policy_rule_metrics_rules",
+		Help: "Number of policy rules that per-rule metrics are currently reported for.",
+	})
+	counterRuleUpdatesDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_policy_rule_metrics_dropped_total",
+		Help: "Number of rule matches not counted because the maximum number of rules was reached.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(counterRulePackets)
+	prometheus.MustRegister(counterRuleBytes)
+	prometheus.MustRegister(counterRuleConnections)
+	prometheus.MustRegister(gaugeRulesTracked)
+	prometheus.MustRegister(counterRuleUpdatesDropped)
+}
+
+// ruleKey identifies a policy rule, and holds its label values.
+type ruleKey struct {
+	tier      string
+	namespace string
+	policy    string
+	direction string
+	index     string
+	action    string
+}
+
+func newRuleKey(r *calc.RuleID) ruleKey {
+	return ruleKey{
+		tier:      r.TierString(),
+		namespace: r.Namespace,
+		policy:    r.NameString(),
+		direction: r.DirectionString(),
+		index:     r.IndexStr,
+		action:    r.ActionString(),
+	}
+}
+
+func (k ruleKey) labels() []string {
+	return []string{k.tier, k.namespace, k.policy, k.direction, k.index, k.action}
+}
+
+func (k ruleKey) trafficLabels(dir types.TrafficDirection) []string {
+	return append(k.labels(), dir.String())
+}
+
+// connKey identifies a connection as seen by the rules in one direction. A connection between two
+// local endpoints is reported separately for the egress rules of its source and the ingress rules of
+// its destination.
+type connKey struct {
+	tuple     tuple.Tuple
+	direction rules.RuleDir
+}
+
+// Reporter is a metrics reporter that maintains Prometheus counters of the packets, bytes and connections
+// that matched each policy rule. The number of rules is bounded, and series for rules that have not
+// matched traffic within the retention period are removed.
+type Reporter struct {
+	maxRules  int
+	retention time.Duration
+
+	// Allow the time function to be mocked for test purposes.
+	nowFn func() time.Time
+
+	once  sync.Once
+	lock  sync.Mutex
+	rules map[ruleKey]time.Time
+	conns map[connKey]struct{}
+}
+
+func New(maxRules int, retention time.Duration) *Reporter {
+	return &Reporter{
+		maxRules:  maxRules,
+		retention: retention,
+		nowFn:     time.Now,
+		rules:     map[ruleKey]time.Time{},
+		conns:     map[connKey]struct{}{},
+	}
+}
+
+func (r *Reporter) Start() error {
+	r.once.Do(func() {
+		go r.run()
+	})
+	return nil
+}
+
+func (r *Reporter) run() {
+	ticker := time.NewTicker(r.retention / 2)
+	defer ticker.Stop()
+	for range ticker.C {
+		r.Expire()
+	}
+}
+
+func (r *Reporter) Report(u any) error {
+	mu, ok := u.(metric.Update)
+	if !ok {
+		return fmt.Errorf("invalid metric update")
+	}
+	if len(mu.RuleIDs) == 0 {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Count each connection once, the first time it is reported.
+	newConn := false
+	if mu.IsConnection {
+		ck := connKey{tuple: mu.Tuple, direction: mu.RuleIDs[0].Direction}
+		if _, ok := r.conns[ck]; !ok {
+			r.conns[ck] = struct{}{}
+			newConn = true
+		}
+		if mu.UpdateType == metric.UpdateTypeExpire {
+			delete(r.conns, ck)
+		}
+	}
+
+	now := r.nowFn()
+	for _, rid := range mu.RuleIDs {
+		k := newRuleKey(rid)
+		if _, ok := r.rules[k]; !ok {
+			if len(r.rules) >= r.maxRules {
+				counterRuleUpdatesDropped.Inc()
+				continue
+			}
+			log.WithField("rule", rid).Debug("Reporting metrics for new rule")
+		}
+		r.rules[k] = now
+
+		// Every rule in the path applies to the whole flow, including any pass rules in earlier tiers.
+		r.count(k, types.TrafficDirInbound, mu.InMetric)
+		r.count(k, types.TrafficDirOutbound, mu.OutMetric)
+		if newConn {
+			counterRuleConnections.WithLabelValues(k.labels()...).Inc()
+		}
+	}
+	gaugeRulesTracked.Set(float64(len(r.rules)))
+	return nil
+}
+
+func (r *Reporter) count(k ruleKey, dir types.TrafficDirection, v metric.Value) {
+	if v.DeltaPackets == 0 && v.DeltaBytes == 0 {
+		return
+	}
+	labels := k.trafficLabels(dir)
+	counterRulePackets.WithLabelValues(labels...).Add(float64(v.DeltaPackets))
+	counterRuleBytes.WithLabelValues(labels...).Add(float64(v.DeltaBytes))
+}
+
+// Expire removes the series for rules that have not matched traffic within the retention period.
+func (r *Reporter) Expire() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	cutoff := r.nowFn().Add(-r.retention)
+	for k, lastSeen := range r.rules {
+		if lastSeen.After(cutoff) {
+			continue
+		}
+		log.WithField("rule", k).Debug("Expiring metrics for rule")
+		for _, dir := range []types.TrafficDirection{types.TrafficDirInbound, types.TrafficDirOutbound} {
+			counterRulePackets.DeleteLabelValues(k.trafficLabels(dir)...)
+			counterRuleBytes.DeleteLabelValues(k.trafficLabels(dir)...)
+		}
+		counterRuleConnections.DeleteLabelValues(k.labels()...)
+		delete(r.rules, k)
+	}
+	gaugeRulesTracked.Set(float64(len(r.rules)))
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulemetrics
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/collector/types"
+	"github.com/projectcalico/calico/felix/collector/types/metric"
+	"github.com/projectcalico/calico/felix/collector/types/tuple"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+var (
+	passRule  = calc.NewRuleID("platform", "pass-all", "", 0, rules.RuleDirIngress, rules.RuleActionPass)
+	allowRule = calc.NewRuleID("default", "allow-web", "ns1", 2, rules.RuleDirIngress, rules.RuleActionAllow)
+	denyRule  = calc.NewRuleID("default", "deny-all", "", 0, rules.RuleDirEgress, rules.RuleActionDeny)
+
+	tuple1 = tuple.Make([16]byte{10, 0, 0, 1}, [16]byte{10, 0, 0, 2}, 6, 40000, 80)
+	tuple2 = tuple.Make([16]byte{10, 0, 0, 1}, [16]byte{10, 0, 0, 2}, 6, 40001, 80)
+)
+
+func newUpdate(t tuple.Tuple, ut metric.UpdateType, packets int, rids ...*calc.RuleID) metric.Update {
+	return metric.Update{
+		UpdateType:   ut,
+		Tuple:        t,
+		IsConnection: true,
+		RuleIDs:      rids,
+		InMetric:     metric.Value{DeltaPackets: packets, DeltaBytes: 100 * packets},
+		OutMetric:    metric.Value{DeltaPackets: 2 * packets, DeltaBytes: 200 * packets},
+	}
+}
+
+func packets(rid *calc.RuleID, dir types.TrafficDirection) float64 {
+	return testutil.ToFloat64(counterRulePackets.WithLabelValues(newRuleKey(rid).trafficLabels(dir)...))
+}
+
+func bytes(rid *calc.RuleID, dir types.TrafficDirection) float64 {
+	return testutil.ToFloat64(counterRuleBytes.WithLabelValues(newRuleKey(rid).trafficLabels(dir)...))
+}
+
+func connections(rid *calc.RuleID) float64 {
+	return testutil.ToFloat64(counterRuleConnections.WithLabelValues(newRuleKey(rid).labels()...))
+}
+
+func reset() {
+	counterRulePackets.Reset()
+	counterRuleBytes.Reset()
+	counterRuleConnections.Reset()
+}
+
+func TestReport(t *testing.T) {
+	g := NewGomegaWithT(t)
+	defer reset()
+
+	r := New(10, time.Minute)
+
+	// Two reports for one connection, and one for another, all passed by the first tier and allowed by the second.
+	g.Expect(r.Report(newUpdate(tuple1, metric.UpdateTypeReport, 1, passRule, allowRule))).To(Succeed())
+	g.Expect(r.Report(newUpdate(tuple1, metric.UpdateTypeReport, 2, passRule, allowRule))).To(Succeed())
+	g.Expect(r.Report(newUpdate(tuple2, metric.UpdateTypeExpire, 3, passRule, allowRule))).To(Succeed())
+
+	for _, rid := range []*calc.RuleID{passRule, allowRule} {
+		g.Expect(packets(rid, types.TrafficDirInbound)).To(Equal(6.0))
+		g.Expect(packets(rid, types.TrafficDirOutbound)).To(Equal(12.0))
+		g.Expect(bytes(rid, types.TrafficDirInbound)).To(Equal(600.0))
+		g.Expect(bytes(rid, types.TrafficDirOutbound)).To(Equal(1200.0))
+		g.Expect(connections(rid)).To(Equal(2.0))
+	}
+
+	// The first connection expiring should not count it again.
+	g.Expect(r.Report(newUpdate(tuple1, metric.UpdateTypeExpire, 0, passRule, allowRule))).To(Succeed())
+	g.Expect(connections(allowRule)).To(Equal(2.0))
+	g.Expect(r.conns).To(BeEmpty())
+
+	// Denied packets that don't form a connection are counted, but not as connections.
+	u := newUpdate(tuple1, metric.UpdateTypeReport, 5, denyRule)
+	u.IsConnection = false
+	u.OutMetric = metric.Value{}
+	g.Expect(r.Report(u)).To(Succeed())
+	g.Expect(packets(denyRule, types.TrafficDirInbound)).To(Equal(5.0))
+	g.Expect(connections(denyRule)).To(Equal(0.0))
+
+	g.Expect(r.Report("not an update")).To(HaveOccurred())
+}
+
+func TestMaxRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	defer reset()
+
+	r := New(1, time.Minute)
+	g.Expect(r.Report(newUpdate(tuple1, metric.UpdateTypeReport, 1, allowRule))).To(Succeed())
+
+	// A second rule should not be tracked, but traffic for the first should still be counted.
+	dropped := testutil.ToFloat64(counterRuleUpdatesDropped)
+	g.Expect(r.Report(newUpdate(tuple2, metric.UpdateTypeReport, 1, passRule, allowRule))).To(Succeed())
+	g.Expect(testutil.ToFloat64(counterRuleUpdatesDropped)).To(Equal(dropped + 1))
+	g.Expect(r.rules).To(HaveLen(1))
+	g.Expect(packets(allowRule, types.TrafficDirInbound)).To(Equal(2.0))
+	g.Expect(testutil.CollectAndCount(counterRuleConnections)).To(Equal(1))
+}
+
+func TestExpire(t *testing.T) {
+	g := NewGomegaWithT(t)
+	defer reset()
+
+	now := time.Unix(1700000000, 0)
+	r := New(1, time.Minute)
+	r.nowFn = func() time.Time { return now }
+
+	g.Expect(r.Report(newUpdate(tuple1, metric.UpdateTypeReport, 1, allowRule))).To(Succeed())
+	g.Expect(testutil.CollectAndCount(counterRulePackets)).To(Equal(2))
+
+	// The rule is within the retention period, so should not be removed.
+	now = now.Add(30 * time.Second)
+	r.Expire()
+	g.Expect(testutil.CollectAndCount(counterRulePackets)).To(Equal(2))
+
+	// Once the rule has been idle for the retention period, its series should be removed, freeing space
+	// for another rule.
+	now = now.Add(time.Minute)
+	r.Expire()
+	g.Expect(r.rules).To(BeEmpty())
+	g.Expect(testutil.CollectAndCount(counterRulePackets)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(counterRuleBytes)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(counterRuleConnections)).To(Equal(0))
+
+	g.Expect(r.Report(newUpdate(tuple2, metric.UpdateTypeReport, 1, denyRule))).To(Succeed())
+	g.Expect(r.rules).To(HaveLen(1))
+}
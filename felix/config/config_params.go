@@ -287,6 +287,13 @@ type Config struct {
 	// TyphaURISAN URI SAN to use when authenticating to Typha over TLS. If any TLS parameters are specified then one of
 	// TyphaCN and TyphaURISAN must be set.
 	TyphaURISAN string `config:"string;;local"`
+	// TyphaNodeScopedUpdates asks Typha to send full workload endpoints only for this node.  Other nodes' workload
+	// endpoints are received as a slimmed projection that only contains what is needed to calculate IP set
+	// membership, which reduces Felix's memory usage in large clusters.  Older Typha versions ignore this setting.
+	TyphaNodeScopedUpdates bool `config:"bool;false;local"`
+	// TyphaReconnect tells Felix to reconnect to Typha, and try to resume its session, if its connection to Typha
+	// fails.  When disabled, Felix restarts if it loses its connection to Typha.
+	TyphaReconnect bool `config:"bool;true;local"`
 
 	Ipv6Support bool `config:"bool;true"`
 
@@ -396,12 +403,15 @@ type Config struct {
 	HealthHost             string                   `config:"host-address;localhost"`
 	HealthTimeoutOverrides map[string]time.Duration `config:"keydurationlist;;"`
 
-	PrometheusMetricsEnabled          bool   `config:"bool;false"`
-	PrometheusMetricsHost             string `config:"host-address;"`
-	PrometheusMetricsPort             int    `config:"int(0:65535);9091"`
-	PrometheusGoMetricsEnabled        bool   `config:"bool;true"`
-	PrometheusProcessMetricsEnabled   bool   `config:"bool;true"`
-	PrometheusWireGuardMetricsEnabled bool   `config:"bool;true"`
+	PrometheusMetricsEnabled          bool          `config:"bool;false"`
+	PrometheusMetricsHost             string        `config:"host-address;"`
+	PrometheusMetricsPort             int           `config:"int(0:65535);9091"`
+	PrometheusGoMetricsEnabled        bool          `config:"bool;true"`
+	PrometheusProcessMetricsEnabled   bool          `config:"bool;true"`
+	PrometheusWireGuardMetricsEnabled bool          `config:"bool;true"`
+	PrometheusRuleMetricsEnabled      bool          `config:"bool;false"`
+	PrometheusRuleMetricsMaxRules     int           `config:"int(1:2147483647);1000"`
+	PrometheusRuleMetricsRetention    time.Duration `config:"seconds(1:86400);600"`
 
 	FailsafeInboundHostPorts  []ProtoPort `config:"port-list;tcp:22,udp:68,tcp:179,tcp:2379,tcp:2380,tcp:5473,tcp:6443,tcp:6666,tcp:6667;die-on-fail"`
 	FailsafeOutboundHostPorts []ProtoPort `config:"port-list;udp:53,udp:67,tcp:179,tcp:2379,tcp:2380,tcp:5473,tcp:6443,tcp:6666,tcp:6667;die-on-fail"`
@@ -410,6 +420,16 @@ type Config struct {
 	FlowLogsCollectorDebugTrace  bool          `config:"bool;false"`
 	FlowLogsGoldmaneServer       string        `config:"string;"`
 	FlowLogsPolicyEvaluationMode string        `config:"oneof(None,Continuous);Continuous"`
+	FlowLogsFileEnabled          bool          `config:"bool;false"`
+	FlowLogsFileDirectory        string        `config:"file;/var/log/calico/flowlogs"`
+	FlowLogsFileMaxFileSizeMB    int           `config:"int;100"`
+	FlowLogsFileMaxFiles         int           `config:"int;5"`
+	FlowLogsFileMaxAgeDays       int           `config:"int;7"`
+
+	DNSPolicyEnabled  bool          `config:"bool;false"`
+	DNSTrustedServers []ServerPort  `config:"server-list;"`
+	DNSCacheFile      string        `config:"file;/var/run/calico/felix-dns-cache.json"`
+	DNSExtraTTL       time.Duration `config:"seconds(0:86400);0"`
 
 	KubeNodePortRanges []numorstring.Port `config:"portrange-list;30000:32767"`
 	NATPortRange       numorstring.Port   `config:"portrange;"`
@@ -536,7 +556,13 @@ func (config *Config) TableRefreshInterval() time.Duration {
 }
 
 func (config *Config) FlowLogsEnabled() bool {
-	return config.FlowLogsGoldmaneServer != ""
+	return config.FlowLogsGoldmaneServer != "" || config.FlowLogsFileEnabled
+}
+
+// CollectorEnabled returns true if Felix needs to collect per-flow statistics from the dataplane, either to
+// generate flow logs or to report per-rule metrics.
+func (config *Config) CollectorEnabled() bool {
+	return config.FlowLogsEnabled() || config.PrometheusRuleMetricsEnabled
 }
 
 // Copy makes a copy of the object.  Internal state is deep copied but config parameters are only shallow copied.
@@ -740,6 +740,13 @@ var _ = DescribeTable("Config validation",
 		"TyphaCN":       "typha-peer",
 		"TyphaURISAN":   "spiffe://k8s.example.com/typha-peer",
 	}, true),
+	Entry("DNS policy in iptables mode", map[string]string{
+		"DNSPolicyEnabled": "true",
+	}, true),
+	Entry("DNS policy in BPF mode", map[string]string{
+		"DNSPolicyEnabled": "true",
+		"BPFEnabled":       "true",
+	}, true),
 	Entry("valid OpenstackRegion", map[string]string{
 		"OpenstackRegion": "region1",
 	}, true),
@@ -394,7 +394,7 @@ configRetry:
 	var lookupsCache *calc.LookupsCache
 	var dpStatsCollector collector.Collector
 
-	if configParams.FlowLogsEnabled() {
+	if configParams.CollectorEnabled() {
 		// Initialzed the lookup cache here and pass it along to both the calc_graph
 		// as well as dataplane driver, which actually uses this for lookups.
 		lookupsCache = calc.NewLookupsCache()
@@ -547,6 +547,8 @@ configRetry:
 				CAFile:       configParams.TyphaCAFile,
 				ServerCN:     configParams.TyphaCN,
 				ServerURISAN: configParams.TyphaURISAN,
+				Reconnect:    configParams.TyphaReconnect,
+				NodeScoped:   configParams.TyphaNodeScopedUpdates,
 			},
 		)
 	} else {
@@ -0,0 +1,486 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+const (
+	// Version of the persisted cache file format.
+	saveFileVersion = "1"
+
+	defaultSaveInterval   = 10 * time.Second
+	defaultExpiryInterval = time.Second
+)
+
+// DomainInfoChangeHandler is implemented by components (the IP sets managers) that need to
+// hear when the IPs for a domain name have changed.
+type DomainInfoChangeHandler interface {
+	// OnDomainChange is called, on the dataplane goroutine, when the IPs for the given
+	// (lower-cased) name have changed.  It returns true if the dataplane needs to be synced.
+	OnDomainChange(name string) (dataplaneSyncNeeded bool)
+}
+
+type Config struct {
+	// SaveFile is the file that the store persists its mappings to, so that they survive a
+	// Felix restart.  If empty, the mappings are not persisted.
+	SaveFile string
+	// SaveInterval is how often the mappings are persisted.
+	SaveInterval time.Duration
+	// ExtraTTL is added to the TTL of each DNS record, to allow for clients that use a
+	// cached IP after its TTL has expired.
+	ExtraTTL time.Duration
+}
+
+// DomainInfoStore learns the IPs for domain names from snooped DNS responses and remembers them
+// until their TTL expires.  DNS packets are fed in, from any goroutine, via ProcessPacket.  When
+// a name's IPs change, the store signals on UpdatesReadyChannel; the dataplane goroutine then
+// calls HandleUpdates to pass the changes on to the registered handlers.
+type DomainInfoStore struct {
+	config Config
+
+	mutex sync.Mutex
+	// mappings maps from a lower-cased domain name to the IPs and CNAME targets that it has
+	// been seen to resolve to.
+	mappings map[string]*nameData
+	// revMappings maps from a CNAME target to the set of names that have that target.
+	revMappings map[string]set.Set[string]
+	// changedNames holds the names whose IPs have changed since the last HandleUpdates call.
+	changedNames set.Set[string]
+	needsSave    bool
+
+	updatesReady chan struct{}
+	handlers     []DomainInfoChangeHandler
+
+	nowFunc func() time.Time
+}
+
+type nameData struct {
+	// ips maps from IP address to the expiry time of the record that gave it.
+	ips map[string]time.Time
+	// cnames maps from CNAME target to the expiry time of the record that gave it.
+	cnames map[string]time.Time
+}
+
+func NewDomainInfoStore(config Config) *DomainInfoStore {
+	if config.SaveInterval == 0 {
+		config.SaveInterval = defaultSaveInterval
+	}
+	return &DomainInfoStore{
+		config:       config,
+		mappings:     map[string]*nameData{},
+		revMappings:  map[string]set.Set[string]{},
+		changedNames: set.New[string](),
+		updatesReady: make(chan struct{}, 1),
+		nowFunc:      time.Now,
+	}
+}
+
+// RegisterHandler adds a handler for domain changes.  It must be called before Start.
+func (s *DomainInfoStore) RegisterHandler(h DomainInfoChangeHandler) {
+	s.handlers = append(s.handlers, h)
+}
+
+// Start loads any persisted mappings and then starts the background goroutine that expires old
+// mappings and periodically persists the current ones.
+func (s *DomainInfoStore) Start() {
+	if err := s.loadMappings(); err != nil {
+		log.WithError(err).Warning("Failed to load persisted DNS mappings")
+	}
+	go s.loop()
+}
+
+func (s *DomainInfoStore) loop() {
+	expiryTicker := time.NewTicker(defaultExpiryInterval)
+	defer expiryTicker.Stop()
+	saveTicker := time.NewTicker(s.config.SaveInterval)
+	defer saveTicker.Stop()
+	for {
+		select {
+		case <-expiryTicker.C:
+			s.expireMappings()
+		case <-saveTicker.C:
+			if err := s.saveMappingsIfNeeded(); err != nil {
+				log.WithError(err).Warning("Failed to persist DNS mappings")
+			}
+		}
+	}
+}
+
+// UpdatesReadyChannel returns a channel that receives a value when there are domain changes
+// waiting to be handled by HandleUpdates.
+func (s *DomainInfoStore) UpdatesReadyChannel() <-chan struct{} {
+	return s.updatesReady
+}
+
+// HandleUpdates passes pending domain changes to the registered handlers.  It returns true if
+// any handler needs a dataplane sync.
+func (s *DomainInfoStore) HandleUpdates() (dataplaneSyncNeeded bool) {
+	s.mutex.Lock()
+	changed := s.changedNames
+	s.changedNames = set.New[string]()
+	s.mutex.Unlock()
+
+	changed.Iter(func(name string) error {
+		for _, h := range s.handlers {
+			if h.OnDomainChange(name) {
+				dataplaneSyncNeeded = true
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// GetDomainIPs returns the IPs that are currently known for the given domain, following any
+// CNAMEs.  The domain may be a wildcard of the form "*.example.com", in which case the IPs of all
+// known subdomains are returned.
+func (s *DomainInfoStore) GetDomainIPs(domain string) []string {
+	domain = strings.ToLower(domain)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ips := set.New[string]()
+	if strings.HasPrefix(domain, "*.") {
+		for name := range s.mappings {
+			if MatchDomain(domain, name) {
+				s.collectIPs(name, ips, set.New[string]())
+			}
+		}
+	} else {
+		s.collectIPs(domain, ips, set.New[string]())
+	}
+	return ips.Slice()
+}
+
+func (s *DomainInfoStore) collectIPs(name string, ips set.Set[string], visited set.Set[string]) {
+	if visited.Contains(name) {
+		// CNAME loop.
+		return
+	}
+	visited.Add(name)
+	data := s.mappings[name]
+	if data == nil {
+		return
+	}
+	for ip := range data.ips {
+		ips.Add(ip)
+	}
+	for target := range data.cnames {
+		s.collectIPs(target, ips, visited)
+	}
+}
+
+// ProcessPacket parses a snooped IP packet and, if it is a successful DNS response, stores the
+// A, AAAA and CNAME records from it.
+func (s *DomainInfoStore) ProcessPacket(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	var firstLayer gopacket.LayerType
+	switch data[0] >> 4 {
+	case 4:
+		firstLayer = layers.LayerTypeIPv4
+	case 6:
+		firstLayer = layers.LayerTypeIPv6
+	default:
+		log.Debug("Ignoring snooped packet that isn't IPv4 or IPv6")
+		return
+	}
+	packet := gopacket.NewPacket(data, firstLayer, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	if tcp, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP); ok {
+		s.processTCPPayload(tcp.Payload)
+		return
+	}
+	dnsLayer, ok := packet.Layer(layers.LayerTypeDNS).(*layers.DNS)
+	if !ok {
+		log.Debug("Ignoring snooped packet that isn't DNS")
+		return
+	}
+	s.processDNS(dnsLayer)
+}
+
+// processTCPPayload handles a snooped DNS-over-TCP segment.  Over TCP, each DNS message is
+// preceded by its 2-byte length.  We only handle messages that fit in a single segment; we don't
+// reassemble the stream.
+func (s *DomainInfoStore) processTCPPayload(payload []byte) {
+	if len(payload) < 2 {
+		// Handshake, ACK, etc.
+		return
+	}
+	msgLen := int(binary.BigEndian.Uint16(payload))
+	if len(payload) < 2+msgLen {
+		log.Debug("Ignoring DNS-over-TCP message that spans more than one segment")
+		return
+	}
+	var msg layers.DNS
+	if err := msg.DecodeFromBytes(payload[2:2+msgLen], gopacket.NilDecodeFeedback); err != nil {
+		log.WithError(err).Debug("Ignoring snooped TCP packet that isn't DNS")
+		return
+	}
+	s.processDNS(&msg)
+}
+
+func (s *DomainInfoStore) processDNS(msg *layers.DNS) {
+	if !msg.QR || msg.ResponseCode != layers.DNSResponseCodeNoErr {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, rr := range append(msg.Answers, msg.Additionals...) {
+		name := strings.ToLower(string(rr.Name))
+		switch rr.Type {
+		case layers.DNSTypeA, layers.DNSTypeAAAA:
+			if rr.IP == nil {
+				continue
+			}
+			s.storeIP(name, rr.IP.String(), rr.TTL)
+		case layers.DNSTypeCNAME:
+			s.storeCNAME(name, strings.ToLower(string(rr.CNAME)), rr.TTL)
+		}
+	}
+}
+
+func (s *DomainInfoStore) expiryTime(ttl uint32) time.Time {
+	return s.nowFunc().Add(time.Duration(ttl)*time.Second + s.config.ExtraTTL)
+}
+
+func (s *DomainInfoStore) getOrCreateNameData(name string) *nameData {
+	data := s.mappings[name]
+	if data == nil {
+		data = &nameData{ips: map[string]time.Time{}, cnames: map[string]time.Time{}}
+		s.mappings[name] = data
+	}
+	return data
+}
+
+func (s *DomainInfoStore) storeIP(name, ip string, ttl uint32) {
+	s.storeIPWithExpiry(name, ip, s.expiryTime(ttl))
+}
+
+func (s *DomainInfoStore) storeIPWithExpiry(name, ip string, expiry time.Time) {
+	data := s.getOrCreateNameData(name)
+	if oldExpiry, known := data.ips[ip]; known {
+		if expiry.After(oldExpiry) {
+			data.ips[ip] = expiry
+			s.needsSave = true
+		}
+		return
+	}
+	log.WithFields(log.Fields{"name": name, "ip": ip}).Debug("Learned new IP for domain")
+	data.ips[ip] = expiry
+	s.needsSave = true
+	s.markChanged(name)
+}
+
+func (s *DomainInfoStore) storeCNAME(name, target string, ttl uint32) {
+	s.storeCNAMEWithExpiry(name, target, s.expiryTime(ttl))
+}
+
+func (s *DomainInfoStore) storeCNAMEWithExpiry(name, target string, expiry time.Time) {
+	data := s.getOrCreateNameData(name)
+	if oldExpiry, known := data.cnames[target]; known {
+		if expiry.After(oldExpiry) {
+			data.cnames[target] = expiry
+			s.needsSave = true
+		}
+		return
+	}
+	log.WithFields(log.Fields{"name": name, "cname": target}).Debug("Learned new CNAME for domain")
+	data.cnames[target] = expiry
+	if s.revMappings[target] == nil {
+		s.revMappings[target] = set.New[string]()
+	}
+	s.revMappings[target].Add(name)
+	s.needsSave = true
+	s.markChanged(name)
+}
+
+// markChanged records that the IPs for the given name have changed, along with those of any
+// names that reach it through CNAMEs, and signals that updates are ready.
+func (s *DomainInfoStore) markChanged(name string) {
+	s.markChangedRecursive(name, set.New[string]())
+	select {
+	case s.updatesReady <- struct{}{}:
+	default:
+	}
+}
+
+func (s *DomainInfoStore) markChangedRecursive(name string, visited set.Set[string]) {
+	if visited.Contains(name) {
+		return
+	}
+	visited.Add(name)
+	s.changedNames.Add(name)
+	if parents := s.revMappings[name]; parents != nil {
+		parents.Iter(func(parent string) error {
+			s.markChangedRecursive(parent, visited)
+			return nil
+		})
+	}
+}
+
+func (s *DomainInfoStore) expireMappings() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.nowFunc()
+	for name, data := range s.mappings {
+		changed := false
+		for ip, expiry := range data.ips {
+			if now.After(expiry) {
+				delete(data.ips, ip)
+				changed = true
+			}
+		}
+		for target, expiry := range data.cnames {
+			if now.After(expiry) {
+				delete(data.cnames, target)
+				if parents := s.revMappings[target]; parents != nil {
+					parents.Discard(name)
+					if parents.Len() == 0 {
+						delete(s.revMappings, target)
+					}
+				}
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		log.WithField("name", name).Debug("DNS mapping(s) expired")
+		s.needsSave = true
+		s.markChanged(name)
+		if len(data.ips) == 0 && len(data.cnames) == 0 {
+			delete(s.mappings, name)
+		}
+	}
+}
+
+type savedMapping struct {
+	Name   string    `json:"name"`
+	Value  string    `json:"value"`
+	Type   string    `json:"type"`
+	Expiry time.Time `json:"expiry"`
+}
+
+type savedMappings struct {
+	Version  string         `json:"version"`
+	Mappings []savedMapping `json:"mappings"`
+}
+
+const (
+	savedTypeIP    = "ip"
+	savedTypeCNAME = "cname"
+)
+
+func (s *DomainInfoStore) saveMappingsIfNeeded() error {
+	if s.config.SaveFile == "" {
+		return nil
+	}
+	s.mutex.Lock()
+	if !s.needsSave {
+		s.mutex.Unlock()
+		return nil
+	}
+	saved := savedMappings{Version: saveFileVersion}
+	for name, data := range s.mappings {
+		for ip, expiry := range data.ips {
+			saved.Mappings = append(saved.Mappings, savedMapping{Name: name, Value: ip, Type: savedTypeIP, Expiry: expiry})
+		}
+		for target, expiry := range data.cnames {
+			saved.Mappings = append(saved.Mappings, savedMapping{Name: name, Value: target, Type: savedTypeCNAME, Expiry: expiry})
+		}
+	}
+	s.needsSave = false
+	s.mutex.Unlock()
+
+	buf, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.config.SaveFile), 0o755); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename so that we never leave a partial file behind.
+	tmpFile := s.config.SaveFile + ".tmp"
+	if err := os.WriteFile(tmpFile, buf, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, s.config.SaveFile)
+}
+
+func (s *DomainInfoStore) loadMappings() error {
+	if s.config.SaveFile == "" {
+		return nil
+	}
+	buf, err := os.ReadFile(s.config.SaveFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var saved savedMappings
+	if err := json.Unmarshal(buf, &saved); err != nil {
+		return err
+	}
+	if saved.Version != saveFileVersion {
+		log.WithField("version", saved.Version).Warning("Ignoring DNS mappings file with unknown version")
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.nowFunc()
+	for _, m := range saved.Mappings {
+		if now.After(m.Expiry) {
+			continue
+		}
+		switch m.Type {
+		case savedTypeIP:
+			if net.ParseIP(m.Value) == nil {
+				continue
+			}
+			s.storeIPWithExpiry(m.Name, m.Value, m.Expiry)
+		case savedTypeCNAME:
+			s.storeCNAMEWithExpiry(m.Name, m.Value, m.Expiry)
+		}
+	}
+	log.WithField("numMappings", len(saved.Mappings)).Info("Loaded persisted DNS mappings")
+	return nil
+}
+
+// MatchDomain returns true if the given (lower-cased) name matches the given domain pattern.  A
+// pattern of the form "*.example.com" matches any subdomain of example.com, but not example.com
+// itself; any other pattern must match exactly.
+func MatchDomain(pattern, name string) bool {
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return len(name) > len(suffix) && strings.HasSuffix(name, suffix)
+	}
+	return pattern == name
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"encoding/binary"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type recordingHandler struct {
+	changes []string
+}
+
+func (h *recordingHandler) OnDomainChange(name string) bool {
+	h.changes = append(h.changes, name)
+	return true
+}
+
+func dnsResponse(answers ...layers.DNSResourceRecord) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP("10.96.0.10"),
+		DstIP:    net.ParseIP("10.65.0.2"),
+	}
+	udp := &layers.UDP{SrcPort: 53, DstPort: 40000}
+	Expect(udp.SetNetworkLayerForChecksum(ip)).To(Succeed())
+	dns := &layers.DNS{
+		ID:           1,
+		QR:           true,
+		ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers:      answers,
+		ANCount:      uint16(len(answers)),
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	Expect(gopacket.SerializeLayers(buf, opts, ip, udp, dns)).To(Succeed())
+	return buf.Bytes()
+}
+
+// dnsResponseTCP returns a DNS response in a single TCP segment, with the 2-byte length prefix
+// that DNS uses over TCP.
+func dnsResponseTCP(answers ...layers.DNSResourceRecord) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP("10.96.0.10"),
+		DstIP:    net.ParseIP("10.65.0.2"),
+	}
+	tcp := &layers.TCP{SrcPort: 53, DstPort: 40000, ACK: true, PSH: true, Window: 1024}
+	Expect(tcp.SetNetworkLayerForChecksum(ip)).To(Succeed())
+	dns := &layers.DNS{
+		ID:           1,
+		QR:           true,
+		ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers:      answers,
+		ANCount:      uint16(len(answers)),
+	}
+	msg := gopacket.NewSerializeBuffer()
+	Expect(dns.SerializeTo(msg, gopacket.SerializeOptions{FixLengths: true})).To(Succeed())
+	payload := binary.BigEndian.AppendUint16(nil, uint16(len(msg.Bytes())))
+	payload = append(payload, msg.Bytes()...)
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	Expect(gopacket.SerializeLayers(buf, opts, ip, tcp, gopacket.Payload(payload))).To(Succeed())
+	return buf.Bytes()
+}
+
+func aRecord(name, ip string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeA,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		IP:    net.ParseIP(ip).To4(),
+	}
+}
+
+func cnameRecord(name, target string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeCNAME,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		CNAME: []byte(target),
+	}
+}
+
+var _ = Describe("DomainInfoStore", func() {
+	var (
+		store   *DomainInfoStore
+		handler *recordingHandler
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		store = NewDomainInfoStore(Config{ExtraTTL: 5 * time.Second})
+		now = time.Now()
+		store.nowFunc = func() time.Time { return now }
+		handler = &recordingHandler{}
+		store.RegisterHandler(handler)
+	})
+
+	It("should learn A records from a DNS response", func() {
+		store.ProcessPacket(dnsResponse(aRecord("API.example.com", "1.2.3.4", 30)))
+		Expect(store.GetDomainIPs("api.example.com")).To(ConsistOf("1.2.3.4"))
+		Expect(store.UpdatesReadyChannel()).To(Receive())
+		Expect(store.HandleUpdates()).To(BeTrue())
+		Expect(handler.changes).To(ConsistOf("api.example.com"))
+	})
+
+	It("should learn A records from a DNS response over TCP", func() {
+		store.ProcessPacket(dnsResponseTCP(aRecord("api.example.com", "1.2.3.4", 30)))
+		Expect(store.GetDomainIPs("api.example.com")).To(ConsistOf("1.2.3.4"))
+	})
+
+	It("should follow CNAMEs and report changes to the aliasing name", func() {
+		store.ProcessPacket(dnsResponse(cnameRecord("api.example.com", "edge.cdn.net", 30)))
+		store.HandleUpdates()
+		handler.changes = nil
+
+		store.ProcessPacket(dnsResponse(aRecord("edge.cdn.net", "5.6.7.8", 30)))
+		Expect(store.GetDomainIPs("api.example.com")).To(ConsistOf("5.6.7.8"))
+		store.HandleUpdates()
+		Expect(handler.changes).To(ConsistOf("api.example.com", "edge.cdn.net"))
+	})
+
+	It("should return the IPs of all subdomains for a wildcard", func() {
+		store.ProcessPacket(dnsResponse(
+			aRecord("a.example.com", "1.1.1.1", 30),
+			aRecord("b.c.example.com", "2.2.2.2", 30),
+			aRecord("example.com", "3.3.3.3", 30),
+		))
+		Expect(store.GetDomainIPs("*.example.com")).To(ConsistOf("1.1.1.1", "2.2.2.2"))
+	})
+
+	It("should expire IPs after their TTL plus the extra TTL", func() {
+		store.ProcessPacket(dnsResponse(aRecord("api.example.com", "1.2.3.4", 30)))
+		store.HandleUpdates()
+		handler.changes = nil
+
+		now = now.Add(34 * time.Second)
+		store.expireMappings()
+		Expect(store.GetDomainIPs("api.example.com")).To(ConsistOf("1.2.3.4"))
+		Expect(handler.changes).To(BeEmpty())
+
+		now = now.Add(2 * time.Second)
+		store.expireMappings()
+		Expect(store.GetDomainIPs("api.example.com")).To(BeEmpty())
+		store.HandleUpdates()
+		Expect(handler.changes).To(ConsistOf("api.example.com"))
+	})
+
+	It("should persist and reload its mappings", func() {
+		store.config.SaveFile = filepath.Join(GinkgoT().TempDir(), "dns-cache.json")
+		store.ProcessPacket(dnsResponse(
+			cnameRecord("api.example.com", "edge.cdn.net", 30),
+			aRecord("edge.cdn.net", "5.6.7.8", 30),
+		))
+		Expect(store.saveMappingsIfNeeded()).To(Succeed())
+
+		newStore := NewDomainInfoStore(Config{SaveFile: store.config.SaveFile})
+		Expect(newStore.loadMappings()).To(Succeed())
+		Expect(newStore.GetDomainIPs("api.example.com")).To(ConsistOf("5.6.7.8"))
+	})
+
+	It("should ignore DNS queries", func() {
+		data := dnsResponse(aRecord("api.example.com", "1.2.3.4", 30))
+		packet := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+		msg := packet.Layer(layers.LayerTypeDNS).(*layers.DNS)
+		msg.QR = false
+		store.processDNS(msg)
+		Expect(store.GetDomainIPs("api.example.com")).To(BeEmpty())
+	})
+})
+
+var _ = DescribeTable("MatchDomain",
+	func(pattern, name string, expected bool) {
+		Expect(MatchDomain(pattern, name)).To(Equal(expected))
+	},
+	Entry("exact match", "api.example.com", "api.example.com", true),
+	Entry("exact mismatch", "api.example.com", "www.example.com", false),
+	Entry("wildcard subdomain", "*.example.com", "api.example.com", true),
+	Entry("wildcard nested subdomain", "*.example.com", "a.b.example.com", true),
+	Entry("wildcard apex", "*.example.com", "example.com", false),
+	Entry("wildcard suffix without dot", "*.example.com", "badexample.com", false),
+)
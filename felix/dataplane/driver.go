@@ -218,9 +218,11 @@ func StartDataplaneDriver(
 				NetlinkTimeout:    configParams.NetlinkTimeoutSecs,
 			},
 			RulesConfig: rules.Config{
-				FlowLogsEnabled:       configParams.FlowLogsEnabled(),
+				FlowLogsEnabled:       configParams.CollectorEnabled(),
 				NFTables:              configParams.NFTablesMode == "Enabled",
 				WorkloadIfacePrefixes: configParams.InterfacePrefixes(),
+				DNSPolicyEnabled:      configParams.DNSPolicyEnabled,
+				DNSTrustedServers:     configParams.DNSTrustedServers,
 
 				IPSetConfigV4: ipsets.NewIPVersionConfig(
 					ipsets.IPFamilyV4,
@@ -411,7 +413,9 @@ func StartDataplaneDriver(
 			KubernetesProvider: configParams.KubernetesProvider(),
 			Collector:          collector,
 			LookupsCache:       lc,
-			FlowLogsEnabled:    configParams.FlowLogsEnabled(),
+			FlowLogsEnabled:    configParams.CollectorEnabled(),
+			DNSCacheFile:       configParams.DNSCacheFile,
+			DNSExtraTTL:        configParams.DNSExtraTTL,
 		}
 
 		if configParams.BPFExternalServiceMode == "dsr" {
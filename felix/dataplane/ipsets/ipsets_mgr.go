@@ -17,6 +17,7 @@ package ipsets
 import (
 	log "github.com/sirupsen/logrus"
 
+	"github.com/projectcalico/calico/felix/dataplane/dns"
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
@@ -36,6 +37,13 @@ type IPSetsDataplane interface {
 	SetFilter(neededIPSets set.Set[string])
 }
 
+// DomainInfoStore provides the IPs that have been learned for domain names.
+type DomainInfoStore interface {
+	// GetDomainIPs returns the IPs for the given domain, which may be a wildcard of the form
+	// "*.example.com".
+	GetDomainIPs(domain string) []string
+}
+
 // Except for domain IP sets, IPSetsManager simply passes through IP set updates from the datastore
 // to the ipsets.IPSets dataplane layer.  For domain IP sets - which hereafter we'll just call
 // "domain sets" - IPSetsManager handles the resolution from domain names to expiring IPs.
@@ -43,12 +51,23 @@ type IPSetsManager struct {
 	dataplanes []IPSetsDataplane
 	maxSize    int
 	lg         *log.Entry
+
+	// domainInfoStore resolves domain names to IPs.  If nil, domain sets are programmed
+	// with no members.
+	domainInfoStore DomainInfoStore
+	// domainSetIDToDomains maps from domain set ID to the domain names that it contains.
+	domainSetIDToDomains map[string]set.Set[string]
+	// domainSetIDToIPs maps from domain set ID to the IPs that we've programmed for it.
+	domainSetIDToIPs map[string]set.Set[string]
 }
 
-func NewIPSetsManager(name string, ipsets_ IPSetsDataplane, maxIPSetSize int) *IPSetsManager {
+func NewIPSetsManager(name string, ipsets_ IPSetsDataplane, maxIPSetSize int, domainInfoStore DomainInfoStore) *IPSetsManager {
 	m := &IPSetsManager{
-		maxSize: maxIPSetSize,
-		lg:      log.WithField("name", name),
+		maxSize:              maxIPSetSize,
+		lg:                   log.WithField("name", name),
+		domainInfoStore:      domainInfoStore,
+		domainSetIDToDomains: map[string]set.Set[string]{},
+		domainSetIDToIPs:     map[string]set.Set[string]{},
 	}
 
 	if ipsets_ != nil {
@@ -87,12 +106,21 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 	// IP set-related messages, these are extremely common.
 	case *proto.IPSetDeltaUpdate:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set delta update")
+		if domains, ok := m.domainSetIDToDomains[msg.Id]; ok {
+			domains.AddAll(msg.AddedMembers)
+			for _, d := range msg.RemovedMembers {
+				domains.Discard(d)
+			}
+			m.updateDomainSetMembers(msg.Id)
+			return
+		}
 		for _, dp := range m.dataplanes {
 			dp.AddMembers(msg.Id, msg.AddedMembers)
 			dp.RemoveMembers(msg.Id, msg.RemovedMembers)
 		}
 	case *proto.IPSetUpdate:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set update")
+		members := msg.Members
 		var setType ipsets.IPSetType
 		switch msg.Type {
 		case proto.IPSetUpdate_IP:
@@ -101,6 +129,14 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 			setType = ipsets.IPSetTypeHashNet
 		case proto.IPSetUpdate_IP_AND_PORT:
 			setType = ipsets.IPSetTypeHashIPPort
+		case proto.IPSetUpdate_DOMAIN:
+			// The members of a domain set are domain names; program the IPs that we've
+			// learned for them instead.
+			setType = ipsets.IPSetTypeHashIP
+			m.domainSetIDToDomains[msg.Id] = set.FromArray(msg.Members)
+			ips := m.resolveDomains(msg.Id)
+			m.domainSetIDToIPs[msg.Id] = ips
+			members = ips.Slice()
 		default:
 			m.lg.WithField("type", msg.Type).Panic("Unknown IP set type")
 		}
@@ -110,16 +146,84 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 			MaxSize: m.maxSize,
 		}
 		for _, dp := range m.dataplanes {
-			dp.AddOrReplaceIPSet(metadata, msg.Members)
+			dp.AddOrReplaceIPSet(metadata, members)
 		}
 	case *proto.IPSetRemove:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set remove")
+		delete(m.domainSetIDToDomains, msg.Id)
+		delete(m.domainSetIDToIPs, msg.Id)
 		for _, dp := range m.dataplanes {
 			dp.RemoveIPSet(msg.Id)
 		}
 	}
 }
 
+// OnDomainChange is called when the IPs for the given domain name have changed.  It updates
+// the members of any domain sets that match the name.
+func (m *IPSetsManager) OnDomainChange(name string) (dataplaneSyncNeeded bool) {
+	for setID, domains := range m.domainSetIDToDomains {
+		matched := false
+		domains.Iter(func(domain string) error {
+			if dns.MatchDomain(domain, name) {
+				matched = true
+				return set.StopIteration
+			}
+			return nil
+		})
+		if matched && m.updateDomainSetMembers(setID) {
+			dataplaneSyncNeeded = true
+		}
+	}
+	return
+}
+
+// resolveDomains returns the IPs that the given domain set should currently contain.
+func (m *IPSetsManager) resolveDomains(setID string) set.Set[string] {
+	ips := set.New[string]()
+	if m.domainInfoStore == nil {
+		return ips
+	}
+	m.domainSetIDToDomains[setID].Iter(func(domain string) error {
+		ips.AddAll(m.domainInfoStore.GetDomainIPs(domain))
+		return nil
+	})
+	return ips
+}
+
+// updateDomainSetMembers re-resolves the given domain set and programs any changes to its IPs.
+// It returns true if the IPs changed.
+func (m *IPSetsManager) updateDomainSetMembers(setID string) bool {
+	oldIPs := m.domainSetIDToIPs[setID]
+	newIPs := m.resolveDomains(setID)
+	var added, removed []string
+	newIPs.Iter(func(ip string) error {
+		if !oldIPs.Contains(ip) {
+			added = append(added, ip)
+		}
+		return nil
+	})
+	oldIPs.Iter(func(ip string) error {
+		if !newIPs.Contains(ip) {
+			removed = append(removed, ip)
+		}
+		return nil
+	})
+	if len(added) == 0 && len(removed) == 0 {
+		return false
+	}
+	m.lg.WithFields(log.Fields{
+		"setID":   setID,
+		"added":   added,
+		"removed": removed,
+	}).Debug("Domain set IPs changed")
+	for _, dp := range m.dataplanes {
+		dp.AddMembers(setID, added)
+		dp.RemoveMembers(setID, removed)
+	}
+	m.domainSetIDToIPs[setID] = newIPs
+	return true
+}
+
 func (m *IPSetsManager) CompleteDeferredWork() error {
 	// Nothing to do, we don't defer any work.
 	return nil
@@ -43,13 +43,15 @@ var ipsetsMgrTestCases = []IPSetsMgrTestCase{
 
 var _ = Describe("IP Sets manager", func() {
 	var (
-		ipsetsMgr *IPSetsManager
-		ipSets    *MockIPSets
+		ipsetsMgr   *IPSetsManager
+		ipSets      *MockIPSets
+		domainStore *mockDomainInfoStore
 	)
 
 	BeforeEach(func() {
 		ipSets = NewMockIPSets()
-		ipsetsMgr = NewIPSetsManager("ipv4", ipSets, 1024)
+		domainStore = &mockDomainInfoStore{ips: map[string][]string{}}
+		ipsetsMgr = NewIPSetsManager("ipv4", ipSets, 1024, domainStore)
 	})
 
 	// Generic assumptions used during tests. Having them here reduces code duplication and improves readability.
@@ -144,4 +146,52 @@ var _ = Describe("IP Sets manager", func() {
 	for _, testCase := range ipsetsMgrTestCases {
 		IPsetsMgrTest1(testCase.ipsetID, testCase.ipsetType, testCase.ipsetMembers)
 	}
+
+	Describe("after creating a domain set", func() {
+		BeforeEach(func() {
+			domainStore.ips["api.example.com"] = []string{"10.0.0.1"}
+			domainStore.ips["*.example.org"] = []string{"10.0.0.2", "10.0.0.3"}
+			ipsetsMgr.OnUpdate(&proto.IPSetUpdate{
+				Id:      "d:1",
+				Members: []string{"api.example.com", "*.example.org"},
+				Type:    proto.IPSetUpdate_DOMAIN,
+			})
+		})
+
+		AssertIPSetMembers("d:1", []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"})
+
+		It("should add and remove IPs when a matching domain changes", func() {
+			domainStore.ips["*.example.org"] = []string{"10.0.0.3", "10.0.0.4"}
+			Expect(ipsetsMgr.OnDomainChange("www.example.org")).To(BeTrue())
+			Expect(ipSets.Members["d:1"]).To(Equal(set.From("10.0.0.1", "10.0.0.3", "10.0.0.4")))
+		})
+
+		It("should ignore changes to other domains", func() {
+			domainStore.ips["*.example.org"] = []string{"10.0.0.4"}
+			Expect(ipsetsMgr.OnDomainChange("example.org")).To(BeFalse())
+			Expect(ipSets.Members["d:1"]).To(Equal(set.From("10.0.0.1", "10.0.0.2", "10.0.0.3")))
+		})
+
+		It("should handle a delta update to the domains", func() {
+			ipsetsMgr.OnUpdate(&proto.IPSetDeltaUpdate{
+				Id:             "d:1",
+				RemovedMembers: []string{"*.example.org"},
+			})
+			Expect(ipSets.Members["d:1"]).To(Equal(set.From("10.0.0.1")))
+		})
+
+		It("should stop tracking the domain set after it is removed", func() {
+			ipsetsMgr.OnUpdate(&proto.IPSetRemove{Id: "d:1"})
+			Expect(ipsetsMgr.OnDomainChange("api.example.com")).To(BeFalse())
+			Expect(ipSets.Members).NotTo(HaveKey("d:1"))
+		})
+	})
 })
+
+type mockDomainInfoStore struct {
+	ips map[string][]string
+}
+
+func (s *mockDomainInfoStore) GetDomainIPs(domain string) []string {
+	return s.ips[domain]
+}
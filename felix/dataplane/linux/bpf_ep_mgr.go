@@ -3798,6 +3798,7 @@ func (m *bpfEndpointManager) loadPolicyProgram(
 	if m.FlowLogsEnabled() {
 		opts = append(opts, polprog.WithFlowLogs())
 	}
+	opts = append(opts, polprog.WithRateLimitMap(m.commonMaps.RateLimitMap.MapFD()))
 
 	pg := polprog.NewBuilder(
 		ipSetIDAlloc,
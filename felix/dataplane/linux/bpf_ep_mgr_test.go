@@ -45,6 +45,7 @@ import (
 	bpfmaps "github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/mock"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
 	"github.com/projectcalico/calico/felix/bpf/state"
 	"github.com/projectcalico/calico/felix/bpf/tc"
 	"github.com/projectcalico/calico/felix/bpf/xdp"
@@ -403,6 +404,7 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		countersMap = mock.NewMockMap(cparams)
 		commonMaps.CountersMap = countersMap
 		commonMaps.RuleCountersMap = mock.NewMockMap(counters.PolicyMapParameters)
+		commonMaps.RateLimitMap = mock.NewMockMap(ratelimit.MapParameters)
 
 		progsParams := bpfmaps.MapParameters{
 			Type:       "prog_array",
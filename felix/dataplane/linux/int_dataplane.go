@@ -58,6 +58,7 @@ import (
 	"github.com/projectcalico/calico/felix/config"
 	felixconfig "github.com/projectcalico/calico/felix/config"
 	"github.com/projectcalico/calico/felix/dataplane/common"
+	"github.com/projectcalico/calico/felix/dataplane/dns"
 	dpsets "github.com/projectcalico/calico/felix/dataplane/ipsets"
 	"github.com/projectcalico/calico/felix/dataplane/linux/dataplanedefs"
 	"github.com/projectcalico/calico/felix/environment"
@@ -72,6 +73,7 @@ import (
 	"github.com/projectcalico/calico/felix/linkaddrs"
 	"github.com/projectcalico/calico/felix/logutils"
 	"github.com/projectcalico/calico/felix/netlinkshim"
+	"github.com/projectcalico/calico/felix/nfnetlink"
 	"github.com/projectcalico/calico/felix/nftables"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routerule"
@@ -250,6 +252,11 @@ type Config struct {
 	LookupsCache     *calc.LookupsCache
 	FlowLogsEnabled  bool
 
+	// DNS policy related fields.  Whether DNS policy is enabled at all is controlled by
+	// RulesConfig.DNSPolicyEnabled.
+	DNSCacheFile string
+	DNSExtraTTL  time.Duration
+
 	ServiceLoopPrevention string
 
 	LookPathOverride func(file string) (string, error)
@@ -379,6 +386,10 @@ type InternalDataplane struct {
 	ipsetsSourceV4    ipsetsSource
 	callbacks         *common.Callbacks
 
+	// domainInfoStore learns the IPs for domains from snooped DNS responses.  Nil if DNS
+	// policy is disabled.
+	domainInfoStore *dns.DomainInfoStore
+
 	loopSummarizer *logutils.Summarizer
 
 	// Fields used to accumulate counts of messages of various types before we report them to
@@ -742,8 +753,21 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		// bpffs so there's nothing to clean up
 	}
 
-	ipsetsManager := dpsets.NewIPSetsManager("ipv4", ipSetsV4, config.MaxIPSetSize)
-	ipsetsManagerV6 := dpsets.NewIPSetsManager("ipv6", nil, config.MaxIPSetSize)
+	var domainInfoStore dpsets.DomainInfoStore
+	if config.RulesConfig.DNSPolicyEnabled {
+		dp.domainInfoStore = dns.NewDomainInfoStore(dns.Config{
+			SaveFile: config.DNSCacheFile,
+			ExtraTTL: config.DNSExtraTTL,
+		})
+		domainInfoStore = dp.domainInfoStore
+	}
+
+	ipsetsManager := dpsets.NewIPSetsManager("ipv4", ipSetsV4, config.MaxIPSetSize, domainInfoStore)
+	ipsetsManagerV6 := dpsets.NewIPSetsManager("ipv6", nil, config.MaxIPSetSize, domainInfoStore)
+	if dp.domainInfoStore != nil {
+		dp.domainInfoStore.RegisterHandler(ipsetsManager)
+		dp.domainInfoStore.RegisterHandler(ipsetsManagerV6)
+	}
 
 	var mangleTableV6, natTableV6, rawTableV6, filterTableV6 generictables.Table
 	var nftablesV6RootTable *nftables.NftablesTable
@@ -1565,12 +1589,32 @@ func (d *InternalDataplane) Start() {
 	d.doStaticDataplaneConfig()
 
 	// Then, start the worker threads.
+	if d.domainInfoStore != nil {
+		d.domainInfoStore.Start()
+		d.startDNSSnooping()
+	}
 	go d.loopUpdatingDataplane()
 	go d.loopReportingStatus()
 	go d.ifaceMonitor.MonitorInterfaces()
 	go d.monitorHostMTU()
 }
 
+// startDNSSnooping subscribes to the NFLOG group that our static rules copy DNS responses to, and
+// feeds the responses to the domain info store.
+func (d *InternalDataplane) startDNSSnooping() {
+	packets := make(chan []byte, 1000)
+	err := nfnetlink.SubscribeDNS(int(rules.NFLOGDomainGroup), d.config.NfNetlinkBufSize, packets, nil)
+	if err != nil {
+		log.WithError(err).Error("Failed to subscribe to DNS NFLOG group; domain rules won't learn new IPs.")
+		return
+	}
+	go func() {
+		for packet := range packets {
+			d.domainInfoStore.ProcessPacket(packet)
+		}
+	}()
+}
+
 // onIfaceInSync is used as a callback from the interface monitor.  We use it to send a message back to
 // the main goroutine via a channel.
 func (d *InternalDataplane) onIfaceInSync() {
@@ -1707,17 +1751,23 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 
 	rulesConfig := d.config.RulesConfig
 	for _, t := range d.filterTables {
-		fwdRules := []generictables.Rule{
-			{
-				// Bypass is a strong signal from the BPF program, it means that the flow is approved
-				// by the program at both ingress and egress.
-				Comment: []string{"Pre-approved by BPF programs."},
-				Match:   d.newMatch().MarkMatchesWithMask(tcdefs.MarkSeenBypass, tcdefs.MarkSeenBypassMask),
-				Action:  d.actions.Allow(),
-			},
+		var fwdRules, inputRules, outputRules []generictables.Rule
+
+		// The BPF programs send flows to the trusted DNS servers through the host namespace so
+		// that we can snoop the responses.  Do that before anything accepts them.
+		for _, prefix := range rulesConfig.WorkloadIfacePrefixes {
+			snoopRules := d.ruleRenderer.DNSSnoopRules(t.IPVersion(), prefix+wildcard)
+			fwdRules = append(fwdRules, snoopRules...)
+			outputRules = append(outputRules, snoopRules...)
 		}
 
-		var inputRules, outputRules []generictables.Rule
+		fwdRules = append(fwdRules, generictables.Rule{
+			// Bypass is a strong signal from the BPF program, it means that the flow is approved
+			// by the program at both ingress and egress.
+			Comment: []string{"Pre-approved by BPF programs."},
+			Match:   d.newMatch().MarkMatchesWithMask(tcdefs.MarkSeenBypass, tcdefs.MarkSeenBypassMask),
+			Action:  d.actions.Allow(),
+		})
 
 		// Handle packets for flows that pre-date the BPF programs.  The BPF program doesn't have any conntrack
 		// state for these so it allows them to fall through to iptables with a mark set.
@@ -2079,12 +2129,21 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 	throttleC := jitter.NewTicker(100*time.Millisecond, 10*time.Millisecond).Channel()
 	beingThrottled := false
 
+	var domainUpdatesC <-chan struct{}
+	if d.domainInfoStore != nil {
+		domainUpdatesC = d.domainInfoStore.UpdatesReadyChannel()
+	}
+
 	for {
 		select {
 		case msg := <-d.toDataplane:
 			d.onDatastoreMessage(msg)
 		case ifaceUpdate := <-d.ifaceUpdates:
 			d.onIfaceMonitorMessage(ifaceUpdate)
+		case <-domainUpdatesC:
+			if d.domainInfoStore.HandleUpdates() {
+				d.dataplaneNeedsSync = true
+			}
 		case name := <-d.vxlanParentC:
 			d.vxlanManager.OnParentNameUpdate(name)
 		case name := <-d.vxlanParentCV6:
@@ -2707,6 +2766,16 @@ func startBPFDataplaneComponents(
 	dp.ipSets = append(dp.ipSets, ipSets)
 	ipSetsMgr.AddDataplane(ipSets)
 
+	if config.RulesConfig.DNSPolicyEnabled {
+		// The BPF programs look up the trusted DNS servers by a well-known ID so that they can send
+		// those flows through the host namespace, where we snoop the responses.
+		ipSetIDAllocator.ReserveWellKnownID(rules.IPSetIDDNSTrustedServers, bpfipsets.DNSTrustedServersSetID)
+		ipSets.AddOrReplaceIPSet(ipsets.IPSetMetadata{
+			SetID: rules.IPSetIDDNSTrustedServers,
+			Type:  ipsets.IPSetTypeHashIPPort,
+		}, dnsTrustedServerMembers(config.RulesConfig.DNSTrustedServers, ipFamily))
+	}
+
 	failsafeMgr := failsafes.NewManager(
 		bpfmaps.FailsafesMap,
 		config.RulesConfig.FailsafeInboundHostPorts,
@@ -2752,6 +2821,22 @@ func startBPFDataplaneComponents(
 	return conntrackScanner
 }
 
+// dnsTrustedServerMembers returns the ip,port IP set members, for both UDP and TCP, of the trusted
+// DNS servers in the given IP family.
+func dnsTrustedServerMembers(servers []config.ServerPort, ipFamily proto.IPVersion) []string {
+	var members []string
+	for _, server := range servers {
+		ip := net.ParseIP(server.IP)
+		if ip == nil || (ip.To4() != nil) != (ipFamily == proto.IPVersion_IPV4) {
+			continue
+		}
+		for _, protocol := range []string{"udp", "tcp"} {
+			members = append(members, fmt.Sprintf("%s,%s:%d", server.IP, protocol, server.Port))
+		}
+	}
+	return members
+}
+
 func createBPFConntrackLivenessScanner(ipFamily proto.IPVersion, config Config) (bpfconntrack.EntryScanner, error) {
 	tryBPF := false
 	tryUserspace := false
@@ -37,6 +37,11 @@ type MatchCriteria interface {
 	NotDestAddrType(addrType AddrType) MatchCriteria
 	ConntrackState(stateNames string) MatchCriteria
 	NotConntrackState(stateNames string) MatchCriteria
+	// ConntrackOrigDest matches packets whose connection was originally addressed to the given
+	// address and port, before any DNAT.
+	ConntrackOrigDest(addr string, port uint16) MatchCriteria
+	// ConntrackReplyDirection matches packets that travel in the reply direction of their connection.
+	ConntrackReplyDirection() MatchCriteria
 	Protocol(name string) MatchCriteria
 	NotProtocol(name string) MatchCriteria
 	ProtocolNum(num uint8) MatchCriteria
@@ -72,11 +77,23 @@ type MatchCriteria interface {
 	ICMPV6TypeAndCode(t, c uint8) MatchCriteria
 	NotICMPV6TypeAndCode(t, c uint8) MatchCriteria
 
+	// RateLimitExceeded matches packets that exceed the given per-second rate, after allowing
+	// an initial burst.  The name identifies the limit's state in the kernel; if perSourceIP is
+	// set then each source IP is tracked separately.
+	RateLimitExceeded(name string, rate, burst uint32, perSourceIP bool) MatchCriteria
+
 	// Only supported in nftables.
 	InInterfaceVMAP(mapname string) MatchCriteria
 	OutInterfaceVMAP(mapname string) MatchCriteria
 }
 
+// RateLimitNamePrefix is the prefix used for the names of rate limit state tables.
+// Names are limited to 15 characters by the iptables hashlimit module.
+const (
+	RateLimitNamePrefix    = "calirl"
+	MaxRateLimitNameLength = 15
+)
+
 type AddrType string
 
 const (
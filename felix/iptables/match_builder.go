@@ -173,6 +173,14 @@ func (m matchCriteria) NotConntrackState(stateNames string) generictables.MatchC
 	return append(m, fmt.Sprintf("-m conntrack ! --ctstate %s", stateNames))
 }
 
+func (m matchCriteria) ConntrackOrigDest(addr string, port uint16) generictables.MatchCriteria {
+	return append(m, fmt.Sprintf("-m conntrack --ctorigdst %s --ctorigdstport %d", addr, port))
+}
+
+func (m matchCriteria) ConntrackReplyDirection() generictables.MatchCriteria {
+	return append(m, "-m conntrack --ctdir REPLY")
+}
+
 func (m matchCriteria) Protocol(name string) generictables.MatchCriteria {
 	return append(m, fmt.Sprintf("-p %s", name))
 }
@@ -325,6 +333,15 @@ func (m matchCriteria) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCrite
 	return append(m, fmt.Sprintf("-m icmp6 ! --icmpv6-type %d/%d", t, c))
 }
 
+func (m matchCriteria) RateLimitExceeded(name string, rate, burst uint32, perSourceIP bool) generictables.MatchCriteria {
+	mode := ""
+	if perSourceIP {
+		mode = " --hashlimit-mode srcip"
+	}
+	return append(m, fmt.Sprintf("-m hashlimit --hashlimit-above %d/sec --hashlimit-burst %d%s --hashlimit-name %s",
+		rate, burst, mode, name))
+}
+
 func (m matchCriteria) InInterfaceVMAP(mapname string) generictables.MatchCriteria {
 	log.Panic("InInterfaceVMAP not supported in iptables")
 	return m
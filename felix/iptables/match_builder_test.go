@@ -59,6 +59,8 @@ var _ = DescribeTable("MatchBuilder",
 	Entry("NotMarkMatchesWithMask", Match().NotMarkMatchesWithMask(0x400a, 0xf00f), "-m mark ! --mark 0x400a/0xf00f"),
 	// Conntrack.
 	Entry("ConntrackState", Match().ConntrackState("INVALID"), "-m conntrack --ctstate INVALID"),
+	Entry("ConntrackOrigDest", Match().ConntrackOrigDest("10.96.0.10", 53), "-m conntrack --ctorigdst 10.96.0.10 --ctorigdstport 53"),
+	Entry("ConntrackReplyDirection", Match().ConntrackReplyDirection(), "-m conntrack --ctdir REPLY"),
 	// Interfaces.
 	Entry("InInterface", Match().InInterface("tap1234abcd"), "--in-interface tap1234abcd"),
 	Entry("OutInterface", Match().OutInterface("tap1234abcd"), "--out-interface tap1234abcd"),
@@ -106,6 +108,11 @@ var _ = DescribeTable("MatchBuilder",
 	Entry("NotICMPV6Type", Match().NotICMPV6Type(123), "-m icmp6 ! --icmpv6-type 123"),
 	Entry("ICMPV6TypeAndCode", Match().ICMPV6TypeAndCode(123, 5), "-m icmp6 --icmpv6-type 123/5"),
 	Entry("NotICMPV6TypeAndCode", Match().NotICMPV6TypeAndCode(123, 5), "-m icmp6 ! --icmpv6-type 123/5"),
+	// Rate limits.
+	Entry("RateLimitExceeded", Match().RateLimitExceeded("calirlcabcdefgh", 10, 20, false),
+		"-m hashlimit --hashlimit-above 10/sec --hashlimit-burst 20 --hashlimit-name calirlcabcdefgh"),
+	Entry("RateLimitExceeded per source", Match().RateLimitExceeded("calirlcabcdefgh", 10, 20, true),
+		"-m hashlimit --hashlimit-above 10/sec --hashlimit-burst 20 --hashlimit-mode srcip --hashlimit-name calirlcabcdefgh"),
 	// Check multiple match criteria are joined correctly.
 	Entry("Protocol and ports", Match().Protocol("tcp").SourcePorts(1234).DestPorts(8080),
 		"-p tcp -m multiport --source-ports 1234 -m multiport --destination-ports 8080"),
@@ -164,6 +164,8 @@ type IPSetMember struct {
 	CIDR       ip.CIDR
 	Protocol   IPSetPortProtocol
 	PortNumber uint16
+	// Domain is set (instead of the fields above) for members of domain IP sets.
+	Domain string
 }
 
 type ipSetData struct {
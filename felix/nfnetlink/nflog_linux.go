@@ -105,6 +105,48 @@ func NflogSubscribe(groupNum int, bufSize int, ch chan<- map[NflogPacketTuple]*N
 	return nil
 }
 
+// SubscribeDNS subscribes to the given NFLOG group and sends the payload of each logged packet (i.e.
+// the IP packet, starting with its IP header) to the given channel.  It is intended for snooping
+// DNS responses, so it asks the kernel to send each NFLOG immediately rather than batching them.
+func SubscribeDNS(groupNum int, bufSize int, ch chan<- []byte, done <-chan struct{}) error {
+	resChan, err := openAndReadNFNLSocket(groupNum, bufSize, done, 2*cap(ch), true, false)
+	if err != nil {
+		return err
+	}
+	go func() {
+		logCtx := rll.WithFields(log.Fields{
+			"groupNum": groupNum,
+		})
+		numParseErrors := counterVecParseErrors.WithLabelValues(fmt.Sprint(groupNum))
+		for {
+			var res [][]byte
+			select {
+			case res = <-resChan:
+			case <-done:
+				return
+			}
+			for _, m := range res {
+				msg := nfnl.DeserializeNfGenMsg(m)
+				payload, err := parseNflogPayload(m[msg.Len():])
+				if err != nil {
+					logCtx.Warnf("Error parsing NFLOG %v", err)
+					numParseErrors.Inc()
+					continue
+				}
+				if payload == nil {
+					continue
+				}
+				select {
+				case ch <- payload:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
 func openAndReadNFNLSocket(
 	groupNum int, bufSize int, done <-chan struct{}, chanCap int, immediateFlush bool, includeConnTrack bool,
 ) (chan [][]byte, error) {
@@ -398,6 +440,23 @@ func parseNflog(m []byte) (NflogPacket, error) {
 	return nflogPacket, nil
 }
 
+// parseNflogPayload returns a copy of the packet payload from the given NFLOG message, or nil if
+// the message has no payload.
+func parseNflogPayload(m []byte) ([]byte, error) {
+	var attrs [nfnl.NFULA_MAX]nfnl.NetlinkNetfilterAttr
+	n, err := nfnl.ParseNetfilterAttr(m, attrs[:])
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < n; idx++ {
+		attr := attrs[idx]
+		if int(attr.Attr.Type)&nfnl.NLA_TYPE_MASK == nfnl.NFULA_PAYLOAD {
+			return append([]byte(nil), attr.Value...), nil
+		}
+	}
+	return nil, nil
+}
+
 func parsePacketHeader(tuple *NflogPacketTuple, hwProtocol int, nflogPayload []byte) {
 	switch hwProtocol {
 	case IPv4Proto:
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 
 	dpsets "github.com/projectcalico/calico/felix/dataplane/ipsets"
 	"github.com/projectcalico/calico/felix/deltatracker"
+	"github.com/projectcalico/calico/felix/generictables"
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/logutils"
@@ -400,6 +402,13 @@ func (s *IPSets) tryResync() error {
 		return fmt.Errorf("error listing nftables sets: %s", err)
 	}
 
+	// Per-source rate limits in policy rules are implemented with meters, which nftables
+	// creates as sets on demand.  They belong to the rules that reference them, so we leave
+	// them alone rather than treating them as stale IP sets.
+	sets = slices.DeleteFunc(sets, func(name string) bool {
+		return strings.HasPrefix(name, generictables.RateLimitNamePrefix)
+	})
+
 	// We'll process each set in parallel, so we need a struct to hold the results.
 	// Once knftables is augmented to support reading many sets at once, we can remove this.
 	type setData struct {
@@ -315,6 +315,16 @@ func (m nftMatch) NotConntrackState(stateNames string) generictables.MatchCriter
 	return m
 }
 
+func (m nftMatch) ConntrackOrigDest(addr string, port uint16) generictables.MatchCriteria {
+	m.clauses = append(m.clauses, fmt.Sprintf("ct original <IPV> daddr %s ct original proto-dst %d", addr, port))
+	return m
+}
+
+func (m nftMatch) ConntrackReplyDirection() generictables.MatchCriteria {
+	m.clauses = append(m.clauses, "ct direction reply")
+	return m
+}
+
 func (m nftMatch) Protocol(name string) generictables.MatchCriteria {
 	if m.proto != "" {
 		logrus.WithField("protocol", m.proto).Fatal("Protocol already set")
@@ -534,6 +544,16 @@ func (m nftMatch) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCriteria {
 	return m
 }
 
+func (m nftMatch) RateLimitExceeded(name string, rate, burst uint32, perSourceIP bool) generictables.MatchCriteria {
+	limit := fmt.Sprintf("limit rate over %d/second burst %d packets", rate, burst)
+	if perSourceIP {
+		// A meter keeps a separate limit for each source address.
+		limit = fmt.Sprintf("meter %s { <IPV> saddr %s }", name, limit)
+	}
+	m.clauses = append(m.clauses, limit)
+	return m
+}
+
 func (m nftMatch) InInterfaceVMAP(name string) generictables.MatchCriteria {
 	m.clauses = append(m.clauses, fmt.Sprintf("iifname vmap @<LAYER>-%s", LegalizeSetName(name)))
 	return m
@@ -62,6 +62,10 @@ var _ = DescribeTable("MatchBuilder",
 
 	// Conntrack.
 	Entry("ConntrackState", Match().ConntrackState("INVALID"), "ct state invalid"),
+	Entry("ConntrackOrigDest", Match().ConntrackOrigDest("10.96.0.10", 53), "ct original ip daddr 10.96.0.10 ct original proto-dst 53"),
+	Entry("ConntrackOrigDest IPv6", Match().(NFTMatchCriteria).IPVersion(6).ConntrackOrigDest("fd00::10", 53),
+		"ct original ip6 daddr fd00::10 ct original proto-dst 53"),
+	Entry("ConntrackReplyDirection", Match().ConntrackReplyDirection(), "ct direction reply"),
 
 	// Interfaces.
 	Entry("InInterface", Match().InInterface("tap1234abcd"), "iifname tap1234abcd"),
@@ -129,6 +133,14 @@ var _ = DescribeTable("MatchBuilder",
 	Entry("ICMPV6TypeAndCode", Match().ICMPV6TypeAndCode(123, 5), "icmpv6 type 123 code 5"),
 	Entry("NotICMPV6TypeAndCode", Match().NotICMPV6TypeAndCode(123, 5), "icmpv6 type != 123 code != 5"),
 
+	// Rate limits.
+	Entry("RateLimitExceeded", Match().RateLimitExceeded("calirlcabcdefgh", 10, 20, false),
+		"limit rate over 10/second burst 20 packets"),
+	Entry("RateLimitExceeded per source", Match().RateLimitExceeded("calirlcabcdefgh", 10, 20, true),
+		"meter calirlcabcdefgh { ip saddr limit rate over 10/second burst 20 packets }"),
+	Entry("RateLimitExceeded per source IPv6", Match().(NFTMatchCriteria).IPVersion(6).RateLimitExceeded("calirlcabcdefgh", 10, 20, true),
+		"meter calirlcabcdefgh { ip6 saddr limit rate over 10/second burst 20 packets }"),
+
 	// VMAPs
 	Entry("InInterfaceVMAP", Match().InInterfaceVMAP("vmap1234").(NFTMatchCriteria).SetLayer("filter"), "iifname vmap @filter-vmap1234"),
 	Entry("OutInterfaceVMAP", Match().OutInterfaceVMAP("vmap1234").(NFTMatchCriteria).SetLayer("raw"), "oifname vmap @raw-vmap1234"),
@@ -25,6 +25,10 @@ import (
 type ipSetInfo struct {
 	ipsets.IPSetMetadata
 	members set.Set[ipsets.IPSetMember]
+
+	// domains is true if this is a domain IP set.  Its members are domain names, which we
+	// can't resolve here, so we send an empty IP set.
+	domains bool
 }
 
 func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
@@ -37,6 +41,9 @@ func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 		s.Type = ipsets.IPSetTypeHashIPPort
 	case proto.IPSetUpdate_NET:
 		s.Type = ipsets.IPSetTypeHashNet
+	case proto.IPSetUpdate_DOMAIN:
+		s.Type = ipsets.IPSetTypeHashIP
+		s.domains = true
 	default:
 		log.WithField("IPSetType", update.GetType()).Panic("unknown IPSetType")
 	}
@@ -51,12 +58,18 @@ func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 
 func (s *ipSetInfo) replaceMembers(update *proto.IPSetUpdate) {
 	s.members = set.New[ipsets.IPSetMember]()
+	if s.domains {
+		return
+	}
 	for _, ms := range update.GetMembers() {
 		s.members.Add(ipsets.CanonicaliseMember(s.Type, ms))
 	}
 }
 
 func (s *ipSetInfo) deltaUpdate(update *proto.IPSetDeltaUpdate) {
+	if s.domains {
+		return
+	}
 	for _, ms := range update.GetAddedMembers() {
 		s.members.Add(ipsets.CanonicaliseMember(s.Type, ms))
 	}
@@ -674,6 +674,16 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 		match = r.NewMatch().MarkSingleBitSet(mark)
 	}
 
+	// Drop traffic that exceeds the rule's rate limit before applying the rule's action.  The
+	// limit has to be the last match in the rule so that only traffic that matches the rule
+	// uses up the limit.
+	for _, limitMatch := range r.rateLimitMatches(pRule) {
+		finalRules = append(finalRules, generictables.Rule{
+			Match:  r.CombineMatches(match, limitMatch),
+			Action: r.IptablesFilterDenyAction(),
+		})
+	}
+
 	for _, rule := range rules {
 		rule.Match = r.CombineMatches(rule.Match, match)
 		finalRules = append(finalRules, rule)
@@ -682,6 +692,44 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 	return finalRules
 }
 
+// rateLimitMatches returns a match for each of the rule's rate limits that matches traffic in
+// excess of the limit.
+func (r *DefaultRuleRenderer) rateLimitMatches(pRule *proto.Rule) []generictables.MatchCriteria {
+	rl := pRule.GetRateLimit()
+	if rl == nil {
+		return nil
+	}
+	var matches []generictables.MatchCriteria
+	if rate := rl.ConnectionsPerSecond; rate > 0 {
+		name := RateLimitName(pRule.RuleId, "c")
+		matches = append(matches, r.NewMatch().ConntrackState("NEW").
+			RateLimitExceeded(name, rate, rateLimitBurst(rl, rate), rl.PerSourceIp))
+	}
+	if rate := rl.PacketsPerSecond; rate > 0 {
+		name := RateLimitName(pRule.RuleId, "p")
+		matches = append(matches, r.NewMatch().
+			RateLimitExceeded(name, rate, rateLimitBurst(rl, rate), rl.PerSourceIp))
+	}
+	return matches
+}
+
+func rateLimitBurst(rl *proto.RuleRateLimit, rate uint32) uint32 {
+	if rl.Burst > 0 {
+		return rl.Burst
+	}
+	return rate
+}
+
+// RateLimitName returns the name of the kernel state for one of a rule's rate limits.  The kind
+// distinguishes the different limits that a single rule can have.
+func RateLimitName(ruleID, kind string) string {
+	name := generictables.RateLimitNamePrefix + kind + ruleID
+	if len(name) > generictables.MaxRateLimitNameLength {
+		name = name[:generictables.MaxRateLimitNameLength]
+	}
+	return name
+}
+
 func appendProtocolMatch(match generictables.MatchCriteria, protocol *proto.Protocol, logCxt *logrus.Entry) generictables.MatchCriteria {
 	if protocol == nil {
 		return match
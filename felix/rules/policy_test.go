@@ -2127,6 +2127,74 @@ var _ = DescribeTable("Port split tests",
 	}}),
 )
 
+var _ = Describe("rule rate limit tests", func() {
+	rrConfigNormal := Config{
+		IPIPEnabled:       true,
+		IPIPTunnelAddress: nil,
+		IPSetConfigV4:     ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+		IPSetConfigV6:     ipsets.NewIPVersionConfig(ipsets.IPFamilyV6, "cali", nil, nil),
+		MarkAccept:        0x80,
+		MarkPass:          0x100,
+		MarkScratch0:      0x200,
+		MarkScratch1:      0x400,
+		MarkDrop:          0x800,
+		MarkEndpoint:      0xff000,
+		LogPrefix:         "calico-packet",
+	}
+
+	It("should drop traffic over the limits before applying the action", func() {
+		rule := &proto.Rule{
+			Action:   "allow",
+			Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "tcp"}},
+			RateLimit: &proto.RuleRateLimit{
+				ConnectionsPerSecond: 10,
+				PacketsPerSecond:     100,
+				Burst:                200,
+				PerSourceIp:          true,
+			},
+			RuleId: "abcdefghijklmnop",
+		}
+		renderer := NewRenderer(rrConfigNormal)
+		rs := renderer.ProtoRuleToIptablesRules(rule, uint8(4),
+			RuleOwnerTypePolicy, RuleDirIngress, 0, "default.foo", false)
+		Expect(rs).To(Equal([]generictables.Rule{
+			{
+				Match:  iptables.Match().Protocol("tcp"),
+				Action: iptables.SetMarkAction{Mark: 0x80},
+			},
+			{
+				Match: iptables.Match().MarkSingleBitSet(0x80).ConntrackState("NEW").
+					RateLimitExceeded("calirlcabcdefgh", 10, 200, true),
+				Action: renderer.IptablesFilterDenyAction(),
+			},
+			{
+				Match: iptables.Match().MarkSingleBitSet(0x80).
+					RateLimitExceeded("calirlpabcdefgh", 100, 200, true),
+				Action: renderer.IptablesFilterDenyAction(),
+			},
+			{
+				Match:  iptables.Match().MarkSingleBitSet(0x80),
+				Action: iptables.ReturnAction{},
+			},
+		}))
+	})
+
+	It("should default the burst to the rate", func() {
+		rule := &proto.Rule{
+			Action:    "pass",
+			RateLimit: &proto.RuleRateLimit{ConnectionsPerSecond: 10},
+			RuleId:    "abcdefghijklmnop",
+		}
+		renderer := NewRenderer(rrConfigNormal)
+		rs := renderer.ProtoRuleToIptablesRules(rule, uint8(6),
+			RuleOwnerTypePolicy, RuleDirEgress, 0, "default.foo", false)
+		Expect(rs).To(HaveLen(3))
+		Expect(rs[1].Match.Render()).To(Equal(
+			"-m mark --mark 0x100/0x100 -m conntrack --ctstate NEW " +
+				"-m hashlimit --hashlimit-above 10/sec --hashlimit-burst 10 --hashlimit-name calirlcabcdefgh"))
+	})
+})
+
 var _ = Describe("rule metadata tests", func() {
 	rule := &proto.Rule{
 		Metadata: &proto.RuleMetadata{Annotations: map[string]string{
@@ -68,6 +68,8 @@ const (
 	IPSetIDAllVXLANSourceNets = "all-vxlan-net"
 	IPSetIDThisHostIPs        = "this-host"
 
+	IPSetIDDNSTrustedServers = "dns-trusted-servers"
+
 	ChainFIPDnat = ChainNamePrefix + "fip-dnat"
 	ChainFIPSnat = ChainNamePrefix + "fip-snat"
 
@@ -331,6 +333,7 @@ type RuleRenderer interface {
 
 	FilterInputChainAllowWG(ipVersion uint8, c Config, allowAction generictables.Action) []generictables.Rule
 	ICMPv6Filter(action generictables.Action) []generictables.Rule
+	DNSSnoopRules(ipVersion uint8, ifaceMatch string) []generictables.Rule
 }
 
 type DefaultRuleRenderer struct {
@@ -440,6 +443,13 @@ type Config struct {
 
 	NFTables        bool
 	FlowLogsEnabled bool
+
+	// DNSPolicyEnabled enables snooping of DNS responses to workloads, for policy rules that
+	// match on domain names.
+	DNSPolicyEnabled bool
+	// DNSTrustedServers are the servers whose DNS responses we snoop.  If empty, no responses
+	// are snooped.
+	DNSTrustedServers []config.ServerPort
 }
 
 var unusedBitsInBPFMode = map[string]bool{
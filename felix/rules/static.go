@@ -16,6 +16,7 @@ package rules
 
 import (
 	"fmt"
+	"net"
 
 	log "github.com/sirupsen/logrus"
 
@@ -27,7 +28,7 @@ import (
 )
 
 func (r *DefaultRuleRenderer) StaticFilterTableChains(ipVersion uint8) (chains []*generictables.Chain) {
-	chains = append(chains, r.StaticFilterForwardChains()...)
+	chains = append(chains, r.StaticFilterForwardChains(ipVersion)...)
 	chains = append(chains, r.StaticFilterInputChains(ipVersion)...)
 	chains = append(chains, r.StaticFilterOutputChains(ipVersion)...)
 	return
@@ -605,7 +606,7 @@ func (r *DefaultRuleRenderer) failsafeOutChain(table string, ipVersion uint8) *g
 	}
 }
 
-func (r *DefaultRuleRenderer) StaticFilterForwardChains() []*generictables.Chain {
+func (r *DefaultRuleRenderer) StaticFilterForwardChains(ipVersion uint8) []*generictables.Chain {
 	rules := []generictables.Rule{}
 
 	// Rules for filter forward chains dispatches the packet to our dispatch chains if it is going
@@ -636,6 +637,7 @@ func (r *DefaultRuleRenderer) StaticFilterForwardChains() []*generictables.Chain
 	for _, prefix := range r.WorkloadIfacePrefixes {
 		log.WithField("ifacePrefix", prefix).Debug("Adding workload match rules")
 		ifaceMatch := prefix + r.wildcard
+		rules = append(rules, r.DNSSnoopRules(ipVersion, ifaceMatch)...)
 		rules = append(rules,
 			generictables.Rule{
 				Match:  r.NewMatch().InInterface(ifaceMatch),
@@ -669,6 +671,45 @@ func (r *DefaultRuleRenderer) StaticFilterForwardChains() []*generictables.Chain
 	}}
 }
 
+// DNSSnoopRules returns rules that copy DNS responses that are going to a workload to the
+// NFLOG group that Felix snoops, so that it can learn the IPs for domains in policy.  Only
+// responses from the DNSTrustedServers are snooped; if there are none, nothing is.  DNS can use
+// TCP as well as UDP (for example, for responses that are too large for a datagram) so we snoop
+// both.
+//
+// A trusted server may be a Service's cluster IP, in which case the response comes from one of
+// the backing pods and is only reverse-DNATted in POSTROUTING.  So, rather than the response's
+// source, we match the destination that the workload originally sent its request to.  In BPF
+// mode, the BPF programs do the NAT, and send these flows through the host namespace, so the
+// response already has the trusted server as its source and Linux conntrack only sees the
+// backing pod; there, we match the source.
+func (r *DefaultRuleRenderer) DNSSnoopRules(ipVersion uint8, ifaceMatch string) []generictables.Rule {
+	if !r.DNSPolicyEnabled {
+		return nil
+	}
+	var rules []generictables.Rule
+	for _, server := range r.DNSTrustedServers {
+		ip := net.ParseIP(server.IP)
+		if ip == nil || (ip.To4() != nil) != (ipVersion == 4) {
+			continue
+		}
+		for _, proto := range []uint8{ProtoUDP, ProtoTCP} {
+			match := r.NewMatch().ProtocolNum(proto).OutInterface(ifaceMatch)
+			if r.BPFEnabled {
+				match = match.SourceNet(server.IP).SourcePorts(server.Port)
+			} else {
+				match = match.ConntrackOrigDest(server.IP, server.Port).ConntrackReplyDirection()
+			}
+			rules = append(rules, generictables.Rule{
+				Match:   match,
+				Action:  r.Nflog(NFLOGDomainGroup, "DNS", -1),
+				Comment: []string{"Snoop DNS responses to workloads"},
+			})
+		}
+	}
+	return rules
+}
+
 // StaticFilterForwardAppendRules returns rules which should be statically appended to the end of the filter
 // table's forward chain.
 func (r *DefaultRuleRenderer) StaticFilterForwardAppendRules() []generictables.Rule {
@@ -733,6 +774,7 @@ func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *generictables.
 		// belongs to an IPVS connection and return at the end.
 		log.WithField("ifacePrefix", prefix).Debug("Adding workload match rules")
 		ifaceMatch := prefix + r.wildcard
+		rules = append(rules, r.DNSSnoopRules(ipVersion, ifaceMatch)...)
 		rules = append(rules,
 			generictables.Rule{
 				// if packet goes to a workload endpoint. set return action properly.
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/projectcalico/calico/felix/config"
 	"github.com/projectcalico/calico/felix/generictables"
@@ -1673,6 +1674,107 @@ var _ = Describe("Static", func() {
 		}
 	})
 
+	Describe("with DNS policy enabled", func() {
+		BeforeEach(func() {
+			conf = Config{
+				WorkloadIfacePrefixes: []string{"cali"},
+				IPSetConfigV4:         ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+				IPSetConfigV6:         ipsets.NewIPVersionConfig(ipsets.IPFamilyV6, "cali", nil, nil),
+				MarkAccept:            0x10,
+				MarkPass:              0x20,
+				MarkScratch0:          0x40,
+				MarkScratch1:          0x80,
+				MarkDrop:              0x200,
+				MarkEndpoint:          0xff000,
+				MarkNonCaliEndpoint:   0x1000,
+				DNSPolicyEnabled:      true,
+				DNSTrustedServers: []config.ServerPort{
+					{IP: "10.96.0.10", Port: 53},
+					{IP: "fd00::10", Port: 5353},
+				},
+			}
+		})
+
+		for _, ipVersion := range []uint8{4, 6} {
+			server, port := "10.96.0.10", uint16(53)
+			if ipVersion == 6 {
+				server, port = "fd00::10", 5353
+			}
+			var snoopRules []generictables.Rule
+			for _, proto := range []uint8{ProtoUDP, ProtoTCP} {
+				snoopRules = append(snoopRules, generictables.Rule{
+					Match:   Match().ProtocolNum(proto).OutInterface("cali+").ConntrackOrigDest(server, port).ConntrackReplyDirection(),
+					Action:  NflogAction{Group: NFLOGDomainGroup, Prefix: "DNS", Size: -1},
+					Comment: []string{"Snoop DNS responses to workloads"},
+				})
+			}
+
+			It(fmt.Sprintf("should snoop UDP and TCP DNS responses to workloads in the IPv%d forward chain", ipVersion), func() {
+				fwd := findChain(rr.StaticFilterTableChains(ipVersion), "cali-FORWARD")
+				Expect(fwd.Rules[2:4]).To(Equal(snoopRules))
+				Expect(fwd.Rules[4].Match).To(Equal(Match().InInterface("cali+")))
+			})
+
+			It(fmt.Sprintf("should snoop UDP and TCP DNS responses to workloads in the IPv%d output chain", ipVersion), func() {
+				out := findChain(rr.StaticFilterTableChains(ipVersion), "cali-OUTPUT")
+				Expect(out.Rules).To(ContainElements(snoopRules))
+			})
+
+			It(fmt.Sprintf("should snoop nothing in IPv%d if there are no trusted servers", ipVersion), func() {
+				conf.DNSTrustedServers = nil
+				rr = NewRenderer(conf).(*DefaultRuleRenderer)
+				for _, chain := range rr.StaticFilterTableChains(ipVersion) {
+					for _, rule := range chain.Rules {
+						Expect(rule.Action).NotTo(Equal(NflogAction{Group: NFLOGDomainGroup, Prefix: "DNS", Size: -1}))
+					}
+				}
+			})
+		}
+
+		It("should snoop responses from a Service's backing pods by the request's original destination", func() {
+			origGetKubernetesService := config.GetKubernetesService
+			defer func() { config.GetKubernetesService = origGetKubernetesService }()
+			config.GetKubernetesService = func(namespace, svcName string) (*v1.Service, error) {
+				Expect(namespace).To(Equal("kube-system"))
+				Expect(svcName).To(Equal("kube-dns"))
+				return &v1.Service{Spec: v1.ServiceSpec{
+					ClusterIP: "10.96.0.10",
+					Ports:     []v1.ServicePort{{Port: 53}},
+				}}, nil
+			}
+			servers, err := (&config.ServerListParam{}).Parse("k8s-service:kube-dns")
+			Expect(err).NotTo(HaveOccurred())
+			conf.DNSTrustedServers = servers.([]config.ServerPort)
+			rr = NewRenderer(conf).(*DefaultRuleRenderer)
+
+			// Responses come from one of the Service's pods and are only reverse-DNATted to the
+			// cluster IP in POSTROUTING, so the rule must not match on the response's source.
+			fwd := findChain(rr.StaticFilterTableChains(4), "cali-FORWARD")
+			Expect(fwd.Rules[2].Match.Render()).To(Equal(
+				"-p 17 --out-interface cali+ -m conntrack --ctorigdst 10.96.0.10 --ctorigdstport 53 -m conntrack --ctdir REPLY"))
+		})
+
+		It("should snoop responses by their source in BPF mode", func() {
+			conf.BPFEnabled = true
+			rr = NewRenderer(conf).(*DefaultRuleRenderer)
+
+			// The BPF programs have already reverse-DNATted the response by the time it
+			// reaches iptables.
+			Expect(rr.DNSSnoopRules(4, "cali+")).To(Equal([]generictables.Rule{
+				{
+					Match:   Match().ProtocolNum(ProtoUDP).OutInterface("cali+").SourceNet("10.96.0.10").SourcePorts(53),
+					Action:  NflogAction{Group: NFLOGDomainGroup, Prefix: "DNS", Size: -1},
+					Comment: []string{"Snoop DNS responses to workloads"},
+				},
+				{
+					Match:   Match().ProtocolNum(ProtoTCP).OutInterface("cali+").SourceNet("10.96.0.10").SourcePorts(53),
+					Action:  NflogAction{Group: NFLOGDomainGroup, Prefix: "DNS", Size: -1},
+					Comment: []string{"Snoop DNS responses to workloads"},
+				},
+			}))
+		})
+	})
+
 	Describe("with WireGuard enabled", func() {
 		type testConf struct {
 			IPVersion  uint8
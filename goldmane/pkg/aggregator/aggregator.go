@@ -61,6 +61,36 @@ type filterHintsResponse struct {
 	err     error
 }
 
+type graphRequest struct {
+	respCh chan *graphResponse
+	req    *proto.GraphRequest
+}
+
+type graphResponse struct {
+	results *proto.GraphResult
+	err     error
+}
+
+type topTalkersRequest struct {
+	respCh chan *topTalkersResponse
+	req    *proto.TopTalkersRequest
+}
+
+type topTalkersResponse struct {
+	results *proto.TopTalkersResult
+	err     error
+}
+
+type policyRecommendationRequest struct {
+	respCh chan *policyRecommendationResponse
+	req    *proto.PolicyRecommendationRequest
+}
+
+type policyRecommendationResponse struct {
+	results *proto.PolicyRecommendationResult
+	err     error
+}
+
 type streamRequest struct {
 	respCh chan *Stream
 	req    *proto.FlowStreamRequest
@@ -71,6 +101,14 @@ type sinkRequest struct {
 	done chan struct{}
 }
 
+// FlowStore is a durable store of completed buckets, used to retain flow history across restarts.
+type FlowStore interface {
+	bucketing.Persister
+
+	// Load returns all stored flows with a start time greater than or equal to the given time.
+	Load(startGte int64) ([]*types.Flow, error)
+}
+
 type LogAggregator struct {
 	// indices allow for quick handling of flow queries sorted by various methods.
 	indices map[proto.SortBy]Index[string]
@@ -104,6 +142,12 @@ type LogAggregator struct {
 
 	filterHintsRequests chan filterHintsRequest
 
+	graphRequests chan graphRequest
+
+	topTalkersRequests chan topTalkersRequest
+
+	policyRecRequests chan policyRecommendationRequest
+
 	// streamRequests is the channel to receive stream requests on.
 	streamRequests chan streamRequest
 
@@ -141,6 +185,13 @@ type LogAggregator struct {
 
 	// health is the health aggregator to use for health checks.
 	health *health.HealthAggregator
+
+	// store is an optional durable store for completed buckets. If set, completed buckets are
+	// written to it and flow history is restored from it on start.
+	store FlowStore
+
+	// observers are notified of each completed bucket.
+	observers []bucketing.BucketObserver
 }
 
 func NewLogAggregator(opts ...Option) *LogAggregator {
@@ -150,6 +201,9 @@ func NewLogAggregator(opts ...Option) *LogAggregator {
 		done:                make(chan struct{}),
 		listRequests:        make(chan listRequest),
 		filterHintsRequests: make(chan filterHintsRequest),
+		graphRequests:       make(chan graphRequest),
+		topTalkersRequests:  make(chan topTalkersRequest),
+		policyRecRequests:   make(chan policyRecommendationRequest),
 		streamRequests:      make(chan streamRequest),
 		sinkChan:            make(chan *sinkRequest, 10),
 		recvChan:            make(chan *types.Flow, channelDepth),
@@ -216,6 +270,12 @@ func (a *LogAggregator) Run(startTime int64) {
 		bucketing.WithLookup(a.diachronicFlow),
 		bucketing.WithStreamReceiver(a.streams),
 	}
+	if a.store != nil {
+		opts = append(opts, bucketing.WithPersister(a.store))
+	}
+	for _, o := range a.observers {
+		opts = append(opts, bucketing.WithBucketObserver(o))
+	}
 	a.buckets = bucketing.NewBucketRing(
 		numBuckets,
 		int(a.aggregationWindow.Seconds()),
@@ -223,6 +283,9 @@ func (a *LogAggregator) Run(startTime int64) {
 		opts...,
 	)
 
+	// Restore any flow history that was persisted prior to a restart.
+	a.restore()
+
 	if a.health != nil {
 		// Register with the health aggregator.
 		// We will send reports on each rollover, so we set the timeout to 4x the rollover window to ensure that
@@ -251,6 +314,12 @@ func (a *LogAggregator) Run(startTime int64) {
 			req.respCh <- a.queryFlows(req.req)
 		case req := <-a.filterHintsRequests:
 			req.respCh <- a.queryFilterHints(req.req)
+		case req := <-a.graphRequests:
+			req.respCh <- a.queryGraph(req.req)
+		case req := <-a.topTalkersRequests:
+			req.respCh <- a.queryTopTalkers(req.req)
+		case req := <-a.policyRecRequests:
+			req.respCh <- a.queryPolicyRecommendations(req.req)
 		case req := <-a.streamRequests:
 			stream := a.streams.register(req)
 			req.respCh <- stream
@@ -269,6 +338,24 @@ func (a *LogAggregator) Run(startTime int64) {
 	}
 }
 
+// restore loads previously persisted flows that fall within the bucket ring's history
+// and replays them into the aggregator.
+func (a *LogAggregator) restore() {
+	if a.store == nil {
+		return
+	}
+
+	flows, err := a.store.Load(a.buckets.BeginningOfHistory())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to load flow history, starting with empty history")
+		return
+	}
+	for _, f := range flows {
+		a.handleFlowUpdate(f)
+	}
+	logrus.WithField("num", len(flows)).Info("Restored flow history")
+}
+
 // SetSink sets the sink for the aggregator and returns a channel that can be used to wait for the sink to be set,
 // if desired by the caller.
 func (a *LogAggregator) SetSink(s bucketing.Sink) chan struct{} {
@@ -293,6 +380,10 @@ func (a *LogAggregator) Receive(f *types.Flow) {
 func (a *LogAggregator) Stream(req *proto.FlowStreamRequest) (*Stream, error) {
 	logrus.WithField("req", req).Debug("Received stream request")
 
+	if err := types.ValidateFilter(req.Filter); err != nil {
+		return nil, err
+	}
+
 	if req.StartTimeGte != 0 {
 		// Sanitize the time range, resolving any relative time values.
 		// Note that for stream requests, 0 means "now" instead of "beginning of history". As such,
@@ -331,6 +422,45 @@ func (a *LogAggregator) Hints(req *proto.FilterHintsRequest) (*proto.FilterHints
 	return resp.results, resp.err
 }
 
+// Graph returns a graph of the communication between endpoints that matches the given request. It uses
+// a channel to synchronously request the graph from the aggregator.
+func (a *LogAggregator) Graph(req *proto.GraphRequest) (*proto.GraphResult, error) {
+	logrus.WithField("req", req).Debug("Received graph request")
+
+	respCh := make(chan *graphResponse)
+	defer close(respCh)
+	a.graphRequests <- graphRequest{respCh, req}
+	resp := <-respCh
+
+	return resp.results, resp.err
+}
+
+// TopTalkers returns the highest ranked groups of flows that match the given request. It uses a channel to
+// synchronously request the results from the aggregator.
+func (a *LogAggregator) TopTalkers(req *proto.TopTalkersRequest) (*proto.TopTalkersResult, error) {
+	logrus.WithField("req", req).Debug("Received top talkers request")
+
+	respCh := make(chan *topTalkersResponse)
+	defer close(respCh)
+	a.topTalkersRequests <- topTalkersRequest{respCh, req}
+	resp := <-respCh
+
+	return resp.results, resp.err
+}
+
+// PolicyRecommendations returns staged policies that would allow the flows observed for the requested namespace.
+// It uses a channel to synchronously request the results from the aggregator.
+func (a *LogAggregator) PolicyRecommendations(req *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error) {
+	logrus.WithField("req", req).Debug("Received policy recommendation request")
+
+	respCh := make(chan *policyRecommendationResponse)
+	defer close(respCh)
+	a.policyRecRequests <- policyRecommendationRequest{respCh, req}
+	resp := <-respCh
+
+	return resp.results, resp.err
+}
+
 func (a *LogAggregator) validateListRequest(req *proto.FlowListRequest) error {
 	if err := a.validateTimeRange(req.StartTimeGte, req.StartTimeLt); err != nil {
 		return err
@@ -338,7 +468,7 @@ func (a *LogAggregator) validateListRequest(req *proto.FlowListRequest) error {
 	if len(req.SortBy) > 1 {
 		return fmt.Errorf("at most one sort order is supported")
 	}
-	return nil
+	return types.ValidateFilter(req.Filter)
 }
 
 func (a *LogAggregator) validateTimeRange(startTimeGt, startTimeLt int64) error {
@@ -483,6 +613,9 @@ func (a *LogAggregator) queryFilterHints(req *proto.FilterHintsRequest) *filterH
 	if err := a.validateTimeRange(req.StartTimeGte, req.StartTimeLt); err != nil {
 		return &filterHintsResponse{nil, err}
 	}
+	if err := types.ValidateFilter(req.Filter); err != nil {
+		return &filterHintsResponse{nil, err}
+	}
 
 	var sortBy proto.SortBy
 	var valueFunc func(*types.FlowKey) []string
@@ -548,6 +681,84 @@ func (a *LogAggregator) queryFilterHints(req *proto.FilterHintsRequest) *filterH
 	}, nil}
 }
 
+func (a *LogAggregator) queryGraph(req *proto.GraphRequest) *graphResponse {
+	// Sanitize the time range, resolving any relative time values.
+	req.StartTimeGte, req.StartTimeLt = a.normalizeTimeRange(req.StartTimeGte, req.StartTimeLt)
+
+	// Validate the request.
+	if err := a.validateTimeRange(req.StartTimeGte, req.StartTimeLt); err != nil {
+		return &graphResponse{nil, err}
+	}
+	if err := types.ValidateFilter(req.Filter); err != nil {
+		return &graphResponse{nil, err}
+	}
+	if _, ok := proto.GraphGranularity_name[int32(req.Granularity)]; !ok {
+		return &graphResponse{nil, fmt.Errorf("unsupported graph granularity '%s'", req.Granularity.String())}
+	}
+
+	// Build the graph from all matching flows in the time range. We don't paginate here, as the
+	// graph is only meaningful when built from the complete set of flows.
+	g := newGraphBuilder(req.Granularity)
+	a.defaultIndex.Iter(IndexFindOpts{
+		startTimeGt: req.StartTimeGte,
+		startTimeLt: req.StartTimeLt,
+		filter:      req.Filter,
+	}, g.add)
+	return &graphResponse{g.result(), nil}
+}
+
+func (a *LogAggregator) queryTopTalkers(req *proto.TopTalkersRequest) *topTalkersResponse {
+	// Sanitize the time range, resolving any relative time values.
+	req.StartTimeGte, req.StartTimeLt = a.normalizeTimeRange(req.StartTimeGte, req.StartTimeLt)
+
+	// Validate the request.
+	if err := a.validateTimeRange(req.StartTimeGte, req.StartTimeLt); err != nil {
+		return &topTalkersResponse{nil, err}
+	}
+	if err := types.ValidateFilter(req.Filter); err != nil {
+		return &topTalkersResponse{nil, err}
+	}
+	if _, ok := proto.TopTalkersGroupBy_name[int32(req.GroupBy)]; !ok {
+		return &topTalkersResponse{nil, fmt.Errorf("unsupported group by '%s'", req.GroupBy.String())}
+	}
+	if _, ok := proto.TopTalkersRankBy_name[int32(req.RankBy)]; !ok {
+		return &topTalkersResponse{nil, fmt.Errorf("unsupported rank by '%s'", req.RankBy.String())}
+	}
+	if req.Limit < 0 {
+		return &topTalkersResponse{nil, fmt.Errorf("limit must not be negative")}
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultTopTalkersLimit
+	}
+
+	t := newTopTalkers(req.GroupBy, req.RankBy)
+	a.defaultIndex.Iter(IndexFindOpts{
+		startTimeGt: req.StartTimeGte,
+		startTimeLt: req.StartTimeLt,
+		filter:      req.Filter,
+	}, t.add)
+	return &topTalkersResponse{t.result(limit), nil}
+}
+
+func (a *LogAggregator) queryPolicyRecommendations(req *proto.PolicyRecommendationRequest) *policyRecommendationResponse {
+	// Sanitize the time range, resolving any relative time values.
+	req.StartTimeGte, req.StartTimeLt = a.normalizeTimeRange(req.StartTimeGte, req.StartTimeLt)
+
+	// Validate the request.
+	if err := a.validateTimeRange(req.StartTimeGte, req.StartTimeLt); err != nil {
+		return &policyRecommendationResponse{nil, err}
+	}
+
+	r := newPolicyRecommender(req.Namespace, req.Tier)
+	a.defaultIndex.Iter(IndexFindOpts{
+		startTimeGt: req.StartTimeGte,
+		startTimeLt: req.StartTimeLt,
+	}, r.add)
+	results, err := r.result()
+	return &policyRecommendationResponse{results, err}
+}
+
 // extractPolicyFieldsFromFlowKey is a convenience function to extract policy fields from a flow key. The given function
 // is run over all policy hits (enforced and pending) to get all of the values.
 func extractPolicyFieldsFromFlowKey(getField func(*proto.PolicyHit) string) func(key *types.FlowKey) []string {
@@ -15,11 +15,15 @@
 package aggregator_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	googleproto "google.golang.org/protobuf/proto"
@@ -31,6 +35,7 @@ import (
 	"github.com/projectcalico/calico/goldmane/pkg/types"
 	"github.com/projectcalico/calico/goldmane/proto"
 	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
 )
 
 var (
@@ -1066,6 +1071,95 @@ func TestBucketDrift(t *testing.T) {
 	require.Equal(t, 10*time.Millisecond, rolloverScheduledAt, "Immediate rollover should have been scheduled for 10ms")
 }
 
+func TestFlowStore(t *testing.T) {
+	c := newClock(initialNow)
+	now := c.Now().Unix()
+	store := &testStore{}
+	roller := &rolloverController{
+		ch:                    make(chan time.Time),
+		aggregationWindowSecs: 1,
+		clock:                 c,
+	}
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithRolloverFunc(roller.After),
+		aggregator.WithNowFunc(c.Now),
+		aggregator.WithFlowStore(store),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(now)
+
+	// Send a flow for the most recently completed bucket. It should be persisted on the next rollover.
+	fl := testutils.NewRandomFlow(now - 1)
+	agg.Receive(types.ProtoToFlow(fl))
+	Eventually(func() int {
+		results, err := agg.List(&proto.FlowListRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		return len(results.Flows)
+	}, waitTimeout, retryTime).Should(Equal(1))
+
+	roller.rolloverAndAdvanceClock(1)
+	Eventually(store.numBuckets, waitTimeout, retryTime).Should(Equal(1))
+	require.Len(t, store.buckets[0].Flows, 1)
+
+	// Simulate a restart by creating a new aggregator backed by the same store. It should
+	// restore the persisted flow without receiving any new flow updates.
+	agg.Stop()
+	agg = aggregator.NewLogAggregator(opts...)
+	go agg.Run(c.Now().Unix())
+
+	var flows []*proto.FlowResult
+	Eventually(func() int {
+		results, err := agg.List(&proto.FlowListRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		flows = results.Flows
+		return len(flows)
+	}, waitTimeout, retryTime).Should(Equal(1))
+	Expect(googleproto.Equal(flows[0].Flow.Key, fl.Key)).To(BeTrue())
+	Expect(flows[0].Flow.BytesIn).To(Equal(fl.BytesIn))
+}
+
+func TestBucketObserver(t *testing.T) {
+	c := newClock(initialNow)
+	now := c.Now().Unix()
+	observer := &testObserver{}
+	roller := &rolloverController{
+		ch:                    make(chan time.Time),
+		aggregationWindowSecs: 1,
+		clock:                 c,
+	}
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithRolloverFunc(roller.After),
+		aggregator.WithNowFunc(c.Now),
+		aggregator.WithBucketObserver(observer),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(now)
+
+	// Send a flow for the most recently completed bucket. It should be observed on the next rollover.
+	fl := testutils.NewRandomFlow(now - 1)
+	agg.Receive(types.ProtoToFlow(fl))
+	Eventually(func() int {
+		results, err := agg.List(&proto.FlowListRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		return len(results.Flows)
+	}, waitTimeout, retryTime).Should(Equal(1))
+
+	roller.rolloverAndAdvanceClock(1)
+	Eventually(observer.numBuckets, waitTimeout, retryTime).Should(Equal(1))
+
+	// Empty buckets should be observed too.
+	roller.rolloverAndAdvanceClock(1)
+	Eventually(observer.numBuckets, waitTimeout, retryTime).Should(Equal(2))
+
+	observer.Lock()
+	defer observer.Unlock()
+	require.Len(t, observer.buckets[0].Flows, 1)
+	require.Empty(t, observer.buckets[1].Flows)
+	require.Equal(t, observer.buckets[0].EndTime, observer.buckets[1].StartTime)
+}
+
 func TestStreams(t *testing.T) {
 	t.Run("Basic", func(t *testing.T) {
 		// Create a clock and rollover controller.
@@ -1527,6 +1621,48 @@ func TestFilter(t *testing.T) {
 			},
 			numFlows: 0,
 		},
+
+		{
+			name: "SourceSelector, no sort",
+			req: &proto.FlowListRequest{
+				Filter: &proto.Filter{SourceSelector: "app == 'source-1'"},
+			},
+			numFlows: 1,
+			check: func(fl *proto.FlowResult) error {
+				if fl.Flow.Key.SourceName != "source-1" {
+					return fmt.Errorf("Expected SourceName to be source-1, got %s", fl.Flow.Key.SourceName)
+				}
+				return nil
+			},
+		},
+
+		{
+			name: "SourceSelector, sort by DestName",
+			req: &proto.FlowListRequest{
+				Filter: &proto.Filter{SourceSelector: "tier == 'frontend'"},
+				SortBy: []*proto.SortOption{{SortBy: proto.SortBy_DestName}},
+			},
+			numFlows: 5,
+		},
+
+		{
+			name: "DestSelector",
+			req: &proto.FlowListRequest{
+				Filter: &proto.Filter{DestSelector: "app in {'dest-1', 'dest-2'}"},
+			},
+			numFlows: 2,
+		},
+
+		{
+			name: "SourceSelector and DestSelector, no match",
+			req: &proto.FlowListRequest{
+				Filter: &proto.Filter{
+					SourceSelector: "app == 'source-1'",
+					DestSelector:   "app == 'dest-2'",
+				},
+			},
+			numFlows: 0,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1558,6 +1694,11 @@ func TestFilter(t *testing.T) {
 				fl.Key.DestNamespace = fmt.Sprintf("dest-ns-%d", i)
 				fl.Key.Proto = "tcp"
 				fl.Key.DestPort = int64(i)
+				fl.SourceLabels = []string{fmt.Sprintf("app=source-%d", i)}
+				if i%2 == 0 {
+					fl.SourceLabels = append(fl.SourceLabels, "tier=frontend")
+				}
+				fl.DestLabels = []string{fmt.Sprintf("app=dest-%d", i)}
 				fl.Key.Policies = &proto.PolicyTrace{
 					EnforcedPolicies: []*proto.PolicyHit{
 						{
@@ -1620,6 +1761,27 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestInvalidSelector(t *testing.T) {
+	c := newClock(initialNow)
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithNowFunc(c.Now),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(c.Now().Unix())
+
+	filter := &proto.Filter{SourceSelector: "app == "}
+
+	_, err := agg.List(&proto.FlowListRequest{Filter: filter})
+	Expect(err).To(HaveOccurred())
+
+	_, err = agg.Hints(&proto.FilterHintsRequest{Type: proto.FilterType_FilterTypeDestName, Filter: filter})
+	Expect(err).To(HaveOccurred())
+
+	_, err = agg.Stream(&proto.FlowStreamRequest{Filter: filter})
+	Expect(err).To(HaveOccurred())
+}
+
 func TestFilterHints(t *testing.T) {
 	type tc struct {
 		name    string
@@ -1803,6 +1965,434 @@ func TestFilterHints(t *testing.T) {
 	}
 }
 
+func TestGraph(t *testing.T) {
+	// Create a clock and rollover controller.
+	c := newClock(initialNow)
+	roller := &rolloverController{
+		ch:                    make(chan time.Time),
+		aggregationWindowSecs: 1,
+		clock:                 c,
+	}
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithRolloverFunc(roller.After),
+		aggregator.WithNowFunc(c.Now),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(c.Now().Unix())
+
+	newFlow := func(srcNs, srcName string, dstType proto.EndpointType, dstNs, dstName string, r proto.Reporter, a proto.Action, bytes int64) *proto.Flow {
+		fl := testutils.NewRandomFlow(c.Now().Unix() - 1)
+		fl.Key.SourceType = proto.EndpointType_WorkloadEndpoint
+		fl.Key.SourceNamespace = srcNs
+		fl.Key.SourceName = srcName
+		fl.Key.DestType = dstType
+		fl.Key.DestNamespace = dstNs
+		fl.Key.DestName = dstName
+		fl.Key.Reporter = r
+		fl.Key.Action = a
+		fl.BytesIn = bytes
+		fl.BytesOut = bytes
+		fl.PacketsIn = 1
+		fl.PacketsOut = 1
+		fl.NumConnectionsStarted = 1
+		return fl
+	}
+
+	flows := []*proto.Flow{
+		// A connection reported by both the source and destination.
+		newFlow("ns-a", "client", proto.EndpointType_WorkloadEndpoint, "ns-b", "server", proto.Reporter_Src, proto.Action_Allow, 100),
+		newFlow("ns-a", "client", proto.EndpointType_WorkloadEndpoint, "ns-b", "server", proto.Reporter_Dst, proto.Action_Allow, 100),
+
+		// A second client in the same namespace talking to the same server.
+		newFlow("ns-a", "other", proto.EndpointType_WorkloadEndpoint, "ns-b", "server", proto.Reporter_Src, proto.Action_Allow, 200),
+
+		// A denied connection to a global network set.
+		newFlow("ns-a", "client", proto.EndpointType_NetworkSet, "-", "public", proto.Reporter_Src, proto.Action_Deny, 50),
+	}
+	for _, fl := range flows {
+		agg.Receive(types.ProtoToFlow(fl))
+	}
+
+	// Wait for all flows to be received.
+	Eventually(func() bool {
+		results, _ := agg.List(&proto.FlowListRequest{})
+		return len(results.Flows) == len(flows)
+	}, waitTimeout, retryTime, "Didn't receive all flows").Should(BeTrue())
+
+	t.Run("Endpoint granularity", func(t *testing.T) {
+		res, err := agg.Graph(&proto.GraphRequest{})
+		require.NoError(t, err)
+
+		var ids []string
+		for _, n := range res.Nodes {
+			ids = append(ids, n.Id)
+		}
+		require.Equal(t, []string{
+			"networkset//public",
+			"workload/ns-a/client",
+			"workload/ns-a/other",
+			"workload/ns-b/server",
+		}, ids)
+
+		require.Len(t, res.Edges, 3)
+
+		// Edges are sorted by source, then destination.
+		e := res.Edges[0]
+		require.Equal(t, "workload/ns-a/client", e.Source)
+		require.Equal(t, "networkset//public", e.Dest)
+		require.Equal(t, int64(50), e.BytesOut)
+		require.Equal(t, int64(0), e.AllowedFlows)
+		require.Equal(t, int64(1), e.DeniedFlows)
+
+		// The connection reported at both ends should only be counted once.
+		e = res.Edges[1]
+		require.Equal(t, "workload/ns-a/client", e.Source)
+		require.Equal(t, "workload/ns-b/server", e.Dest)
+		require.Equal(t, int64(100), e.BytesOut)
+		require.Equal(t, int64(1), e.PacketsOut)
+		require.Equal(t, int64(1), e.NumConnectionsStarted)
+		require.Equal(t, int64(1), e.AllowedFlows)
+		require.Equal(t, int64(0), e.DeniedFlows)
+	})
+
+	t.Run("Namespace granularity", func(t *testing.T) {
+		res, err := agg.Graph(&proto.GraphRequest{Granularity: proto.GraphGranularity_GraphGranularityNamespace})
+		require.NoError(t, err)
+
+		// Namespaced endpoints are collapsed, but the global network set remains.
+		var ids []string
+		for _, n := range res.Nodes {
+			ids = append(ids, n.Id)
+		}
+		require.Equal(t, []string{
+			"namespace/ns-a",
+			"namespace/ns-b",
+			"networkset//public",
+		}, ids)
+
+		require.Len(t, res.Edges, 2)
+		e := res.Edges[0]
+		require.Equal(t, "namespace/ns-a", e.Source)
+		require.Equal(t, "namespace/ns-b", e.Dest)
+		require.Equal(t, int64(300), e.BytesOut)
+		require.Equal(t, int64(2), e.NumConnectionsStarted)
+		require.Equal(t, int64(2), e.AllowedFlows)
+
+		e = res.Edges[1]
+		require.Equal(t, "namespace/ns-a", e.Source)
+		require.Equal(t, "networkset//public", e.Dest)
+		require.Equal(t, int64(1), e.DeniedFlows)
+	})
+
+	t.Run("With filter", func(t *testing.T) {
+		res, err := agg.Graph(&proto.GraphRequest{
+			Filter: &proto.Filter{Actions: []proto.Action{proto.Action_Deny}},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Nodes, 2)
+		require.Len(t, res.Edges, 1)
+		require.Equal(t, "networkset//public", res.Edges[0].Dest)
+	})
+
+	t.Run("Invalid granularity", func(t *testing.T) {
+		_, err := agg.Graph(&proto.GraphRequest{Granularity: proto.GraphGranularity(100)})
+		require.Error(t, err)
+	})
+}
+
+func TestTopTalkers(t *testing.T) {
+	// Create a clock and rollover controller.
+	c := newClock(initialNow)
+	roller := &rolloverController{
+		ch:                    make(chan time.Time),
+		aggregationWindowSecs: 1,
+		clock:                 c,
+	}
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithRolloverFunc(roller.After),
+		aggregator.WithNowFunc(c.Now),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(c.Now().Unix())
+
+	newFlow := func(srcNs, srcName, dstNs, dstName, protocol string, port int64, r proto.Reporter, a proto.Action, packets, bytes int64) *proto.Flow {
+		fl := testutils.NewRandomFlow(c.Now().Unix() - 1)
+		fl.Key.SourceNamespace = srcNs
+		fl.Key.SourceName = srcName
+		fl.Key.DestNamespace = dstNs
+		fl.Key.DestName = dstName
+		fl.Key.Proto = protocol
+		fl.Key.DestPort = port
+		fl.Key.Reporter = r
+		fl.Key.Action = a
+		fl.PacketsIn = packets
+		fl.PacketsOut = 0
+		fl.BytesIn = bytes
+		fl.BytesOut = 0
+		fl.NumConnectionsStarted = 1
+		return fl
+	}
+
+	flows := []*proto.Flow{
+		// A connection reported by both the source and destination.
+		newFlow("ns-a", "a1", "ns-b", "b1", "tcp", 80, proto.Reporter_Src, proto.Action_Allow, 2, 200),
+		newFlow("ns-a", "a1", "ns-b", "b1", "tcp", 80, proto.Reporter_Dst, proto.Action_Allow, 2, 200),
+
+		// The biggest talker by bytes.
+		newFlow("ns-a", "a2", "ns-b", "b1", "tcp", 80, proto.Reporter_Src, proto.Action_Allow, 5, 1000),
+
+		// The most denied, with the most packets.
+		newFlow("ns-c", "c1", "ns-b", "b2", "udp", 53, proto.Reporter_Src, proto.Action_Deny, 10, 0),
+	}
+	for _, fl := range flows {
+		agg.Receive(types.ProtoToFlow(fl))
+	}
+
+	// Wait for all flows to be received.
+	Eventually(func() bool {
+		results, _ := agg.List(&proto.FlowListRequest{})
+		return len(results.Flows) == len(flows)
+	}, waitTimeout, retryTime, "Didn't receive all flows").Should(BeTrue())
+
+	t.Run("Sources by bytes", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 2)
+		require.Equal(t, "a2", res.Talkers[0].SourceName)
+		require.Equal(t, int64(1000), res.Talkers[0].Bytes)
+
+		// The connection reported at both ends should only be counted once.
+		require.Equal(t, "a1", res.Talkers[1].SourceName)
+		require.Equal(t, int64(200), res.Talkers[1].Bytes)
+		require.Equal(t, int64(2), res.Talkers[1].Packets)
+		require.Equal(t, int64(1), res.Talkers[1].NumConnectionsStarted)
+	})
+
+	t.Run("Sources by denied packets", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{
+			RankBy: proto.TopTalkersRankBy_TopTalkersRankByDeniedPackets,
+			Limit:  1,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 1)
+		require.Equal(t, "ns-c", res.Talkers[0].SourceNamespace)
+		require.Equal(t, "c1", res.Talkers[0].SourceName)
+		require.Equal(t, int64(10), res.Talkers[0].DeniedPackets)
+	})
+
+	t.Run("Destinations by packets", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{
+			GroupBy: proto.TopTalkersGroupBy_TopTalkersGroupByDest,
+			RankBy:  proto.TopTalkersRankBy_TopTalkersRankByPackets,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 2)
+		require.Equal(t, "b2", res.Talkers[0].DestName)
+		require.Equal(t, int64(10), res.Talkers[0].Packets)
+		require.Equal(t, "b1", res.Talkers[1].DestName)
+		require.Equal(t, int64(7), res.Talkers[1].Packets)
+		require.Empty(t, res.Talkers[1].SourceName)
+	})
+
+	t.Run("Namespace pairs by bytes", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{
+			GroupBy: proto.TopTalkersGroupBy_TopTalkersGroupByNamespacePair,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 2)
+		require.Equal(t, "ns-a", res.Talkers[0].SourceNamespace)
+		require.Equal(t, "ns-b", res.Talkers[0].DestNamespace)
+		require.Equal(t, int64(1200), res.Talkers[0].Bytes)
+	})
+
+	t.Run("Ports by connections", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{
+			GroupBy: proto.TopTalkersGroupBy_TopTalkersGroupByDestPort,
+			RankBy:  proto.TopTalkersRankBy_TopTalkersRankByConnections,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 2)
+		require.Equal(t, "tcp", res.Talkers[0].Proto)
+		require.Equal(t, int64(80), res.Talkers[0].DestPort)
+		require.Equal(t, int64(2), res.Talkers[0].NumConnectionsStarted)
+		require.Equal(t, "udp", res.Talkers[1].Proto)
+	})
+
+	t.Run("With filter", func(t *testing.T) {
+		res, err := agg.TopTalkers(&proto.TopTalkersRequest{
+			Filter: &proto.Filter{DestNames: []*proto.StringMatch{{Value: "b2"}}},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Talkers, 1)
+		require.Equal(t, "c1", res.Talkers[0].SourceName)
+	})
+
+	t.Run("Invalid request", func(t *testing.T) {
+		_, err := agg.TopTalkers(&proto.TopTalkersRequest{Limit: -1})
+		require.Error(t, err)
+		_, err = agg.TopTalkers(&proto.TopTalkersRequest{RankBy: proto.TopTalkersRankBy(100)})
+		require.Error(t, err)
+	})
+}
+
+func TestPolicyRecommendations(t *testing.T) {
+	// Create a clock and rollover controller.
+	c := newClock(initialNow)
+	roller := &rolloverController{
+		ch:                    make(chan time.Time),
+		aggregationWindowSecs: 1,
+		clock:                 c,
+	}
+	opts := []aggregator.Option{
+		aggregator.WithRolloverTime(1 * time.Second),
+		aggregator.WithRolloverFunc(roller.After),
+		aggregator.WithNowFunc(c.Now),
+	}
+	defer setupTest(t, opts...)()
+	go agg.Run(c.Now().Unix())
+
+	type endpoint struct {
+		t         proto.EndpointType
+		namespace string
+		name      string
+		labels    []string
+	}
+	frontend := endpoint{proto.EndpointType_WorkloadEndpoint, "shop", "frontend-*", []string{"app=frontend", "pod-template-hash=abc"}}
+	backend := endpoint{proto.EndpointType_WorkloadEndpoint, "shop", "backend-*", []string{"app=backend"}}
+	db := endpoint{proto.EndpointType_WorkloadEndpoint, "shop", "db-*", []string{"app=db"}}
+	dns := endpoint{proto.EndpointType_WorkloadEndpoint, "kube-system", "coredns-*", []string{"k8s-app=kube-dns"}}
+	other := endpoint{proto.EndpointType_WorkloadEndpoint, "other", "other-*", []string{"app=other"}}
+	host := endpoint{proto.EndpointType_HostEndpoint, "", "node-1", []string{"kubernetes.io/hostname=node-1"}}
+	public := endpoint{proto.EndpointType_Network, "", "pub", nil}
+
+	newFlow := func(src, dst endpoint, protocol string, port int64, r proto.Reporter, a proto.Action) *proto.Flow {
+		fl := testutils.NewRandomFlow(c.Now().Unix() - 1)
+		fl.Key.SourceType, fl.Key.SourceNamespace, fl.Key.SourceName = src.t, src.namespace, src.name
+		fl.Key.DestType, fl.Key.DestNamespace, fl.Key.DestName = dst.t, dst.namespace, dst.name
+		fl.SourceLabels = src.labels
+		fl.DestLabels = dst.labels
+		fl.Key.Proto = protocol
+		fl.Key.DestPort = port
+		fl.Key.Reporter = r
+		fl.Key.Action = a
+		return fl
+	}
+
+	flows := []*proto.Flow{
+		// Frontend to backend, reported at both ends.
+		newFlow(frontend, backend, "tcp", 8080, proto.Reporter_Src, proto.Action_Allow),
+		newFlow(frontend, backend, "tcp", 8080, proto.Reporter_Dst, proto.Action_Allow),
+
+		// Frontend to DNS in another namespace, and to the internet.
+		newFlow(frontend, dns, "udp", 53, proto.Reporter_Src, proto.Action_Allow),
+		newFlow(frontend, public, "tcp", 443, proto.Reporter_Src, proto.Action_Allow),
+
+		// Denied flows should not result in rules.
+		newFlow(frontend, db, "tcp", 5432, proto.Reporter_Src, proto.Action_Deny),
+
+		// Flows in other namespaces should be ignored.
+		newFlow(other, other, "tcp", 80, proto.Reporter_Src, proto.Action_Allow),
+
+		// A host endpoint talking to the internet.
+		newFlow(host, public, "tcp", 443, proto.Reporter_Src, proto.Action_Allow),
+	}
+	for _, fl := range flows {
+		agg.Receive(types.ProtoToFlow(fl))
+	}
+
+	// Wait for all flows to be received.
+	Eventually(func() bool {
+		results, _ := agg.List(&proto.FlowListRequest{})
+		return len(results.Flows) == len(flows)
+	}, waitTimeout, retryTime, "Didn't receive all flows").Should(BeTrue())
+
+	tcp := numorstring.ProtocolFromString("tcp")
+	udp := numorstring.ProtocolFromString("udp")
+
+	t.Run("Namespace", func(t *testing.T) {
+		res, err := agg.PolicyRecommendations(&proto.PolicyRecommendationRequest{Namespace: "shop"})
+		require.NoError(t, err)
+		require.Len(t, res.Policies, 2)
+
+		var policies []*apiv3.StagedNetworkPolicy
+		for _, rec := range res.Policies {
+			require.Equal(t, apiv3.KindStagedNetworkPolicy, rec.Kind)
+			require.Equal(t, "shop", rec.Namespace)
+			pol := &apiv3.StagedNetworkPolicy{}
+			require.NoError(t, json.Unmarshal(rec.Resource, pol))
+			require.Equal(t, rec.Name, pol.Name)
+			require.NoError(t, validator.Validate(pol))
+			policies = append(policies, pol)
+		}
+
+		// The backend only receives traffic from the frontend.
+		backendPol := policies[0]
+		require.Equal(t, "shop", backendPol.Namespace)
+		require.Equal(t, "default", backendPol.Spec.Tier)
+		require.Equal(t, apiv3.StagedActionSet, backendPol.Spec.StagedAction)
+		require.Equal(t, "app == 'backend'", backendPol.Spec.Selector)
+		require.Equal(t, []apiv3.PolicyType{apiv3.PolicyTypeIngress}, backendPol.Spec.Types)
+		require.Equal(t, []apiv3.Rule{{
+			Action:      apiv3.Allow,
+			Protocol:    &tcp,
+			Source:      apiv3.EntityRule{Selector: "app == 'frontend'"},
+			Destination: apiv3.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(8080)}},
+		}}, backendPol.Spec.Ingress)
+		require.Empty(t, backendPol.Spec.Egress)
+
+		// The frontend only sends traffic. Volatile labels should not be used in selectors.
+		frontendPol := policies[1]
+		require.Equal(t, "app == 'frontend'", frontendPol.Spec.Selector)
+		require.Equal(t, []apiv3.PolicyType{apiv3.PolicyTypeEgress}, frontendPol.Spec.Types)
+		require.Empty(t, frontendPol.Spec.Ingress)
+		require.Equal(t, []apiv3.Rule{
+			{
+				Action:      apiv3.Allow,
+				Protocol:    &tcp,
+				Destination: apiv3.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(443)}},
+			},
+			{
+				Action:      apiv3.Allow,
+				Protocol:    &tcp,
+				Destination: apiv3.EntityRule{Selector: "app == 'backend'", Ports: []numorstring.Port{numorstring.SinglePort(8080)}},
+			},
+			{
+				Action:   apiv3.Allow,
+				Protocol: &udp,
+				Destination: apiv3.EntityRule{
+					Selector:          "k8s-app == 'kube-dns'",
+					NamespaceSelector: "projectcalico.org/name == 'kube-system'",
+					Ports:             []numorstring.Port{numorstring.SinglePort(53)},
+				},
+			},
+		}, frontendPol.Spec.Egress)
+	})
+
+	t.Run("Host endpoints", func(t *testing.T) {
+		res, err := agg.PolicyRecommendations(&proto.PolicyRecommendationRequest{Tier: "security"})
+		require.NoError(t, err)
+		require.Len(t, res.Policies, 1)
+		require.Equal(t, apiv3.KindStagedGlobalNetworkPolicy, res.Policies[0].Kind)
+		require.Empty(t, res.Policies[0].Namespace)
+
+		pol := &apiv3.StagedGlobalNetworkPolicy{}
+		require.NoError(t, json.Unmarshal(res.Policies[0].Resource, pol))
+		require.True(t, strings.HasPrefix(pol.Name, "security."), pol.Name)
+		require.Equal(t, "security", pol.Spec.Tier)
+		require.Equal(t, "kubernetes.io/hostname == 'node-1'", pol.Spec.Selector)
+		require.Len(t, pol.Spec.Egress, 1)
+		require.NoError(t, validator.Validate(pol))
+	})
+
+	t.Run("No flows", func(t *testing.T) {
+		res, err := agg.PolicyRecommendations(&proto.PolicyRecommendationRequest{Namespace: "empty"})
+		require.NoError(t, err)
+		require.Empty(t, res.Policies)
+	})
+}
+
 func TestStatistics(t *testing.T) {
 	var roller *rolloverController
 
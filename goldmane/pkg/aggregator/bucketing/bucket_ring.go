@@ -31,6 +31,17 @@ type StreamReceiver interface {
 	Receive(FlowBuilder)
 }
 
+// Persister represents an object that can durably store completed buckets of flows.
+type Persister interface {
+	Persist(*FlowCollection)
+}
+
+// BucketObserver represents an object that is notified of each completed bucket of flows, for example
+// to track trends over time. It is called synchronously with the bucket ring, so must not block.
+type BucketObserver interface {
+	ObserveBucket(*FlowCollection)
+}
+
 // FlowBuilder provides an interface for building Flows. It allows us to conserve memory by
 // only rendering Flow objects when they match the filter.
 type FlowBuilder interface {
@@ -86,6 +97,13 @@ type BucketRing struct {
 	// satisfy stream requests.
 	streams StreamReceiver
 
+	// persister receives each bucket once it is complete, allowing it to be
+	// durably stored. May be nil, in which case buckets are only held in memory.
+	persister Persister
+
+	// observers are notified of each bucket once it is complete.
+	observers []BucketObserver
+
 	// pushAfter is the number of buckets from the head to wait before including
 	// a bucket in an aggregated flow for emission. We only push
 	// buckets after several rollovers have occurred, to ensure that we have
@@ -130,9 +148,14 @@ func NewBucketRing(n, interval int, now int64, opts ...BucketRingOption) *Bucket
 	oldestBucketStart := time.Unix(newestBucketStart-int64(interval*n), 0)
 	oldestBucketEnd := time.Unix(oldestBucketStart.Unix()+int64(interval), 0)
 	ring.buckets[0] = *NewAggregationBucket(oldestBucketStart, oldestBucketEnd)
+
+	// Observers should only see buckets that have actually been completed, not those seeded here.
+	observers := ring.observers
+	ring.observers = nil
 	for range n {
 		ring.Rollover()
 	}
+	ring.observers = observers
 
 	// Tell each bucket its absolute index and initialize the lookup function.
 	for i := range ring.buckets {
@@ -159,6 +182,9 @@ func (r *BucketRing) Rollover() (int64, set.Set[*types.DiachronicFlow]) {
 	// Send flows to the stream manager.
 	r.flushToStreams()
 
+	// Send the same completed bucket to the persister and observers, if configured.
+	r.flushCompleted()
+
 	// Move the head index to the next bucket.
 	r.headIndex = r.nextBucketIndex(r.headIndex)
 
@@ -512,6 +538,34 @@ func (r *BucketRing) flushToStreams() {
 	r.streamBucket(bucket, r.streams)
 }
 
+// flushCompleted sends the most recently completed bucket to the persister and observers, if any are configured.
+func (r *BucketRing) flushCompleted() {
+	if r.persister == nil && len(r.observers) == 0 {
+		return
+	}
+
+	b := r.streamingBucket()
+	c := NewFlowCollection(b.StartTime, b.EndTime)
+	if b.Flows != nil {
+		b.Flows.Iter(func(d *types.DiachronicFlow) error {
+			if f := d.Aggregate(b.StartTime, b.EndTime); f != nil {
+				c.AddFlow(*f)
+			}
+			return nil
+		})
+	}
+
+	// Observers are notified of every bucket, even if it is empty, so that they see time pass.
+	for _, o := range r.observers {
+		o.ObserveBucket(c)
+	}
+
+	if r.persister != nil && len(c.Flows) > 0 {
+		logrus.WithFields(b.Fields()).Debug("Persisting completed bucket")
+		r.persister.Persist(c)
+	}
+}
+
 func (r *BucketRing) streamBucket(b *AggregationBucket, s StreamReceiver) {
 	if b.Flows != nil {
 		b.Flows.Iter(func(d *types.DiachronicFlow) error {
@@ -44,3 +44,17 @@ func WithStreamReceiver(sm StreamReceiver) BucketRingOption {
 		r.streams = sm
 	}
 }
+
+func WithPersister(p Persister) BucketRingOption {
+	return func(r *BucketRing) {
+		logrus.WithField("persister", p).Debug("Setting bucket persister")
+		r.persister = p
+	}
+}
+
+func WithBucketObserver(o BucketObserver) BucketRingOption {
+	return func(r *BucketRing) {
+		logrus.WithField("observer", o).Debug("Adding bucket observer")
+		r.observers = append(r.observers, o)
+	}
+}
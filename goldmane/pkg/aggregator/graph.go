@@ -0,0 +1,159 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+type edgeKey struct {
+	source string
+	dest   string
+}
+
+// graphBuilder builds a graph of nodes and edges from a set of aggregated Flows.
+type graphBuilder struct {
+	granularity proto.GraphGranularity
+
+	nodes map[string]*proto.GraphNode
+
+	// edges tracks the statistics for each edge, separately for each reporter. A connection between two
+	// Calico endpoints is typically reported by both the source and the destination, so we keep the views
+	// separate and combine them when building the result in order to avoid counting traffic twice.
+	edges map[edgeKey]map[proto.Reporter]*proto.GraphEdge
+}
+
+func newGraphBuilder(granularity proto.GraphGranularity) *graphBuilder {
+	return &graphBuilder{
+		granularity: granularity,
+		nodes:       map[string]*proto.GraphNode{},
+		edges:       map[edgeKey]map[proto.Reporter]*proto.GraphEdge{},
+	}
+}
+
+// add adds the given Flow to the graph.
+func (g *graphBuilder) add(f *types.Flow) {
+	src := g.node(f.Key.SourceType(), f.Key.SourceNamespace(), f.Key.SourceName())
+	dst := g.node(f.Key.DestType(), f.Key.DestNamespace(), f.Key.DestName())
+
+	k := edgeKey{source: src.Id, dest: dst.Id}
+	if _, ok := g.edges[k]; !ok {
+		g.edges[k] = map[proto.Reporter]*proto.GraphEdge{}
+	}
+	e, ok := g.edges[k][f.Key.Reporter()]
+	if !ok {
+		e = &proto.GraphEdge{Source: src.Id, Dest: dst.Id}
+		g.edges[k][f.Key.Reporter()] = e
+	}
+
+	e.PacketsIn += f.PacketsIn
+	e.PacketsOut += f.PacketsOut
+	e.BytesIn += f.BytesIn
+	e.BytesOut += f.BytesOut
+	e.NumConnectionsStarted += f.NumConnectionsStarted
+	e.NumConnectionsCompleted += f.NumConnectionsCompleted
+	e.NumConnectionsLive += f.NumConnectionsLive
+
+	switch f.Key.Action() {
+	case proto.Action_Allow:
+		e.AllowedFlows++
+	case proto.Action_Deny:
+		e.DeniedFlows++
+	}
+}
+
+// node returns the graph node for the given endpoint, creating it if needed.
+func (g *graphBuilder) node(t proto.EndpointType, namespace, name string) *proto.GraphNode {
+	namespace = normalizeNamespace(namespace)
+
+	var n *proto.GraphNode
+	if g.granularity == proto.GraphGranularity_GraphGranularityNamespace && namespace != "" {
+		n = &proto.GraphNode{
+			Id:        fmt.Sprintf("namespace/%s", namespace),
+			Type:      proto.GraphNodeType_GraphNodeTypeNamespace,
+			Namespace: namespace,
+		}
+	} else {
+		nt := graphNodeType(t)
+		n = &proto.GraphNode{
+			Id:        fmt.Sprintf("%s/%s/%s", strings.ToLower(strings.TrimPrefix(nt.String(), "GraphNodeType")), namespace, name),
+			Type:      nt,
+			Namespace: namespace,
+			Name:      name,
+		}
+	}
+
+	if existing, ok := g.nodes[n.Id]; ok {
+		return existing
+	}
+	g.nodes[n.Id] = n
+	return n
+}
+
+// result returns the graph, with nodes and edges sorted by ID.
+func (g *graphBuilder) result() *proto.GraphResult {
+	res := &proto.GraphResult{}
+	for _, n := range g.nodes {
+		res.Nodes = append(res.Nodes, n)
+	}
+	sort.Slice(res.Nodes, func(i, j int) bool {
+		return res.Nodes[i].Id < res.Nodes[j].Id
+	})
+
+	for k, byReporter := range g.edges {
+		// Combine the views from each reporter by taking the largest value of each statistic. Where both
+		// ends report a connection, they observe (roughly) the same traffic; where only one end reports, the
+		// other view will be empty.
+		e := &proto.GraphEdge{Source: k.source, Dest: k.dest}
+		for _, r := range byReporter {
+			e.PacketsIn = max(e.PacketsIn, r.PacketsIn)
+			e.PacketsOut = max(e.PacketsOut, r.PacketsOut)
+			e.BytesIn = max(e.BytesIn, r.BytesIn)
+			e.BytesOut = max(e.BytesOut, r.BytesOut)
+			e.NumConnectionsStarted = max(e.NumConnectionsStarted, r.NumConnectionsStarted)
+			e.NumConnectionsCompleted = max(e.NumConnectionsCompleted, r.NumConnectionsCompleted)
+			e.NumConnectionsLive = max(e.NumConnectionsLive, r.NumConnectionsLive)
+			e.AllowedFlows = max(e.AllowedFlows, r.AllowedFlows)
+			e.DeniedFlows = max(e.DeniedFlows, r.DeniedFlows)
+		}
+		res.Edges = append(res.Edges, e)
+	}
+	sort.Slice(res.Edges, func(i, j int) bool {
+		if res.Edges[i].Source != res.Edges[j].Source {
+			return res.Edges[i].Source < res.Edges[j].Source
+		}
+		return res.Edges[i].Dest < res.Edges[j].Dest
+	})
+	return res
+}
+
+func graphNodeType(t proto.EndpointType) proto.GraphNodeType {
+	switch t {
+	case proto.EndpointType_WorkloadEndpoint:
+		return proto.GraphNodeType_GraphNodeTypeWorkload
+	case proto.EndpointType_HostEndpoint:
+		return proto.GraphNodeType_GraphNodeTypeHost
+	case proto.EndpointType_NetworkSet:
+		return proto.GraphNodeType_GraphNodeTypeNetworkSet
+	case proto.EndpointType_Network:
+		return proto.GraphNodeType_GraphNodeTypeNetwork
+	}
+	return proto.GraphNodeType_GraphNodeTypeUnspecified
+}
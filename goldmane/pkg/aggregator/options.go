@@ -17,6 +17,7 @@ package aggregator
 import (
 	"time"
 
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
 	"github.com/projectcalico/calico/libcalico-go/lib/health"
 )
 
@@ -63,3 +64,18 @@ func WithHealthAggregator(ha *health.HealthAggregator) Option {
 		a.health = ha
 	}
 }
+
+// WithFlowStore configures a durable store for completed buckets. Completed buckets are written to the store,
+// and flow history is restored from it when the aggregator starts.
+func WithFlowStore(s FlowStore) Option {
+	return func(a *LogAggregator) {
+		a.store = s
+	}
+}
+
+// WithBucketObserver adds an observer that is notified of each aggregation bucket once it is complete.
+func WithBucketObserver(o bucketing.BucketObserver) Option {
+	return func(a *LogAggregator) {
+		a.observers = append(a.observers, o)
+	}
+}
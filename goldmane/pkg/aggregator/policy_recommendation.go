@@ -0,0 +1,348 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strings"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	defaultTier = "default"
+
+	// recommendedPolicyPrefix is the prefix given to the names of recommended policies.
+	recommendedPolicyPrefix = "recommended-"
+
+	// namespaceNameLabel is the label Calico applies to each namespace, containing its name.
+	namespaceNameLabel = "projectcalico.org/name"
+)
+
+// ignoredLabels are labels that differ between otherwise identical workloads, and so are not useful for
+// identifying them in a policy.
+var ignoredLabels = map[string]bool{
+	"pod-template-hash":                  true,
+	"pod-template-generation":            true,
+	"controller-revision-hash":           true,
+	"statefulset.kubernetes.io/pod-name": true,
+}
+
+// recEndpoint identifies an endpoint in the recommendation.
+type recEndpoint struct {
+	t         proto.EndpointType
+	namespace string
+	name      string
+}
+
+// recRule identifies a single observed connection, from the point of view of a local endpoint.
+type recRule struct {
+	ingress  bool
+	peer     recEndpoint
+	protocol string
+	port     int64
+}
+
+// policyRecommender builds recommended policies from observed Flows. Policies are recommended for the
+// endpoints in a single namespace - or, if the namespace is empty, for host endpoints.
+//
+// Only allowed Flows are considered, with egress rules built from Flows reported by the source and ingress rules
+// from Flows reported by the destination. Endpoints are identified by the intersection of the labels seen across
+// all of their Flows, and endpoints with identical labels share a policy.
+type policyRecommender struct {
+	namespace string
+	tier      string
+
+	// labels tracks the intersection of labels seen for each endpoint.
+	labels map[recEndpoint]map[string]string
+
+	// rules tracks the observed connections for each local endpoint.
+	rules map[recEndpoint]map[recRule]struct{}
+}
+
+func newPolicyRecommender(namespace, tier string) *policyRecommender {
+	if tier == "" {
+		tier = defaultTier
+	}
+	return &policyRecommender{
+		namespace: namespace,
+		tier:      tier,
+		labels:    map[recEndpoint]map[string]string{},
+		rules:     map[recEndpoint]map[recRule]struct{}{},
+	}
+}
+
+// add adds the given Flow to the recommendation, if it is relevant.
+func (p *policyRecommender) add(f *types.Flow) {
+	if f.Key.Action() != proto.Action_Allow {
+		return
+	}
+
+	src := recEndpoint{f.Key.SourceType(), normalizeNamespace(f.Key.SourceNamespace()), f.Key.SourceName()}
+	dst := recEndpoint{f.Key.DestType(), normalizeNamespace(f.Key.DestNamespace()), f.Key.DestName()}
+
+	var local, peer recEndpoint
+	var localLabels, peerLabels map[string]string
+	var ingress bool
+	switch f.Key.Reporter() {
+	case proto.Reporter_Src:
+		local, peer = src, dst
+		localLabels, peerLabels = types.LabelMap(f.SourceLabels), types.LabelMap(f.DestLabels)
+	case proto.Reporter_Dst:
+		local, peer = dst, src
+		localLabels, peerLabels = types.LabelMap(f.DestLabels), types.LabelMap(f.SourceLabels)
+		ingress = true
+	default:
+		return
+	}
+	if !p.isLocal(local) {
+		return
+	}
+
+	p.mergeLabels(local, localLabels)
+	p.mergeLabels(peer, peerLabels)
+
+	if _, ok := p.rules[local]; !ok {
+		p.rules[local] = map[recRule]struct{}{}
+	}
+	p.rules[local][recRule{
+		ingress:  ingress,
+		peer:     peer,
+		protocol: f.Key.Proto(),
+		port:     f.Key.DestPort(),
+	}] = struct{}{}
+}
+
+// isLocal returns true if the given endpoint is one that we are recommending policy for.
+func (p *policyRecommender) isLocal(ep recEndpoint) bool {
+	if p.namespace == "" {
+		return ep.t == proto.EndpointType_HostEndpoint
+	}
+	return ep.t == proto.EndpointType_WorkloadEndpoint && ep.namespace == p.namespace
+}
+
+// mergeLabels updates the labels for the given endpoint to the intersection of the existing labels and those given.
+func (p *policyRecommender) mergeLabels(ep recEndpoint, labels map[string]string) {
+	existing, ok := p.labels[ep]
+	if !ok {
+		p.labels[ep] = labels
+		return
+	}
+	for k, v := range existing {
+		if labels[k] != v {
+			delete(existing, k)
+		}
+	}
+}
+
+// result returns the recommended policies.
+func (p *policyRecommender) result() (*proto.PolicyRecommendationResult, error) {
+	// Group local endpoints by selector, so that endpoints that can't be told apart share a policy.
+	rulesBySelector := map[string]map[recRule]struct{}{}
+	for ep, rules := range p.rules {
+		sel := labelSelector(p.labels[ep])
+		if _, ok := rulesBySelector[sel]; !ok {
+			rulesBySelector[sel] = map[recRule]struct{}{}
+		}
+		maps.Copy(rulesBySelector[sel], rules)
+	}
+
+	res := &proto.PolicyRecommendationResult{}
+	for _, sel := range slices.Sorted(maps.Keys(rulesBySelector)) {
+		ingress, egress := p.buildRules(rulesBySelector[sel])
+		rec, err := p.buildPolicy(sel, ingress, egress)
+		if err != nil {
+			return nil, err
+		}
+		res.Policies = append(res.Policies, rec)
+	}
+	return res, nil
+}
+
+// buildRules converts the observed connections into ingress and egress rules, combining the ports for
+// connections with the same peer and protocol into a single rule.
+func (p *policyRecommender) buildRules(rules map[recRule]struct{}) ([]apiv3.Rule, []apiv3.Rule) {
+	type ruleKey struct {
+		ingress  bool
+		peer     string
+		protocol string
+	}
+	peers := map[ruleKey]apiv3.EntityRule{}
+	ports := map[ruleKey]map[int64]struct{}{}
+	for r := range rules {
+		peer := p.peerEntityRule(r.peer)
+		k := ruleKey{ingress: r.ingress, peer: peer.NamespaceSelector + "/" + peer.Selector, protocol: r.protocol}
+		peers[k] = peer
+		if _, ok := ports[k]; !ok {
+			ports[k] = map[int64]struct{}{}
+		}
+		if r.port != 0 {
+			ports[k][r.port] = struct{}{}
+		}
+	}
+
+	// Sort the rules so that the output is deterministic.
+	keys := slices.SortedFunc(maps.Keys(peers), func(a, b ruleKey) int {
+		if a.peer != b.peer {
+			return strings.Compare(a.peer, b.peer)
+		}
+		return strings.Compare(a.protocol, b.protocol)
+	})
+
+	var ingress, egress []apiv3.Rule
+	for _, k := range keys {
+		rule := apiv3.Rule{Action: apiv3.Allow}
+		if k.protocol != "" {
+			protocol := numorstring.ProtocolFromString(k.protocol)
+			rule.Protocol = &protocol
+		}
+
+		// Ports can only be specified for protocols that support them.
+		var rulePorts []numorstring.Port
+		if rule.Protocol != nil && rule.Protocol.SupportsPorts() {
+			for _, port := range slices.Sorted(maps.Keys(ports[k])) {
+				rulePorts = append(rulePorts, numorstring.SinglePort(uint16(port)))
+			}
+		}
+
+		if k.ingress {
+			rule.Source = peers[k]
+			rule.Destination.Ports = rulePorts
+			ingress = append(ingress, rule)
+		} else {
+			rule.Destination = peers[k]
+			rule.Destination.Ports = rulePorts
+			egress = append(egress, rule)
+		}
+	}
+	return ingress, egress
+}
+
+// peerEntityRule returns an EntityRule that matches the given peer endpoint.
+func (p *policyRecommender) peerEntityRule(peer recEndpoint) apiv3.EntityRule {
+	switch peer.t {
+	case proto.EndpointType_WorkloadEndpoint, proto.EndpointType_NetworkSet, proto.EndpointType_HostEndpoint:
+		r := apiv3.EntityRule{Selector: labelSelector(p.labels[peer])}
+		switch {
+		case peer.namespace == "":
+			r.NamespaceSelector = "global()"
+		case peer.namespace != p.namespace:
+			// For namespaced policies, selectors match endpoints in the policy's namespace unless a namespace
+			// selector is given. For global policies, we always need one.
+			r.NamespaceSelector = fmt.Sprintf("%s == '%s'", namespaceNameLabel, peer.namespace)
+		}
+		return r
+	}
+
+	// Flows don't include the addresses of endpoints outside the cluster, so the best we can do is
+	// match on protocol and port alone.
+	return apiv3.EntityRule{}
+}
+
+// buildPolicy builds a staged policy with the given selector and rules.
+func (p *policyRecommender) buildPolicy(sel string, ingress, egress []apiv3.Rule) (*proto.RecommendedPolicy, error) {
+	name := policyName(p.tier, sel)
+
+	var policyTypes []apiv3.PolicyType
+	if len(ingress) > 0 {
+		policyTypes = append(policyTypes, apiv3.PolicyTypeIngress)
+	}
+	if len(egress) > 0 {
+		policyTypes = append(policyTypes, apiv3.PolicyTypeEgress)
+	}
+
+	var obj any
+	var kind string
+	if p.namespace == "" {
+		pol := apiv3.NewStagedGlobalNetworkPolicy()
+		pol.Name = name
+		pol.Spec = apiv3.StagedGlobalNetworkPolicySpec{
+			StagedAction: apiv3.StagedActionSet,
+			Tier:         p.tier,
+			Selector:     sel,
+			Types:        policyTypes,
+			Ingress:      ingress,
+			Egress:       egress,
+		}
+		obj, kind = pol, apiv3.KindStagedGlobalNetworkPolicy
+	} else {
+		pol := apiv3.NewStagedNetworkPolicy()
+		pol.Name = name
+		pol.Namespace = p.namespace
+		pol.Spec = apiv3.StagedNetworkPolicySpec{
+			StagedAction: apiv3.StagedActionSet,
+			Tier:         p.tier,
+			Selector:     sel,
+			Types:        policyTypes,
+			Ingress:      ingress,
+			Egress:       egress,
+		}
+		obj, kind = pol, apiv3.KindStagedNetworkPolicy
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recommended policy %s: %w", name, err)
+	}
+	return &proto.RecommendedPolicy{
+		Kind:      kind,
+		Name:      name,
+		Namespace: p.namespace,
+		Resource:  b,
+	}, nil
+}
+
+// policyName returns a stable name for a recommended policy with the given selector. Policies outside of
+// the default tier must be prefixed with the tier name.
+func policyName(tier, sel string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(sel))
+	name := fmt.Sprintf("%s%08x", recommendedPolicyPrefix, h.Sum32())
+	if tier != defaultTier {
+		name = tier + "." + name
+	}
+	return name
+}
+
+// labelSelector returns a selector that matches the given labels.
+func labelSelector(labels map[string]string) string {
+	var terms []string
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		if ignoredLabels[k] || strings.HasPrefix(k, "projectcalico.org/") {
+			continue
+		}
+		terms = append(terms, fmt.Sprintf("%s == '%s'", k, labels[k]))
+	}
+	if len(terms) == 0 {
+		return "all()"
+	}
+	return strings.Join(terms, " && ")
+}
+
+// normalizeNamespace converts the placeholder used for non-namespaced endpoints to an empty namespace.
+func normalizeNamespace(ns string) string {
+	if ns == "-" {
+		return ""
+	}
+	return ns
+}
@@ -47,35 +47,10 @@ func (a *RingIndex) List(opts IndexFindOpts) ([]*types.Flow, types.ListMeta) {
 	}).Debug("Listing flows from time sorted index")
 
 	// Default to time-sorted flow data.
-	// Collect all the flow keys across all buckets that match the request. We will then
-	// use DiachronicFlow data to combine statistics together for each key across the time range.
-	keys := a.agg.flowSet(opts.startTimeGt, opts.startTimeLt)
-
-	// Aggregate the relevant DiachronicFlows across the time range.
-	flowsByKey := map[types.FlowKey]*types.Flow{}
-	keys.Iter(func(d *types.DiachronicFlow) error {
-		logCtx := logrus.WithField("id", d.ID)
-		if logrus.IsLevelEnabled(logrus.DebugLevel) {
-			// Unpacking the key is a bit expensive, so only do it in debug mode.
-			logCtx = logrus.WithFields(d.Key.Fields())
-		}
-		logCtx.WithFields(logrus.Fields{"filter": opts.filter}).Debug("Checking if flow matches filter")
-		if d.Matches(opts.filter, opts.startTimeGt, opts.startTimeLt) {
-			logCtx.Debug("Flow matches filter")
-			flow := d.Aggregate(opts.startTimeGt, opts.startTimeLt)
-			if flow != nil {
-				logCtx.Debug("Aggregated flow")
-				flowsByKey[*flow.Key] = flow
-			}
-		}
-		return nil
-	})
-
-	// Convert the map to a slice.
 	flows := []*types.Flow{}
-	for _, flow := range flowsByKey {
+	a.Iter(opts, func(flow *types.Flow) {
 		flows = append(flows, flow)
-	}
+	})
 
 	// Sort the flows by start time, sorting newer flows first.
 	sort.Slice(flows, func(i, j int) bool {
@@ -111,6 +86,34 @@ func (a *RingIndex) List(opts IndexFindOpts) ([]*types.Flow, types.ListMeta) {
 	return flows, calculateListMeta(totalFlows, int(opts.pageSize))
 }
 
+// Iter calls f with the aggregated Flow for each DiachronicFlow that matches the given filter and time range, in
+// no particular order. Pagination options are ignored. This allows callers that need to process every matching
+// Flow to do so without the overhead of sorting.
+func (a *RingIndex) Iter(opts IndexFindOpts, f func(*types.Flow)) {
+	// Collect all the flow keys across all buckets that match the request. We will then
+	// use DiachronicFlow data to combine statistics together for each key across the time range.
+	keys := a.agg.flowSet(opts.startTimeGt, opts.startTimeLt)
+
+	// Aggregate the relevant DiachronicFlows across the time range.
+	keys.Iter(func(d *types.DiachronicFlow) error {
+		logCtx := logrus.WithField("id", d.ID)
+		if logrus.IsLevelEnabled(logrus.DebugLevel) {
+			// Unpacking the key is a bit expensive, so only do it in debug mode.
+			logCtx = logrus.WithFields(d.Key.Fields())
+		}
+		logCtx.WithFields(logrus.Fields{"filter": opts.filter}).Debug("Checking if flow matches filter")
+		if d.Matches(opts.filter, opts.startTimeGt, opts.startTimeLt) {
+			logCtx.Debug("Flow matches filter")
+			flow := d.Aggregate(opts.startTimeGt, opts.startTimeLt)
+			if flow != nil {
+				logCtx.Debug("Aggregated flow")
+				f(flow)
+			}
+		}
+		return nil
+	})
+}
+
 func (r *RingIndex) Add(d *types.DiachronicFlow) {
 }
 
@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregator
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+// defaultTopTalkersLimit is the number of results to return if no limit is specified.
+const defaultTopTalkersLimit = 10
+
+// talkerKey identifies a group of Flows. Only the fields relevant to the grouping are set.
+type talkerKey struct {
+	sourceNamespace string
+	sourceName      string
+	destNamespace   string
+	destName        string
+	proto           string
+	destPort        int64
+}
+
+// talkerStats holds the statistics for a group as seen by a single reporter.
+type talkerStats struct {
+	packets       int64
+	bytes         int64
+	connections   int64
+	deniedPackets int64
+}
+
+// topTalkers accumulates statistics for groups of Flows and selects the highest ranked groups.
+type topTalkers struct {
+	groupBy proto.TopTalkersGroupBy
+	rankBy  proto.TopTalkersRankBy
+
+	// groups tracks the statistics for each group, separately for each reporter, so that traffic reported
+	// by both ends of a connection is not counted twice.
+	groups map[talkerKey]map[proto.Reporter]*talkerStats
+}
+
+func newTopTalkers(groupBy proto.TopTalkersGroupBy, rankBy proto.TopTalkersRankBy) *topTalkers {
+	return &topTalkers{
+		groupBy: groupBy,
+		rankBy:  rankBy,
+		groups:  map[talkerKey]map[proto.Reporter]*talkerStats{},
+	}
+}
+
+// add adds the given Flow's statistics to its group.
+func (t *topTalkers) add(f *types.Flow) {
+	var k talkerKey
+	switch t.groupBy {
+	case proto.TopTalkersGroupBy_TopTalkersGroupBySource:
+		k.sourceNamespace, k.sourceName = f.Key.SourceNamespace(), f.Key.SourceName()
+	case proto.TopTalkersGroupBy_TopTalkersGroupByDest:
+		k.destNamespace, k.destName = f.Key.DestNamespace(), f.Key.DestName()
+	case proto.TopTalkersGroupBy_TopTalkersGroupByNamespacePair:
+		k.sourceNamespace, k.destNamespace = f.Key.SourceNamespace(), f.Key.DestNamespace()
+	case proto.TopTalkersGroupBy_TopTalkersGroupByDestPort:
+		k.proto, k.destPort = f.Key.Proto(), f.Key.DestPort()
+	}
+
+	if _, ok := t.groups[k]; !ok {
+		t.groups[k] = map[proto.Reporter]*talkerStats{}
+	}
+	s, ok := t.groups[k][f.Key.Reporter()]
+	if !ok {
+		s = &talkerStats{}
+		t.groups[k][f.Key.Reporter()] = s
+	}
+
+	packets := f.PacketsIn + f.PacketsOut
+	s.packets += packets
+	s.bytes += f.BytesIn + f.BytesOut
+	s.connections += f.NumConnectionsStarted
+	if f.Key.Action() == proto.Action_Deny {
+		s.deniedPackets += packets
+	}
+}
+
+// rank returns the statistic used to rank the given group.
+func (t *topTalkers) rank(tt *proto.TopTalker) int64 {
+	switch t.rankBy {
+	case proto.TopTalkersRankBy_TopTalkersRankByPackets:
+		return tt.Packets
+	case proto.TopTalkersRankBy_TopTalkersRankByConnections:
+		return tt.NumConnectionsStarted
+	case proto.TopTalkersRankBy_TopTalkersRankByDeniedPackets:
+		return tt.DeniedPackets
+	}
+	return tt.Bytes
+}
+
+// result returns up to limit groups, ordered from highest to lowest rank. Rather than sorting every group,
+// it maintains a min-heap of the best limit groups seen so far.
+func (t *topTalkers) result(limit int) *proto.TopTalkersResult {
+	h := &talkerHeap{rank: t.rank}
+	for k, byReporter := range t.groups {
+		tt := &proto.TopTalker{
+			SourceNamespace: k.sourceNamespace,
+			SourceName:      k.sourceName,
+			DestNamespace:   k.destNamespace,
+			DestName:        k.destName,
+			Proto:           k.proto,
+			DestPort:        k.destPort,
+		}
+		for _, s := range byReporter {
+			tt.Packets = max(tt.Packets, s.packets)
+			tt.Bytes = max(tt.Bytes, s.bytes)
+			tt.NumConnectionsStarted = max(tt.NumConnectionsStarted, s.connections)
+			tt.DeniedPackets = max(tt.DeniedPackets, s.deniedPackets)
+		}
+
+		if h.Len() < limit {
+			heap.Push(h, tt)
+		} else if h.less(h.talkers[0], tt) {
+			// This group outranks the lowest ranked group in the heap, so replace it.
+			h.talkers[0] = tt
+			heap.Fix(h, 0)
+		}
+	}
+
+	// Pop the heap to produce results from lowest to highest rank, then reverse.
+	res := &proto.TopTalkersResult{Talkers: make([]*proto.TopTalker, h.Len())}
+	for i := len(res.Talkers) - 1; i >= 0; i-- {
+		res.Talkers[i] = heap.Pop(h).(*proto.TopTalker)
+	}
+	return res
+}
+
+// talkerHeap is a min-heap of TopTalkers, ordered by rank.
+type talkerHeap struct {
+	talkers []*proto.TopTalker
+	rank    func(*proto.TopTalker) int64
+}
+
+// less orders talkers by rank, using the grouping fields as a tie-breaker so that results are deterministic.
+func (h *talkerHeap) less(a, b *proto.TopTalker) bool {
+	if ra, rb := h.rank(a), h.rank(b); ra != rb {
+		return ra < rb
+	}
+	return slices.Compare(talkerSortKey(a), talkerSortKey(b)) > 0
+}
+
+func (h *talkerHeap) Len() int           { return len(h.talkers) }
+func (h *talkerHeap) Less(i, j int) bool { return h.less(h.talkers[i], h.talkers[j]) }
+func (h *talkerHeap) Swap(i, j int)      { h.talkers[i], h.talkers[j] = h.talkers[j], h.talkers[i] }
+func (h *talkerHeap) Push(x any)         { h.talkers = append(h.talkers, x.(*proto.TopTalker)) }
+func (h *talkerHeap) Pop() any {
+	n := len(h.talkers)
+	x := h.talkers[n-1]
+	h.talkers = h.talkers[:n-1]
+	return x
+}
+
+func talkerSortKey(t *proto.TopTalker) []string {
+	return []string{
+		t.SourceNamespace,
+		t.SourceName,
+		t.DestNamespace,
+		t.DestName,
+		t.Proto,
+		fmt.Sprintf("%020d", t.DestPort),
+	}
+}
@@ -15,11 +15,13 @@
 package aggregator_test
 
 import (
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
 )
 
 // testSink implements the Sink interface for testing.
@@ -31,6 +33,57 @@ func (t *testSink) Receive(b *bucketing.FlowCollection) {
 	t.buckets = append(t.buckets, b)
 }
 
+// testStore implements the FlowStore interface for testing.
+type testStore struct {
+	sync.Mutex
+	buckets []*bucketing.FlowCollection
+}
+
+func (t *testStore) Persist(b *bucketing.FlowCollection) {
+	t.Lock()
+	defer t.Unlock()
+	t.buckets = append(t.buckets, b)
+}
+
+func (t *testStore) Load(startGte int64) ([]*types.Flow, error) {
+	t.Lock()
+	defer t.Unlock()
+	var flows []*types.Flow
+	for _, b := range t.buckets {
+		if b.StartTime < startGte {
+			continue
+		}
+		for i := range b.Flows {
+			flows = append(flows, &b.Flows[i])
+		}
+	}
+	return flows, nil
+}
+
+func (t *testStore) numBuckets() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.buckets)
+}
+
+// testObserver implements the BucketObserver interface for testing.
+type testObserver struct {
+	sync.Mutex
+	buckets []*bucketing.FlowCollection
+}
+
+func (t *testObserver) ObserveBucket(b *bucketing.FlowCollection) {
+	t.Lock()
+	defer t.Unlock()
+	t.buckets = append(t.buckets, b)
+}
+
+func (t *testObserver) numBuckets() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.buckets)
+}
+
 // rolloverController is a helper struct to control when rollovers occur.
 type rolloverController struct {
 	ch                    chan time.Time
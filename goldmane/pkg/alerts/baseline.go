@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import "math"
+
+// baseline tracks an exponentially weighted moving average and variance of a series of values, and whether
+// the series is currently anomalous.
+type baseline struct {
+	mean     float64
+	variance float64
+
+	// active is true while the series is above its threshold, so that a single alert is raised for each
+	// excursion from the baseline.
+	active bool
+}
+
+// threshold returns the value above which the series is considered anomalous.
+func (b *baseline) threshold(sensitivity float64) float64 {
+	return b.mean + sensitivity*math.Sqrt(b.variance)
+}
+
+// update adds a new value to the series, using the given smoothing factor.
+func (b *baseline) update(x, alpha float64) {
+	diff := x - b.mean
+	incr := alpha * diff
+	b.mean += incr
+	b.variance = (1 - alpha) * (b.variance + diff*incr)
+}
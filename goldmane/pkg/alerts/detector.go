@@ -0,0 +1,430 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	// recentAlertsSize is the number of recently raised alerts kept for sending to new streams.
+	recentAlertsSize = 1000
+
+	// subscriptionBuffer is the number of alerts that can be queued for a stream before alerts are dropped.
+	subscriptionBuffer = 100
+
+	// maxNewConnectionAlerts limits the number of new connection alerts raised for a single bucket, so that
+	// a large change in traffic (e.g., a new application being deployed) doesn't flood consumers. Further
+	// connections in the bucket are still learned.
+	maxNewConnectionAlerts = 100
+
+	// maxConnections limits the number of source and destination pairs remembered.
+	maxConnections = 100000
+
+	// sweepInterval is how often, in seconds, expired source and destination pairs are removed.
+	sweepInterval = 600
+)
+
+var (
+	alertsRaised = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goldmane_alerts_total",
+		Help: "Total number of alerts raised, by type.",
+	}, []string{"type"})
+
+	denySpikeActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "goldmane_deny_spike_active",
+		Help: "Set to 1 while the rate of denied packets for a namespace or policy is above its baseline.",
+	}, []string{"scope", "name"})
+)
+
+func init() {
+	prometheus.MustRegister(alertsRaised, denySpikeActive)
+}
+
+// seriesKey identifies a deny rate series, for either a namespace or a policy.
+type seriesKey struct {
+	scope     proto.AlertScope
+	namespace string
+	policy    policyKey
+}
+
+type policyKey struct {
+	kind      proto.PolicyKind
+	tier      string
+	namespace string
+	name      string
+}
+
+// pairKey identifies traffic between a source and destination.
+type pairKey struct {
+	sourceNamespace string
+	sourceName      string
+	destNamespace   string
+	destName        string
+	proto           string
+	destPort        int64
+}
+
+// Detector watches completed aggregation buckets for unusual activity, raising alerts when the rate of denied
+// traffic for a namespace or policy rises well above its baseline, and when traffic is first seen between a
+// source and destination.
+//
+// Baselines are exponentially weighted moving averages of the rate of denied packets in each bucket, and an
+// alert is raised when the rate exceeds the baseline by a configurable number of standard deviations. Only one
+// alert is raised for each excursion above the baseline.
+type Detector struct {
+	sensitivity         float64
+	minDenyRate         float64
+	baselineWindow      time.Duration
+	warmup              time.Duration
+	connectionRetention time.Duration
+	nowFunc             func() time.Time
+
+	// The following fields are only accessed from ObserveBucket, which is called synchronously
+	// with the bucket ring.
+
+	// start is the start time of the first bucket observed.
+	start int64
+
+	// series tracks the baseline for each deny rate series.
+	series map[seriesKey]*baseline
+
+	// connections tracks when each source and destination pair was last seen.
+	connections map[pairKey]int64
+	lastSweep   int64
+
+	// lock protects the fields below, which are shared with streams.
+	lock          sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	recent        []*proto.Alert
+}
+
+// Make sure Detector implements the BucketObserver interface, so it can be attached to the bucket ring.
+var _ bucketing.BucketObserver = &Detector{}
+
+func NewDetector(opts ...Option) *Detector {
+	d := &Detector{
+		sensitivity:         3,
+		minDenyRate:         1,
+		baselineWindow:      time.Hour,
+		warmup:              15 * time.Minute,
+		connectionRetention: 24 * time.Hour,
+		nowFunc:             time.Now,
+		series:              map[seriesKey]*baseline{},
+		connections:         map[pairKey]int64{},
+		subscriptions:       map[*Subscription]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// ObserveBucket updates baselines and known connections from the Flows in a completed bucket, raising
+// alerts for any unusual activity.
+func (d *Detector) ObserveBucket(c *bucketing.FlowCollection) {
+	duration := c.EndTime - c.StartTime
+	if duration <= 0 {
+		return
+	}
+	if d.start == 0 {
+		d.start = c.StartTime
+	}
+
+	// Don't raise alerts until we've had a chance to learn what normal looks like.
+	warm := time.Duration(c.EndTime-d.start)*time.Second >= d.warmup
+
+	rates := map[seriesKey]float64{}
+	numNewConnections := 0
+	for i := range c.Flows {
+		f := &c.Flows[i]
+		if d.observeConnection(f, c.EndTime) && warm {
+			if numNewConnections < maxNewConnectionAlerts {
+				d.raise(newConnectionAlert(f, c.EndTime))
+			}
+			numNewConnections++
+		}
+
+		if f.Key.Action() != proto.Action_Deny {
+			continue
+		}
+		rate := float64(f.PacketsIn+f.PacketsOut) / float64(duration)
+
+		// Attribute denied traffic to the namespace of the endpoint that denied it.
+		ns := f.Key.SourceNamespace()
+		if f.Key.Reporter() == proto.Reporter_Dst {
+			ns = f.Key.DestNamespace()
+		}
+		if ns != "" && ns != "-" {
+			rates[seriesKey{scope: proto.AlertScope_AlertScopeNamespace, namespace: ns}] += rate
+		}
+		if p, ok := denyingPolicy(f.Key); ok {
+			rates[seriesKey{scope: proto.AlertScope_AlertScopePolicy, policy: p}] += rate
+		}
+	}
+	if numNewConnections > maxNewConnectionAlerts {
+		logrus.WithField("num", numNewConnections).Warn("Too many new connections in bucket, some alerts suppressed")
+	}
+
+	// Update every series, including those with no denied traffic in this bucket.
+	for k := range rates {
+		if _, ok := d.series[k]; !ok {
+			d.series[k] = &baseline{}
+		}
+	}
+	alpha := 2 / (d.baselineWindow.Seconds()/float64(duration) + 1)
+	for k, b := range d.series {
+		d.updateSeries(k, b, rates[k], c.EndTime, warm, alpha)
+	}
+
+	d.sweep(c.EndTime)
+}
+
+// updateSeries updates the baseline for a deny rate series, raising an alert if the given rate is
+// well above it.
+func (d *Detector) updateSeries(k seriesKey, b *baseline, rate float64, t int64, warm bool, alpha float64) {
+	defer b.update(rate, alpha)
+
+	if warm {
+		threshold := b.threshold(d.sensitivity)
+		anomalous := rate >= d.minDenyRate && rate > threshold
+		switch {
+		case anomalous && !b.active:
+			b.active = true
+			denySpikeActive.WithLabelValues(k.scope.String(), k.name()).Set(1)
+			d.raise(denySpikeAlert(k, rate, b.mean, t))
+		case !anomalous && b.active:
+			b.active = false
+			denySpikeActive.DeleteLabelValues(k.scope.String(), k.name())
+		}
+	}
+
+	if !b.active && rate == 0 && b.mean < d.minDenyRate/100 {
+		// The series has been quiet for long enough that its baseline is effectively zero, which is
+		// what a new series starts with. Stop tracking it.
+		delete(d.series, k)
+	}
+}
+
+// observeConnection records that traffic was seen for the Flow's source and destination, returning
+// true if it had not been seen before.
+func (d *Detector) observeConnection(f *types.Flow, t int64) bool {
+	k := pairKey{
+		sourceNamespace: f.Key.SourceNamespace(),
+		sourceName:      f.Key.SourceName(),
+		destNamespace:   f.Key.DestNamespace(),
+		destName:        f.Key.DestName(),
+		proto:           f.Key.Proto(),
+		destPort:        f.Key.DestPort(),
+	}
+	if _, ok := d.connections[k]; ok {
+		d.connections[k] = t
+		return false
+	}
+	if len(d.connections) >= maxConnections {
+		// We can't remember any more connections, so don't report this one as new - otherwise we'd
+		// report it again every time it is seen.
+		return false
+	}
+	d.connections[k] = t
+	return true
+}
+
+// sweep periodically forgets source and destination pairs that have not been seen recently.
+func (d *Detector) sweep(t int64) {
+	if t-d.lastSweep < sweepInterval {
+		return
+	}
+	d.lastSweep = t
+	cutoff := t - int64(d.connectionRetention.Seconds())
+	for k, lastSeen := range d.connections {
+		if lastSeen < cutoff {
+			delete(d.connections, k)
+		}
+	}
+}
+
+// raise sends the given alert to all streams, and keeps it for streams that start later.
+func (d *Detector) raise(a *proto.Alert) {
+	logrus.WithFields(logrus.Fields{
+		"type":        a.Type,
+		"scope":       a.Scope,
+		"description": a.Description,
+	}).Debug("Raising alert")
+	alertsRaised.WithLabelValues(a.Type.String()).Inc()
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if len(d.recent) == recentAlertsSize {
+		d.recent = d.recent[1:]
+	}
+	d.recent = append(d.recent, a)
+	for s := range d.subscriptions {
+		s.send(a)
+	}
+}
+
+// Subscribe returns a Subscription to alerts matching the given request. Recently raised alerts are
+// queued first, if requested. The caller must Close the Subscription when done.
+func (d *Detector) Subscribe(req *proto.AlertStreamRequest) (*Subscription, error) {
+	s := &Subscription{
+		detector: d,
+		types:    map[proto.AlertType]bool{},
+	}
+	for _, t := range req.Types {
+		if _, ok := proto.AlertType_name[int32(t)]; !ok {
+			return nil, fmt.Errorf("unknown alert type %d", t)
+		}
+		s.types[t] = true
+	}
+
+	start := req.StartTimeGte
+	if start < 0 {
+		start = d.nowFunc().Unix() + start
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var backfill []*proto.Alert
+	if start != 0 {
+		// Recent alerts are in time order, so find the first at or after the start time.
+		i, _ := slices.BinarySearchFunc(d.recent, start, func(a *proto.Alert, t int64) int {
+			return int(a.Time - t)
+		})
+		for _, a := range d.recent[i:] {
+			if s.matches(a) {
+				backfill = append(backfill, a)
+			}
+		}
+	}
+	s.ch = make(chan *proto.Alert, subscriptionBuffer+len(backfill))
+	for _, a := range backfill {
+		s.ch <- a
+	}
+	d.subscriptions[s] = struct{}{}
+	return s, nil
+}
+
+// Subscription is a stream of alerts from a Detector.
+type Subscription struct {
+	detector *Detector
+	ch       chan *proto.Alert
+
+	// types restricts the alerts sent to the subscription. If empty, all alerts are sent.
+	types map[proto.AlertType]bool
+}
+
+// Alerts returns the channel on which alerts are sent.
+func (s *Subscription) Alerts() <-chan *proto.Alert {
+	return s.ch
+}
+
+// Close stops alerts being sent to the subscription.
+func (s *Subscription) Close() {
+	s.detector.lock.Lock()
+	defer s.detector.lock.Unlock()
+	delete(s.detector.subscriptions, s)
+}
+
+func (s *Subscription) matches(a *proto.Alert) bool {
+	return len(s.types) == 0 || s.types[a.Type]
+}
+
+// send queues the alert for the subscription, if it matches. Must be called with the detector's lock held.
+func (s *Subscription) send(a *proto.Alert) {
+	if !s.matches(a) {
+		return
+	}
+	select {
+	case s.ch <- a:
+	default:
+		logrus.WithField("type", a.Type).Warn("Alert stream is not keeping up, dropping alert")
+	}
+}
+
+// denyingPolicy returns the policy responsible for denying the Flow with the given key. For Flows denied by
+// a tier's default action, this is the policy that selected the Flow without allowing it.
+func denyingPolicy(k *types.FlowKey) (policyKey, bool) {
+	trace := types.FlowLogPolicyToProto(k.Policies())
+	for _, h := range slices.Backward(trace.EnforcedPolicies) {
+		if h.Action != proto.Action_Deny {
+			continue
+		}
+		if h.Kind == proto.PolicyKind_EndOfTier && h.Trigger != nil {
+			h = h.Trigger
+		}
+		return policyKey{kind: h.Kind, tier: h.Tier, namespace: h.Namespace, name: h.Name}, true
+	}
+	return policyKey{}, false
+}
+
+// name returns the name of the namespace or policy the series applies to, for use in metrics and descriptions.
+func (k seriesKey) name() string {
+	if k.scope == proto.AlertScope_AlertScopeNamespace {
+		return k.namespace
+	}
+	if k.policy.namespace == "" {
+		return fmt.Sprintf("%s/%s", k.policy.tier, k.policy.name)
+	}
+	return fmt.Sprintf("%s/%s/%s", k.policy.tier, k.policy.namespace, k.policy.name)
+}
+
+func denySpikeAlert(k seriesKey, rate, baseline float64, t int64) *proto.Alert {
+	a := &proto.Alert{
+		Type:     proto.AlertType_AlertTypeDenySpike,
+		Scope:    k.scope,
+		Time:     t,
+		Value:    rate,
+		Baseline: baseline,
+	}
+	if k.scope == proto.AlertScope_AlertScopeNamespace {
+		a.Namespace = k.namespace
+		a.Description = fmt.Sprintf("Denied packets in namespace %s rose to %.2f/s, above a baseline of %.2f/s",
+			k.namespace, rate, baseline)
+	} else {
+		a.Policy = &proto.PolicyHit{
+			Kind:      k.policy.kind,
+			Tier:      k.policy.tier,
+			Namespace: k.policy.namespace,
+			Name:      k.policy.name,
+			Action:    proto.Action_Deny,
+		}
+		a.Description = fmt.Sprintf("Packets denied by policy %s rose to %.2f/s, above a baseline of %.2f/s",
+			k.name(), rate, baseline)
+	}
+	return a
+}
+
+func newConnectionAlert(f *types.Flow, t int64) *proto.Alert {
+	key := types.FlowToProto(f).Key
+	return &proto.Alert{
+		Type:  proto.AlertType_AlertTypeNewConnection,
+		Scope: proto.AlertScope_AlertScopeEndpointPair,
+		Time:  t,
+		Flow:  key,
+		Description: fmt.Sprintf("First traffic seen from %s/%s to %s/%s on %s port %d (%s)",
+			key.SourceNamespace, key.SourceName, key.DestNamespace, key.DestName, key.Proto, key.DestPort, key.Action),
+	}
+}
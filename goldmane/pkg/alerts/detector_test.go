@@ -0,0 +1,299 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts_test
+
+import (
+	"testing"
+	"time"
+	"unique"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/alerts"
+	"github.com/projectcalico/calico/goldmane/pkg/internal/utils"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
+)
+
+const (
+	startTime      = int64(1700000000)
+	bucketDuration = int64(15)
+)
+
+func setupTest(t *testing.T) func() {
+	utils.ConfigureLogging("DEBUG")
+	return logutils.RedirectLogrusToTestingT(t)
+}
+
+// newFlow returns a Flow from a client to a server in the given namespaces. Denied Flows are reported by the
+// destination, and denied by the default action of the tier containing the "default/deny-clients" policy.
+func newFlow(srcNs, dstNs string, port int64, action proto.Action, packets int64) types.Flow {
+	reporter := proto.Reporter_Src
+	trace := &proto.PolicyTrace{}
+	if action == proto.Action_Deny {
+		reporter = proto.Reporter_Dst
+		trace.EnforcedPolicies = []*proto.PolicyHit{
+			{
+				Kind:        proto.PolicyKind_EndOfTier,
+				Tier:        "default",
+				Action:      proto.Action_Deny,
+				PolicyIndex: 0,
+				RuleIndex:   -1,
+				Trigger: &proto.PolicyHit{
+					Kind:      proto.PolicyKind_CalicoNetworkPolicy,
+					Tier:      "default",
+					Namespace: dstNs,
+					Name:      "deny-clients",
+				},
+			},
+		}
+	}
+	return types.Flow{
+		Key: types.NewFlowKey(
+			&types.FlowKeySource{
+				SourceName:      "client-*",
+				SourceNamespace: srcNs,
+				SourceType:      proto.EndpointType_WorkloadEndpoint,
+			},
+			&types.FlowKeyDestination{
+				DestName:      "server-*",
+				DestNamespace: dstNs,
+				DestType:      proto.EndpointType_WorkloadEndpoint,
+				DestPort:      port,
+			},
+			&types.FlowKeyMeta{
+				Proto:    "tcp",
+				Reporter: reporter,
+				Action:   action,
+			},
+			trace,
+		),
+		SourceLabels: unique.Make("app=client"),
+		DestLabels:   unique.Make("app=server"),
+		PacketsIn:    packets,
+	}
+}
+
+// observe sends the i'th bucket, containing the given Flows, to the detector.
+func observe(d *alerts.Detector, i int, flows ...types.Flow) {
+	c := bucketing.NewFlowCollection(startTime+int64(i)*bucketDuration, startTime+int64(i+1)*bucketDuration)
+	for _, f := range flows {
+		f.StartTime = c.StartTime
+		f.EndTime = c.EndTime
+		c.AddFlow(f)
+	}
+	d.ObserveBucket(c)
+}
+
+// drain returns all alerts currently queued for the subscription.
+func drain(s *alerts.Subscription) []*proto.Alert {
+	var received []*proto.Alert
+	for {
+		select {
+		case a := <-s.Alerts():
+			received = append(received, a)
+		default:
+			return received
+		}
+	}
+}
+
+func newDetector(opts ...alerts.Option) *alerts.Detector {
+	opts = append([]alerts.Option{
+		alerts.WithBaselineWindow(5 * time.Minute),
+		alerts.WithWarmup(10 * time.Minute),
+		alerts.WithNowFunc(func() time.Time { return time.Unix(startTime, 0) }),
+	}, opts...)
+	return alerts.NewDetector(opts...)
+}
+
+func TestDenySpike(t *testing.T) {
+	defer setupTest(t)()
+
+	d := newDetector()
+	sub, err := d.Subscribe(&proto.AlertStreamRequest{Types: []proto.AlertType{proto.AlertType_AlertTypeDenySpike}})
+	require.NoError(t, err)
+	defer sub.Close()
+
+	// Send a steady rate of denied traffic, of one packet per second, for an hour. This is the baseline,
+	// so should not raise any alerts.
+	i := 0
+	for ; i < 240; i++ {
+		observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, bucketDuration))
+	}
+	require.Empty(t, drain(sub))
+
+	// Increase the rate of denied traffic tenfold. This should raise an alert for both the namespace of the
+	// denying endpoint and the policy that denied it.
+	observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, 10*bucketDuration))
+	i++
+	received := drain(sub)
+	require.Len(t, received, 2)
+
+	var nsAlert, policyAlert *proto.Alert
+	for _, a := range received {
+		require.Equal(t, proto.AlertType_AlertTypeDenySpike, a.Type)
+		require.Equal(t, startTime+int64(i)*bucketDuration, a.Time)
+		require.InDelta(t, 10, a.Value, 0.001)
+		require.InDelta(t, 1, a.Baseline, 0.01)
+		switch a.Scope {
+		case proto.AlertScope_AlertScopeNamespace:
+			nsAlert = a
+		case proto.AlertScope_AlertScopePolicy:
+			policyAlert = a
+		}
+	}
+	require.NotNil(t, nsAlert)
+	require.Equal(t, "ns-server", nsAlert.Namespace)
+	require.NotNil(t, policyAlert)
+	require.Equal(t, proto.PolicyKind_CalicoNetworkPolicy, policyAlert.Policy.Kind)
+	require.Equal(t, "default", policyAlert.Policy.Tier)
+	require.Equal(t, "ns-server", policyAlert.Policy.Namespace)
+	require.Equal(t, "deny-clients", policyAlert.Policy.Name)
+
+	// The spike continuing should not raise further alerts.
+	observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, 10*bucketDuration))
+	i++
+	require.Empty(t, drain(sub))
+
+	// Return to normal for a while, then spike again. This is a new excursion, so should raise new alerts.
+	for j := 0; j < 20; j++ {
+		observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, bucketDuration))
+		i++
+	}
+	require.Empty(t, drain(sub))
+	observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, 20*bucketDuration))
+	require.Len(t, drain(sub), 2)
+}
+
+func TestDenySpikeWarmup(t *testing.T) {
+	defer setupTest(t)()
+
+	d := newDetector()
+	sub, err := d.Subscribe(&proto.AlertStreamRequest{Types: []proto.AlertType{proto.AlertType_AlertTypeDenySpike}})
+	require.NoError(t, err)
+	defer sub.Close()
+
+	// Denied traffic appearing during warmup should not raise an alert.
+	i := 0
+	for ; i < 10; i++ {
+		observe(d, i)
+	}
+	observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, 10*bucketDuration))
+	i++
+	require.Empty(t, drain(sub))
+
+	// After warmup, denied traffic for a namespace and policy not seen before should raise alerts, as long as
+	// it is above the minimum rate.
+	for ; i < 60; i++ {
+		observe(d, i)
+	}
+	observe(d, i, newFlow("ns-client", "ns-other", 80, proto.Action_Deny, bucketDuration/2))
+	i++
+	require.Empty(t, drain(sub))
+	observe(d, i, newFlow("ns-client", "ns-other", 80, proto.Action_Deny, 5*bucketDuration))
+	require.Len(t, drain(sub), 2)
+}
+
+func TestNewConnection(t *testing.T) {
+	defer setupTest(t)()
+
+	d := newDetector(alerts.WithConnectionRetention(30 * time.Minute))
+	sub, err := d.Subscribe(&proto.AlertStreamRequest{Types: []proto.AlertType{proto.AlertType_AlertTypeNewConnection}})
+	require.NoError(t, err)
+	defer sub.Close()
+
+	// Connections seen during warmup are learned, and don't raise alerts.
+	i := 0
+	for ; i < 40; i++ {
+		observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Allow, 1))
+	}
+	require.Empty(t, drain(sub))
+	observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Allow, 1))
+	i++
+	require.Empty(t, drain(sub))
+
+	// A connection to a new port should raise an alert, once.
+	observe(d, i, newFlow("ns-client", "ns-server", 443, proto.Action_Allow, 1))
+	i++
+	received := drain(sub)
+	require.Len(t, received, 1)
+	require.Equal(t, proto.AlertType_AlertTypeNewConnection, received[0].Type)
+	require.Equal(t, proto.AlertScope_AlertScopeEndpointPair, received[0].Scope)
+	require.Equal(t, "ns-client", received[0].Flow.SourceNamespace)
+	require.Equal(t, "ns-server", received[0].Flow.DestNamespace)
+	require.Equal(t, int64(443), received[0].Flow.DestPort)
+
+	observe(d, i, newFlow("ns-client", "ns-server", 443, proto.Action_Allow, 1))
+	i++
+	require.Empty(t, drain(sub))
+
+	// Once a connection hasn't been seen for longer than the retention period, it is forgotten, and
+	// raises an alert when seen again.
+	for j := 0; j < 200; j++ {
+		observe(d, i, newFlow("ns-client", "ns-server", 80, proto.Action_Allow, 1))
+		i++
+	}
+	require.Empty(t, drain(sub))
+	observe(d, i, newFlow("ns-client", "ns-server", 443, proto.Action_Allow, 1))
+	require.Len(t, drain(sub), 1)
+}
+
+func TestSubscribe(t *testing.T) {
+	defer setupTest(t)()
+
+	d := newDetector(alerts.WithWarmup(0))
+
+	// Raise a new connection alert, and then deny spike alerts for the same connection in a later bucket.
+	observe(d, 0, newFlow("ns-client", "ns-server", 80, proto.Action_Allow, 1))
+	observe(d, 10, newFlow("ns-client", "ns-server", 80, proto.Action_Deny, 10*bucketDuration))
+
+	// A stream without a start time should only receive new alerts.
+	sub, err := d.Subscribe(&proto.AlertStreamRequest{})
+	require.NoError(t, err)
+	require.Empty(t, drain(sub))
+	sub.Close()
+
+	// A stream with a start time should receive recent alerts at or after that time.
+	sub, err = d.Subscribe(&proto.AlertStreamRequest{StartTimeGte: startTime})
+	require.NoError(t, err)
+	require.Len(t, drain(sub), 3)
+	sub.Close()
+
+	sub, err = d.Subscribe(&proto.AlertStreamRequest{StartTimeGte: startTime + 2*bucketDuration})
+	require.NoError(t, err)
+	require.Len(t, drain(sub), 2)
+	sub.Close()
+
+	// Negative start times are relative to now.
+	sub, err = d.Subscribe(&proto.AlertStreamRequest{
+		StartTimeGte: -1,
+		Types:        []proto.AlertType{proto.AlertType_AlertTypeNewConnection},
+	})
+	require.NoError(t, err)
+	received := drain(sub)
+	require.Len(t, received, 1)
+	require.Equal(t, proto.AlertType_AlertTypeNewConnection, received[0].Type)
+
+	// Alerts are no longer received once the subscription is closed.
+	sub.Close()
+	observe(d, 11, newFlow("ns-client", "ns-server", 8080, proto.Action_Allow, 1))
+	require.Empty(t, drain(sub))
+
+	_, err = d.Subscribe(&proto.AlertStreamRequest{Types: []proto.AlertType{proto.AlertType(100)}})
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import (
+	"time"
+)
+
+type Option func(*Detector)
+
+// WithSensitivity sets the number of standard deviations above its baseline that a deny rate must reach
+// before an alert is raised.
+func WithSensitivity(s float64) Option {
+	return func(d *Detector) {
+		d.sensitivity = s
+	}
+}
+
+// WithMinDenyRate sets the minimum rate of denied packets per second that can trigger an alert, regardless
+// of baseline. This prevents alerts for small changes in otherwise quiet namespaces and policies.
+func WithMinDenyRate(r float64) Option {
+	return func(d *Detector) {
+		d.minDenyRate = r
+	}
+}
+
+// WithBaselineWindow sets the approximate window of history over which baselines are calculated.
+func WithBaselineWindow(w time.Duration) Option {
+	return func(d *Detector) {
+		d.baselineWindow = w
+	}
+}
+
+// WithWarmup sets how long the detector observes traffic after starting before raising alerts, allowing
+// it to learn baselines and existing connections.
+func WithWarmup(w time.Duration) Option {
+	return func(d *Detector) {
+		d.warmup = w
+	}
+}
+
+// WithConnectionRetention sets how long a source and destination pair is remembered after it was last seen.
+// Traffic between a pair that has not been seen for longer than this raises a new connection alert.
+func WithConnectionRetention(r time.Duration) Option {
+	return func(d *Detector) {
+		d.connectionRetention = r
+	}
+}
+
+// WithNowFunc allows overriding the current time, used in tests.
+func WithNowFunc(f func() time.Time) Option {
+	return func(d *Detector) {
+		d.nowFunc = f
+	}
+}
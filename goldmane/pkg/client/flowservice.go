@@ -33,6 +33,8 @@ type FlowsClient interface {
 	List(context.Context, *proto.FlowListRequest) (*proto.ListMetadata, []*proto.FlowResult, error)
 	Stream(ctx context.Context, request *proto.FlowStreamRequest) (proto.Flows_StreamClient, error)
 	FilterHints(ctx context.Context, req *proto.FilterHintsRequest) (*proto.ListMetadata, []*proto.FilterHint, error)
+	Graph(ctx context.Context, req *proto.GraphRequest) (*proto.GraphResult, error)
+	PolicyRecommendations(ctx context.Context, req *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error)
 }
 
 func NewFlowsAPIClient(host string, opts ...grpc.DialOption) (FlowsClient, error) {
@@ -72,3 +74,24 @@ func (cli *flowServiceClient) FilterHints(ctx context.Context, req *proto.Filter
 
 	return result.Meta, result.Hints, nil
 }
+
+// Graph retrieves a graph of the communication between endpoints from Goldmane, built from the flows that match
+// the given request.
+func (cli *flowServiceClient) Graph(ctx context.Context, req *proto.GraphRequest) (*proto.GraphResult, error) {
+	result, err := cli.cli.Graph(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flow graph: %w", err)
+	}
+
+	return result, nil
+}
+
+// PolicyRecommendations retrieves staged policies recommended by Goldmane based on the flows it has observed.
+func (cli *flowServiceClient) PolicyRecommendations(ctx context.Context, req *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error) {
+	result, err := cli.cli.PolicyRecommendations(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy recommendations: %w", err)
+	}
+
+	return result, nil
+}
@@ -91,6 +91,65 @@ func (_c *FlowsClient_FilterHints_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// Graph provides a mock function with given fields: ctx, req
+func (_m *FlowsClient) Graph(ctx context.Context, req *proto.GraphRequest) (*proto.GraphResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Graph")
+	}
+
+	var r0 *proto.GraphResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GraphRequest) (*proto.GraphResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.GraphRequest) *proto.GraphResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GraphResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.GraphRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlowsClient_Graph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Graph'
+type FlowsClient_Graph_Call struct {
+	*mock.Call
+}
+
+// Graph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *proto.GraphRequest
+func (_e *FlowsClient_Expecter) Graph(ctx interface{}, req interface{}) *FlowsClient_Graph_Call {
+	return &FlowsClient_Graph_Call{Call: _e.mock.On("Graph", ctx, req)}
+}
+
+func (_c *FlowsClient_Graph_Call) Run(run func(ctx context.Context, req *proto.GraphRequest)) *FlowsClient_Graph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.GraphRequest))
+	})
+	return _c
+}
+
+func (_c *FlowsClient_Graph_Call) Return(_a0 *proto.GraphResult, _a1 error) *FlowsClient_Graph_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FlowsClient_Graph_Call) RunAndReturn(run func(context.Context, *proto.GraphRequest) (*proto.GraphResult, error)) *FlowsClient_Graph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *FlowsClient) List(_a0 context.Context, _a1 *proto.FlowListRequest) (*proto.ListMetadata, []*proto.FlowResult, error) {
 	ret := _m.Called(_a0, _a1)
@@ -159,6 +218,65 @@ func (_c *FlowsClient_List_Call) RunAndReturn(run func(context.Context, *proto.F
 	return _c
 }
 
+// PolicyRecommendations provides a mock function with given fields: ctx, req
+func (_m *FlowsClient) PolicyRecommendations(ctx context.Context, req *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyRecommendations")
+	}
+
+	var r0 *proto.PolicyRecommendationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.PolicyRecommendationRequest) *proto.PolicyRecommendationResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.PolicyRecommendationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.PolicyRecommendationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlowsClient_PolicyRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyRecommendations'
+type FlowsClient_PolicyRecommendations_Call struct {
+	*mock.Call
+}
+
+// PolicyRecommendations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *proto.PolicyRecommendationRequest
+func (_e *FlowsClient_Expecter) PolicyRecommendations(ctx interface{}, req interface{}) *FlowsClient_PolicyRecommendations_Call {
+	return &FlowsClient_PolicyRecommendations_Call{Call: _e.mock.On("PolicyRecommendations", ctx, req)}
+}
+
+func (_c *FlowsClient_PolicyRecommendations_Call) Run(run func(ctx context.Context, req *proto.PolicyRecommendationRequest)) *FlowsClient_PolicyRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proto.PolicyRecommendationRequest))
+	})
+	return _c
+}
+
+func (_c *FlowsClient_PolicyRecommendations_Call) Return(_a0 *proto.PolicyRecommendationResult, _a1 error) *FlowsClient_PolicyRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FlowsClient_PolicyRecommendations_Call) RunAndReturn(run func(context.Context, *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error)) *FlowsClient_PolicyRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stream provides a mock function with given fields: ctx, request
 func (_m *FlowsClient) Stream(ctx context.Context, request *proto.FlowStreamRequest) (proto.Flows_StreamClient, error) {
 	ret := _m.Called(ctx, request)
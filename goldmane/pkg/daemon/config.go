@@ -22,7 +22,6 @@ import (
 
 	"github.com/sirupsen/logrus"
 
-	"github.com/projectcalico/calico/goldmane/pkg/aggregator"
 	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
 	"github.com/projectcalico/calico/goldmane/pkg/internal/utils"
 )
@@ -32,17 +31,18 @@ type goldmaneFileConfig struct {
 }
 
 type sinkManager struct {
-	aggregator *aggregator.LogAggregator
-	sink       bucketing.Sink
-	upd        chan struct{}
-	watchFn    func(context.Context)
-	path       string
+	// setFn is called to attach the sink when it is enabled, and with nil when it is disabled.
+	setFn   func(bucketing.Sink)
+	sink    bucketing.Sink
+	upd     chan struct{}
+	watchFn func(context.Context)
+	path    string
 
 	// cur is the current state of the sink.
 	cur bool
 }
 
-func newSinkManager(agg *aggregator.LogAggregator, sink bucketing.Sink, path string) (*sinkManager, error) {
+func newSinkManager(setFn func(bucketing.Sink), sink bucketing.Sink, path string) (*sinkManager, error) {
 	onUpdate := make(chan struct{}, 1)
 
 	// Watch for changes to the input file.
@@ -54,16 +54,16 @@ func newSinkManager(agg *aggregator.LogAggregator, sink bucketing.Sink, path str
 	if sink == nil {
 		return nil, fmt.Errorf("a sink must be provided")
 	}
-	if agg == nil {
-		return nil, fmt.Errorf("an aggregator must be provided")
+	if setFn == nil {
+		return nil, fmt.Errorf("a set function must be provided")
 	}
 
 	e := sinkManager{
-		upd:        onUpdate,
-		watchFn:    watchFn,
-		aggregator: agg,
-		sink:       sink,
-		path:       path,
+		upd:     onUpdate,
+		watchFn: watchFn,
+		setFn:   setFn,
+		sink:    sink,
+		path:    path,
 	}
 	return &e, nil
 }
@@ -76,7 +76,8 @@ func (f *sinkManager) run(ctx context.Context) {
 	// Start of day - check if we should enable the sink.
 	if sinkEnabled(f.path) {
 		logrus.Debug("Sink enabled at startup")
-		f.aggregator.SetSink(f.sink)
+		f.setFn(f.sink)
+		f.cur = true
 	}
 	logrus.Info("Sink manager started")
 
@@ -100,9 +101,9 @@ func (f *sinkManager) set(enabled bool) {
 	}
 	logrus.WithField("enabled", enabled).Info("Sink enablement changed")
 	if enabled {
-		f.aggregator.SetSink(f.sink)
+		f.setFn(f.sink)
 	} else {
-		f.aggregator.SetSink(nil)
+		f.setFn(nil)
 	}
 	f.cur = enabled
 }
@@ -34,13 +34,24 @@ import (
 	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
 	"github.com/projectcalico/calico/goldmane/pkg/aggregator"
 	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/alerts"
 	"github.com/projectcalico/calico/goldmane/pkg/emitter"
 	"github.com/projectcalico/calico/goldmane/pkg/internal/utils"
+	"github.com/projectcalico/calico/goldmane/pkg/ipfix"
+	"github.com/projectcalico/calico/goldmane/pkg/persistence"
 	"github.com/projectcalico/calico/goldmane/pkg/server"
+	"github.com/projectcalico/calico/goldmane/pkg/sinks"
 	"github.com/projectcalico/calico/libcalico-go/lib/debugserver"
 	"github.com/projectcalico/calico/libcalico-go/lib/health"
 )
 
+// Names of the sinks configured directly through the daemon Config. Sinks in the sinks configuration
+// file may not use these names.
+const (
+	emitterSinkName = "emitter"
+	ipfixSinkName   = "ipfix"
+)
+
 type Config struct {
 	// LogLevel is the log level to use.
 	LogLevel string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
@@ -49,6 +60,28 @@ type Config struct {
 	// periodically in a bulk format.
 	PushURL string `json:"push_url" envconfig:"PUSH_URL"`
 
+	// EmitterStateNamespace and EmitterStateName identify the ConfigMap used to store emitter progress
+	// across restarts.
+	EmitterStateNamespace string `json:"emitter_state_namespace" envconfig:"EMITTER_STATE_NAMESPACE" default:"calico-system"`
+	EmitterStateName      string `json:"emitter_state_name" envconfig:"EMITTER_STATE_NAME" default:"flow-emitter-state"`
+
+	// SinksConfigPath is the path to a JSON file defining additional sinks to export flows to, such as local
+	// files, OpenTelemetry collectors, or syslog servers. Each sink may have its own filter and rate limit.
+	SinksConfigPath string `json:"sinks_config_path" envconfig:"SINKS_CONFIG_PATH"`
+
+	// IPFIXCollectorAddress is the address of an IPFIX collector to export flows to, if set.
+	IPFIXCollectorAddress string `json:"ipfix_collector_address" envconfig:"IPFIX_COLLECTOR_ADDRESS"`
+
+	// IPFIXTransport is the transport used to reach the IPFIX collector, either "udp" or "tcp".
+	IPFIXTransport string `json:"ipfix_transport" envconfig:"IPFIX_TRANSPORT" default:"udp"`
+
+	// IPFIXObservationDomainID is the observation domain ID included in exported IPFIX messages.
+	IPFIXObservationDomainID uint32 `json:"ipfix_observation_domain_id" envconfig:"IPFIX_OBSERVATION_DOMAIN_ID"`
+
+	// IPFIXEnterpriseNumber is the private enterprise number used for the information elements that carry
+	// Kubernetes metadata in exported IPFIX records.
+	IPFIXEnterpriseNumber uint32 `json:"ipfix_enterprise_number" envconfig:"IPFIX_ENTERPRISE_NUMBER" default:"32473"`
+
 	// FileConfigPath is the path to the goldmane configuration file, used for a subset of goldmane
 	// configuration that does not require a process restart.
 	// If set, Goldmane will watch this file for changes and reload its configuration when it changes.
@@ -93,6 +126,35 @@ type Config struct {
 
 	// PrometheusPort is the port to listen on for serving Prometheus metrics.
 	PrometheusPort int `json:"prometheus_port" envconfig:"PROMETHEUS_PORT" default:"9090"`
+
+	// FlowHistoryDir is the directory in which to persist completed aggregation buckets, allowing flow history
+	// to survive restarts. If empty, flow history is only kept in memory.
+	FlowHistoryDir string `json:"flow_history_dir" envconfig:"FLOW_HISTORY_DIR"`
+
+	// FlowHistoryRetention is how long persisted aggregation buckets are kept on disk. Only as much history as is
+	// held in memory is restored on start, but older buckets are retained on disk for offline analysis.
+	FlowHistoryRetention time.Duration `json:"flow_history_retention" envconfig:"FLOW_HISTORY_RETENTION" default:"24h"`
+
+	// AlertsEnabled enables detection of spikes in denied traffic and of traffic between previously unseen
+	// sources and destinations. Alerts are available from the Alerts gRPC API and as Prometheus metrics.
+	// Disabled by default.
+	AlertsEnabled bool `json:"alerts_enabled" envconfig:"ALERTS_ENABLED"`
+
+	// AlertSensitivity is the number of standard deviations above its baseline that the rate of denied traffic
+	// for a namespace or policy must reach to raise an alert.
+	AlertSensitivity float64 `json:"alert_sensitivity" envconfig:"ALERT_SENSITIVITY" default:"3"`
+
+	// AlertMinDenyRate is the minimum rate of denied packets per second that can raise an alert.
+	AlertMinDenyRate float64 `json:"alert_min_deny_rate" envconfig:"ALERT_MIN_DENY_RATE" default:"1"`
+
+	// AlertBaselineWindow is the approximate window of history used to calculate baseline deny rates.
+	AlertBaselineWindow time.Duration `json:"alert_baseline_window" envconfig:"ALERT_BASELINE_WINDOW" default:"1h"`
+
+	// AlertWarmup is how long to learn baselines and existing connections after starting before raising alerts.
+	AlertWarmup time.Duration `json:"alert_warmup" envconfig:"ALERT_WARMUP" default:"15m"`
+
+	// AlertConnectionRetention is how long a source and destination pair is remembered after it was last seen.
+	AlertConnectionRetention time.Duration `json:"alert_connection_retention" envconfig:"ALERT_CONNECTION_RETENTION" default:"24h"`
 }
 
 func ConfigFromEnv() Config {
@@ -156,8 +218,82 @@ func Run(ctx context.Context, cfg Config) {
 		aggregator.WithPushIndex(cfg.EmitAfterSeconds / int(cfg.AggregationWindow.Seconds())),
 		aggregator.WithHealthAggregator(healthAggregator),
 	}
+
+	if cfg.FlowHistoryDir != "" {
+		// Persist flow history to disk so that it survives restarts.
+		store, err := persistence.NewFileStore(
+			persistence.WithDirectory(cfg.FlowHistoryDir),
+			persistence.WithRetention(cfg.FlowHistoryRetention),
+		)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to create flow history store")
+		}
+		go store.Run(ctx)
+		aggOpts = append(aggOpts, aggregator.WithFlowStore(store))
+	}
+
+	var detector *alerts.Detector
+	if cfg.AlertsEnabled {
+		// Watch completed buckets for unusual activity.
+		detector = alerts.NewDetector(
+			alerts.WithSensitivity(cfg.AlertSensitivity),
+			alerts.WithMinDenyRate(cfg.AlertMinDenyRate),
+			alerts.WithBaselineWindow(cfg.AlertBaselineWindow),
+			alerts.WithWarmup(cfg.AlertWarmup),
+			alerts.WithConnectionRetention(cfg.AlertConnectionRetention),
+		)
+		aggOpts = append(aggOpts, aggregator.WithBucketObserver(detector))
+	}
 	agg := aggregator.NewLogAggregator(aggOpts...)
 
+	// All sinks receive flows from the aggregator via a single fanout, which is only attached to the aggregator
+	// while at least one sink is enabled. This ensures that flows are not marked as emitted while no sinks are
+	// enabled.
+	fanout := sinks.NewFanout()
+	setSink := func(name string, s bucketing.Sink) {
+		fanout.Set(name, s)
+		if fanout.Len() == 0 {
+			agg.SetSink(nil)
+		} else {
+			agg.SetSink(fanout)
+		}
+	}
+
+	if cfg.SinksConfigPath != "" {
+		// Create the additional sinks defined in the sinks configuration file.
+		sinksCfg, err := sinks.LoadConfig(cfg.SinksConfigPath)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to load sinks configuration")
+		}
+		for _, sc := range sinksCfg.Sinks {
+			if sc.Name == emitterSinkName || sc.Name == ipfixSinkName {
+				logrus.WithField("name", sc.Name).Fatal("Sink name is reserved")
+			}
+			s, err := sinks.New(sc)
+			if err != nil {
+				logrus.WithError(err).Fatal("Failed to create sink")
+			}
+			go s.Run(ctx)
+			setSink(s.Name(), s)
+		}
+	}
+
+	if cfg.IPFIXCollectorAddress != "" {
+		// Export flows to an IPFIX collector.
+		exporter, err := ipfix.NewExporter(ipfix.Options{
+			Network:             cfg.IPFIXTransport,
+			Address:             cfg.IPFIXCollectorAddress,
+			ObservationDomainID: cfg.IPFIXObservationDomainID,
+			EnterpriseNumber:    cfg.IPFIXEnterpriseNumber,
+		})
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to create IPFIX exporter")
+		}
+		s := sinks.NewSink(ipfixSinkName, exporter)
+		go s.Run(ctx)
+		setSink(ipfixSinkName, s)
+	}
+
 	if cfg.PushURL != "" {
 		// Create an emitter, which forwards flows to an upstream HTTP endpoint.
 		logEmitter := emitter.NewEmitter(
@@ -168,21 +304,23 @@ func Run(ctx context.Context, cfg Config) {
 			emitter.WithClientCertPath(cfg.ClientCertPath),
 			emitter.WithServerName(cfg.ServerName),
 			emitter.WithHealthAggregator(healthAggregator),
+			emitter.WithStateConfigMap(cfg.EmitterStateNamespace, cfg.EmitterStateName),
 		)
 		go logEmitter.Run(ctx)
+		setEmitter := func(s bucketing.Sink) { setSink(emitterSinkName, s) }
 
 		if cfg.FileConfigPath != "" {
 			// Start a goroutine to manage sink enablement. This will monitor a file on disk to determine if
 			// the sink should be enabled or disabled, and update the aggregator configuration accordingly. This
 			// allows the sink to be enabled or disabled without a process restart.
-			mgr, err := newSinkManager(agg, logEmitter, cfg.FileConfigPath)
+			mgr, err := newSinkManager(setEmitter, logEmitter, cfg.FileConfigPath)
 			if err != nil {
 				logrus.WithError(err).Fatal("Failed to create sink manager")
 			}
 			go mgr.run(ctx)
 		} else {
 			// Just set the sink directly.
-			agg.SetSink(logEmitter)
+			setEmitter(logEmitter)
 		}
 	}
 
@@ -202,6 +340,12 @@ func Run(ctx context.Context, cfg Config) {
 	statsServer := server.NewStatisticsServer(agg)
 	statsServer.RegisterWith(grpcServer)
 
+	if detector != nil {
+		// Start an alerts server, serving from the detector.
+		alertsServer := server.NewAlertsServer(detector)
+		alertsServer.RegisterWith(grpcServer)
+	}
+
 	// Start the gRPC server.
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
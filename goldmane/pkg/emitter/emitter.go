@@ -36,9 +36,9 @@ import (
 )
 
 var (
-	maxRetries   = 15
-	configMapKey = apitypes.NamespacedName{Name: "flow-emitter-state", Namespace: "calico-system"}
-	healthName   = "emitter"
+	maxRetries          = 15
+	defaultConfigMapKey = apitypes.NamespacedName{Name: "flow-emitter-state", Namespace: "calico-system"}
+	healthName          = "emitter"
 )
 
 // Emitter is a type that emits aggregated Flow objects to an HTTP endpoint.
@@ -47,6 +47,9 @@ type Emitter struct {
 
 	kcli client.Client
 
+	// configMap is the ConfigMap in which emitter progress is stored across restarts.
+	configMap apitypes.NamespacedName
+
 	// Configuration for emitter endpoint.
 	url        string
 	caCert     string
@@ -71,7 +74,8 @@ var _ bucketing.Sink = &Emitter{}
 
 func NewEmitter(opts ...Option) *Emitter {
 	e := &Emitter{
-		buckets: newBucketCache(),
+		configMap: defaultConfigMapKey,
+		buckets:   newBucketCache(),
 		q: workqueue.NewTypedRateLimitingQueue(
 			workqueue.NewTypedMaxOfRateLimiter(
 				workqueue.NewTypedItemExponentialFailureRateLimiter[bucketKey](1*time.Second, 30*time.Second),
@@ -258,19 +262,19 @@ func (e *Emitter) saveState() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 	cm := &corev1.ConfigMap{}
-	if err := e.kcli.Get(ctx, configMapKey, cm); err != nil && !errors.IsNotFound(err) {
+	if err := e.kcli.Get(ctx, e.configMap, cm); err != nil && !errors.IsNotFound(err) {
 		return fmt.Errorf("error getting configmap: %v", err)
 	} else if errors.IsNotFound(err) {
 		// Configmap doesn't exist, create it.
-		cm.Name = configMapKey.Name
-		cm.Namespace = configMapKey.Namespace
+		cm.Name = e.configMap.Name
+		cm.Namespace = e.configMap.Namespace
 		cm.Data = map[string]string{}
 	}
 
 	// Update the timestamp in the configmap.
 	cm.Data["latestTimestamp"] = fmt.Sprintf("%d", e.latestTimestamp)
 	logCtx := logrus.WithFields(logrus.Fields{
-		"cm":              configMapKey,
+		"cm":              e.configMap,
 		"latestTimestamp": cm.Data["latestTimestamp"],
 	})
 
@@ -300,10 +304,10 @@ func (e *Emitter) loadCachedState() error {
 	defer cancel()
 
 	cm := &corev1.ConfigMap{}
-	if err := e.kcli.Get(ctx, configMapKey, cm); err != nil && !errors.IsNotFound(err) {
+	if err := e.kcli.Get(ctx, e.configMap, cm); err != nil && !errors.IsNotFound(err) {
 		return fmt.Errorf("error getting configmap: %v", err)
 	} else if errors.IsNotFound(err) {
-		logrus.WithField("cm", configMapKey).Debug("Configmap not found")
+		logrus.WithField("cm", e.configMap).Debug("Configmap not found")
 		return nil
 	}
 
@@ -426,3 +426,49 @@ func TestStaleBuckets(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "70", cm.Data["latestTimestamp"])
 }
+
+func TestEmitterStateConfigMap(t *testing.T) {
+	flow := types.Flow{
+		Key: types.NewFlowKey(
+			&types.FlowKeySource{SourceName: "test-src", SourceNamespace: "test-ns", SourceType: proto.EndpointType_WorkloadEndpoint},
+			&types.FlowKeyDestination{DestName: "test-dst", DestNamespace: "test-dst-ns", DestType: proto.EndpointType_WorkloadEndpoint},
+			&types.FlowKeyMeta{Proto: "tcp", Action: proto.Action_Allow},
+			&proto.PolicyTrace{},
+		),
+		StartTime:    18,
+		EndTime:      28,
+		SourceLabels: unique.Make("src=label"),
+		DestLabels:   unique.Make("dst=label"),
+	}
+
+	numBucketsEmitted := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		numBucketsEmitted++
+	}))
+	defer server.Close()
+
+	kcli := fake.NewFakeClient()
+	opts := []emitter.Option{
+		emitter.WithURL(server.URL),
+		emitter.WithServerName("test-server"),
+		emitter.WithKubeClient(kcli),
+		emitter.WithStateConfigMap("test-ns", "test-emitter-state"),
+	}
+	defer setupTest(t, opts...)()
+
+	b := bucketing.NewFlowCollection(15, 30)
+	b.AddFlow(flow)
+	emt.Receive(b)
+	require.Eventually(t, func() bool {
+		return numBucketsEmitted == 1
+	}, 5*time.Second, 500*time.Millisecond)
+
+	// State should be saved in the configured ConfigMap, rather than the default.
+	cm := &corev1.ConfigMap{}
+	require.Eventually(t, func() bool {
+		return kcli.Get(context.Background(), ktypes.NamespacedName{Name: "test-emitter-state", Namespace: "test-ns"}, cm) == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	require.Equal(t, "30", cm.Data["latestTimestamp"])
+	require.Error(t, kcli.Get(context.Background(), configMapKey, &corev1.ConfigMap{}))
+}
@@ -15,6 +15,7 @@
 package emitter
 
 import (
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/health"
@@ -52,6 +53,14 @@ func WithKubeClient(kcli client.Client) Option {
 	}
 }
 
+// WithStateConfigMap sets the ConfigMap used to store emitter progress across restarts. This allows
+// multiple emitters to run without overwriting each other's progress.
+func WithStateConfigMap(namespace, name string) Option {
+	return func(e *Emitter) {
+		e.configMap = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+}
+
 func WithServerName(name string) Option {
 	return func(e *Emitter) {
 		e.serverName = name
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ipfix exports aggregated Flows as IPFIX (RFC 7011) messages. Standard information elements are
+// used where they exist, with RFC 5103 reverse elements for traffic in the reverse direction, and
+// enterprise-specific information elements for the Kubernetes metadata.
+package ipfix
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	// DefaultEnterpriseNumber is the private enterprise number used for Calico's information elements if
+	// none is configured. It is the number IANA reserves for documentation (RFC 5612), so collectors should
+	// be configured with the number chosen by the operator.
+	DefaultEnterpriseNumber = 32473
+
+	// reverseEnterpriseNumber is the private enterprise number used for reverse information elements,
+	// as defined in RFC 5103.
+	reverseEnterpriseNumber = 29305
+
+	// variableLength is the field length used in templates for variable length fields.
+	variableLength = 0xffff
+
+	// enterpriseBit is set in the information element ID of enterprise-specific elements.
+	enterpriseBit = 0x8000
+
+	// maxStringLength is the maximum length of a string field. Longer strings are truncated, so that a
+	// single record cannot exceed the maximum message size.
+	maxStringLength = 4096
+)
+
+// IANA assigned information element IDs.
+const (
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieDestinationTransportPort = 11
+	ieFlowStartSeconds         = 150
+	ieFlowEndSeconds           = 151
+)
+
+// Enterprise-specific information element IDs for Calico metadata.
+const (
+	ieSourceNamespace = iota + 1
+	ieSourceName
+	ieSourceType
+	ieDestinationNamespace
+	ieDestinationName
+	ieDestinationType
+	ieDestinationServiceNamespace
+	ieDestinationServiceName
+	ieDestinationServicePortName
+	ieDestinationServicePort
+	ieReporter
+	ieAction
+	ieEnforcedPolicies
+	iePendingPolicies
+	ieSourceLabels
+	ieDestinationLabels
+	ieConnectionsStarted
+	ieConnectionsCompleted
+	ieConnectionsLive
+)
+
+// field describes a single field in the data records, along with how to encode it from a Flow.
+type field struct {
+	id         uint16
+	enterprise uint32
+	length     uint16
+	encode     func(b []byte, f *proto.Flow) []byte
+}
+
+// fields returns the fields included in each data record, in template order.
+func fields(enterprise uint32) []field {
+	std := func(id, length uint16, enc func([]byte, *proto.Flow) []byte) field {
+		return field{id: id, length: length, encode: enc}
+	}
+	rev := func(id, length uint16, enc func([]byte, *proto.Flow) []byte) field {
+		return field{id: id, enterprise: reverseEnterpriseNumber, length: length, encode: enc}
+	}
+	ent := func(id, length uint16, enc func([]byte, *proto.Flow) []byte) field {
+		return field{id: id, enterprise: enterprise, length: length, encode: enc}
+	}
+	str := func(id uint16, val func(*proto.Flow) string) field {
+		return ent(id, variableLength, func(b []byte, f *proto.Flow) []byte { return appendString(b, val(f)) })
+	}
+
+	return []field{
+		std(ieFlowStartSeconds, 4, func(b []byte, f *proto.Flow) []byte { return binary.BigEndian.AppendUint32(b, uint32(f.StartTime)) }),
+		std(ieFlowEndSeconds, 4, func(b []byte, f *proto.Flow) []byte { return binary.BigEndian.AppendUint32(b, uint32(f.EndTime)) }),
+		std(ieProtocolIdentifier, 1, func(b []byte, f *proto.Flow) []byte { return append(b, protocolNumber(f.Key.Proto)) }),
+		std(ieDestinationTransportPort, 2, func(b []byte, f *proto.Flow) []byte { return binary.BigEndian.AppendUint16(b, uint16(f.Key.DestPort)) }),
+
+		// Flows count traffic relative to the reporting endpoint, whereas IPFIX counts traffic relative to the
+		// initiator of the connection.
+		std(ieOctetDeltaCount, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, forward(f, f.BytesIn, f.BytesOut)) }),
+		std(iePacketDeltaCount, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, forward(f, f.PacketsIn, f.PacketsOut)) }),
+		rev(ieOctetDeltaCount, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, forward(f, f.BytesOut, f.BytesIn)) }),
+		rev(iePacketDeltaCount, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, forward(f, f.PacketsOut, f.PacketsIn)) }),
+
+		str(ieSourceNamespace, func(f *proto.Flow) string { return f.Key.SourceNamespace }),
+		str(ieSourceName, func(f *proto.Flow) string { return f.Key.SourceName }),
+		str(ieSourceType, func(f *proto.Flow) string { return f.Key.SourceType.String() }),
+		str(ieDestinationNamespace, func(f *proto.Flow) string { return f.Key.DestNamespace }),
+		str(ieDestinationName, func(f *proto.Flow) string { return f.Key.DestName }),
+		str(ieDestinationType, func(f *proto.Flow) string { return f.Key.DestType.String() }),
+		str(ieDestinationServiceNamespace, func(f *proto.Flow) string { return f.Key.DestServiceNamespace }),
+		str(ieDestinationServiceName, func(f *proto.Flow) string { return f.Key.DestServiceName }),
+		str(ieDestinationServicePortName, func(f *proto.Flow) string { return f.Key.DestServicePortName }),
+		ent(ieDestinationServicePort, 2, func(b []byte, f *proto.Flow) []byte {
+			return binary.BigEndian.AppendUint16(b, uint16(f.Key.DestServicePort))
+		}),
+		str(ieReporter, func(f *proto.Flow) string { return f.Key.Reporter.String() }),
+		str(ieAction, func(f *proto.Flow) string { return f.Key.Action.String() }),
+		str(ieEnforcedPolicies, func(f *proto.Flow) string { return policyTrace(f.Key.Policies.GetEnforcedPolicies()) }),
+		str(iePendingPolicies, func(f *proto.Flow) string { return policyTrace(f.Key.Policies.GetPendingPolicies()) }),
+		str(ieSourceLabels, func(f *proto.Flow) string { return strings.Join(f.SourceLabels, ",") }),
+		str(ieDestinationLabels, func(f *proto.Flow) string { return strings.Join(f.DestLabels, ",") }),
+		ent(ieConnectionsStarted, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, f.NumConnectionsStarted) }),
+		ent(ieConnectionsCompleted, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, f.NumConnectionsCompleted) }),
+		ent(ieConnectionsLive, 8, func(b []byte, f *proto.Flow) []byte { return appendUint64(b, f.NumConnectionsLive) }),
+	}
+}
+
+// forward returns the statistic for traffic from the initiator of the connection, given the inbound and
+// outbound statistics from the point of view of the reporter.
+func forward(f *proto.Flow, in, out int64) int64 {
+	if f.Key.Reporter == proto.Reporter_Dst {
+		return in
+	}
+	return out
+}
+
+// policyTrace encodes a list of policy hits in the form used by Calico flow logs, with hits separated by
+// semicolons: "<index>|<tier>|<kind>:<namespace>/<name>|<action>|<rule index>".
+func policyTrace(hits []*proto.PolicyHit) string {
+	parts := make([]string, 0, len(hits))
+	for _, h := range hits {
+		parts = append(parts, strings.Join([]string{
+			strconv.FormatInt(h.PolicyIndex, 10),
+			h.Tier,
+			h.Kind.String() + ":" + h.Namespace + "/" + h.Name,
+			h.Action.String(),
+			strconv.FormatInt(h.RuleIndex, 10),
+		}, "|"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// protocolNumber returns the IANA protocol number for the given protocol name.
+func protocolNumber(p string) uint8 {
+	switch strings.ToLower(p) {
+	case "icmp":
+		return 1
+	case "tcp":
+		return 6
+	case "udp":
+		return 17
+	case "icmpv6":
+		return 58
+	case "sctp":
+		return 132
+	}
+	if n, err := strconv.ParseUint(p, 10, 8); err == nil {
+		return uint8(n)
+	}
+	return 0
+}
+
+func appendUint64(b []byte, v int64) []byte {
+	return binary.BigEndian.AppendUint64(b, uint64(max(v, 0)))
+}
+
+// appendString appends a variable length string, as described in RFC 7011 section 7.
+func appendString(b []byte, s string) []byte {
+	if len(s) > maxStringLength {
+		s = s[:maxStringLength]
+	}
+	if len(s) < 255 {
+		b = append(b, uint8(len(s)))
+	} else {
+		b = append(b, 255)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	}
+	return append(b, s...)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	version = 10
+
+	messageHeaderLength = 16
+
+	templateSetID = 2
+	templateID    = 256
+)
+
+// Encoder encodes Flows as IPFIX messages. It tracks the sequence number for a single transport session,
+// and so is not safe for concurrent use.
+type Encoder struct {
+	domainID       uint32
+	maxMessageSize int
+	fields         []field
+
+	// templateSet is the encoded template set describing the data records.
+	templateSet []byte
+
+	// seq is the number of data records sent in the current transport session.
+	seq uint32
+}
+
+// NewEncoder returns an Encoder for the given observation domain, using the given private enterprise number
+// for Calico's information elements. Messages are limited to maxMessageSize bytes where possible.
+func NewEncoder(domainID, enterprise uint32, maxMessageSize int) *Encoder {
+	e := &Encoder{
+		domainID:       domainID,
+		maxMessageSize: maxMessageSize,
+		fields:         fields(enterprise),
+	}
+	e.templateSet = e.buildTemplateSet()
+	return e
+}
+
+// Reset resets the sequence number, for use at the start of a new transport session.
+func (e *Encoder) Reset() {
+	e.seq = 0
+}
+
+// Encode encodes the given Flows as one or more IPFIX messages. If withTemplate is true, the template set
+// is included at the start of the first message.
+//
+// Records are packed into messages of up to maxMessageSize bytes. A record that doesn't fit in a message of
+// that size on its own is sent in a message by itself.
+func (e *Encoder) Encode(flows []*proto.Flow, exportTime time.Time, withTemplate bool) [][]byte {
+	var msgs [][]byte
+	var msg []byte
+	var setStart, numRecords int
+
+	start := func() {
+		msg = make([]byte, messageHeaderLength, e.maxMessageSize)
+		if withTemplate {
+			msg = append(msg, e.templateSet...)
+			withTemplate = false
+		}
+		setStart = len(msg)
+		msg = binary.BigEndian.AppendUint16(msg, templateID)
+		msg = binary.BigEndian.AppendUint16(msg, 0)
+		numRecords = 0
+	}
+	finish := func() {
+		if numRecords == 0 {
+			// Drop the empty data set, which isn't allowed.
+			msg = msg[:setStart]
+		} else {
+			binary.BigEndian.PutUint16(msg[setStart+2:], uint16(len(msg)-setStart))
+		}
+		e.putHeader(msg, exportTime)
+		e.seq += uint32(numRecords)
+		msgs = append(msgs, msg)
+	}
+
+	start()
+	var record []byte
+	for _, f := range flows {
+		record = record[:0]
+		for _, fd := range e.fields {
+			record = fd.encode(record, f)
+		}
+		if numRecords > 0 && len(msg)+len(record) > e.maxMessageSize {
+			finish()
+			start()
+		}
+		msg = append(msg, record...)
+		numRecords++
+	}
+	if numRecords > 0 || setStart > messageHeaderLength {
+		// Send the final message if it contains any records or the template.
+		finish()
+	}
+	return msgs
+}
+
+// Template returns a message containing only the template set.
+func (e *Encoder) Template(exportTime time.Time) []byte {
+	return e.Encode(nil, exportTime, true)[0]
+}
+
+// putHeader fills in the message header at the start of the given message.
+func (e *Encoder) putHeader(msg []byte, exportTime time.Time) {
+	binary.BigEndian.PutUint16(msg[0:], version)
+	binary.BigEndian.PutUint16(msg[2:], uint16(len(msg)))
+	binary.BigEndian.PutUint32(msg[4:], uint32(exportTime.Unix()))
+	binary.BigEndian.PutUint32(msg[8:], e.seq)
+	binary.BigEndian.PutUint32(msg[12:], e.domainID)
+}
+
+// buildTemplateSet encodes the template set describing the data records, as described in RFC 7011 section 3.4.1.
+func (e *Encoder) buildTemplateSet() []byte {
+	b := binary.BigEndian.AppendUint16(nil, templateSetID)
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = binary.BigEndian.AppendUint16(b, templateID)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(e.fields)))
+	for _, f := range e.fields {
+		if f.enterprise != 0 {
+			b = binary.BigEndian.AppendUint16(b, f.id|enterpriseBit)
+			b = binary.BigEndian.AppendUint16(b, f.length)
+			b = binary.BigEndian.AppendUint32(b, f.enterprise)
+		} else {
+			b = binary.BigEndian.AppendUint16(b, f.id)
+			b = binary.BigEndian.AppendUint16(b, f.length)
+		}
+	}
+	binary.BigEndian.PutUint16(b[2:], uint16(len(b)))
+	return b
+}
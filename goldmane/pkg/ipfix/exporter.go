@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipfix
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	// defaultUDPMessageSize keeps UDP messages within a typical path MTU, avoiding IP fragmentation.
+	defaultUDPMessageSize = 1400
+
+	// maxMessageSize is the largest message allowed by the 16-bit length field in the message header.
+	maxMessageSize = 0xffff
+
+	dialTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// Options configures an Exporter.
+type Options struct {
+	// Network is the transport used to reach the collector - "udp" or "tcp". Defaults to "udp".
+	Network string `json:"network,omitempty"`
+
+	// Address is the address of the collector, e.g., "collector:4739".
+	Address string `json:"address"`
+
+	// ObservationDomainID identifies this exporter to the collector.
+	ObservationDomainID uint32 `json:"observationDomainID,omitempty"`
+
+	// EnterpriseNumber is the private enterprise number used for Calico's information elements.
+	// Defaults to DefaultEnterpriseNumber.
+	EnterpriseNumber uint32 `json:"enterpriseNumber,omitempty"`
+
+	// MaxMessageSize is the maximum size of each message. Defaults to a size that fits within a typical
+	// MTU for UDP, or the maximum allowed size for TCP.
+	MaxMessageSize int `json:"maxMessageSize,omitempty"`
+
+	// TemplateRefreshSeconds is how often the template is resent over UDP, so that collectors that start
+	// or restart after the exporter can decode records. Over TCP, the template is sent once per connection.
+	// Defaults to 600.
+	TemplateRefreshSeconds int `json:"templateRefreshSeconds,omitempty"`
+}
+
+// Exporter sends Flows to an IPFIX collector.
+type Exporter struct {
+	opts            Options
+	templateRefresh time.Duration
+	encoder         *Encoder
+
+	// conn is the connection to the collector. It is created on first use, and recreated after errors.
+	conn net.Conn
+
+	// lastTemplate is when the template was last sent on the current connection.
+	lastTemplate time.Time
+
+	// nowFunc allows overriding the current time, used in tests.
+	nowFunc func() time.Time
+}
+
+// NewExporter returns an Exporter with the given options.
+func NewExporter(opts Options) (*Exporter, error) {
+	if opts.Address == "" {
+		return nil, fmt.Errorf("a collector address must be provided")
+	}
+	switch opts.Network {
+	case "":
+		opts.Network = "udp"
+	case "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("unsupported network %q, must be udp or tcp", opts.Network)
+	}
+	if opts.EnterpriseNumber == 0 {
+		opts.EnterpriseNumber = DefaultEnterpriseNumber
+	}
+	if opts.MaxMessageSize == 0 {
+		opts.MaxMessageSize = maxMessageSize
+		if opts.Network == "udp" {
+			opts.MaxMessageSize = defaultUDPMessageSize
+		}
+	}
+	if opts.MaxMessageSize < 512 || opts.MaxMessageSize > maxMessageSize {
+		return nil, fmt.Errorf("max message size must be between 512 and %d", maxMessageSize)
+	}
+	if opts.TemplateRefreshSeconds == 0 {
+		opts.TemplateRefreshSeconds = 600
+	}
+
+	return &Exporter{
+		opts:            opts,
+		templateRefresh: time.Duration(opts.TemplateRefreshSeconds) * time.Second,
+		encoder:         NewEncoder(opts.ObservationDomainID, opts.EnterpriseNumber, opts.MaxMessageSize),
+		nowFunc:         time.Now,
+	}, nil
+}
+
+// Write sends the given Flows to the collector. On error, the connection is closed so that the next
+// write starts a new transport session.
+func (e *Exporter) Write(ctx context.Context, flows []*proto.Flow) error {
+	if e.conn == nil {
+		d := net.Dialer{Timeout: dialTimeout}
+		conn, err := d.DialContext(ctx, e.opts.Network, e.opts.Address)
+		if err != nil {
+			return fmt.Errorf("error connecting to IPFIX collector: %w", err)
+		}
+		logrus.WithFields(logrus.Fields{
+			"network": e.opts.Network,
+			"address": e.opts.Address,
+		}).Info("Connected to IPFIX collector")
+		e.conn = conn
+		e.encoder.Reset()
+		e.lastTemplate = time.Time{}
+	}
+
+	now := e.nowFunc()
+	withTemplate := e.lastTemplate.IsZero() || (e.opts.Network == "udp" && now.Sub(e.lastTemplate) >= e.templateRefresh)
+	for _, msg := range e.encoder.Encode(flows, now, withTemplate) {
+		if err := e.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return e.reset(err)
+		}
+		if _, err := e.conn.Write(msg); err != nil {
+			return e.reset(err)
+		}
+	}
+	if withTemplate {
+		e.lastTemplate = now
+	}
+	return nil
+}
+
+func (e *Exporter) reset(err error) error {
+	_ = e.conn.Close()
+	e.conn = nil
+	return fmt.Errorf("error sending to IPFIX collector: %w", err)
+}
+
+// Close closes the connection to the collector.
+func (e *Exporter) Close() error {
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close()
+	e.conn = nil
+	return err
+}
@@ -0,0 +1,425 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipfix
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectcalico/calico/goldmane/pkg/internal/utils"
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
+)
+
+func setupTest(t *testing.T) func() {
+	utils.ConfigureLogging("DEBUG")
+	return logutils.RedirectLogrusToTestingT(t)
+}
+
+// ieKey identifies an information element in decoded records.
+type ieKey struct {
+	enterprise uint32
+	id         uint16
+}
+
+type templateField struct {
+	ieKey
+	length uint16
+}
+
+type message struct {
+	length   int
+	seq      uint32
+	domainID uint32
+	template bool
+	records  []map[ieKey][]byte
+}
+
+// collector is a stand-in for an IPFIX collector, which decodes the messages it receives using the
+// templates it has seen.
+type collector struct {
+	sync.Mutex
+	templates map[uint16][]templateField
+	messages  []message
+}
+
+func newCollector() *collector {
+	return &collector{templates: map[uint16][]templateField{}}
+}
+
+func (c *collector) numMessages() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.messages)
+}
+
+func (c *collector) decode(b []byte) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(b) < messageHeaderLength {
+		return fmt.Errorf("short message")
+	}
+	if v := binary.BigEndian.Uint16(b); v != version {
+		return fmt.Errorf("bad version %d", v)
+	}
+	if l := int(binary.BigEndian.Uint16(b[2:])); l != len(b) {
+		return fmt.Errorf("bad length %d, expected %d", l, len(b))
+	}
+	m := message{
+		length:   len(b),
+		seq:      binary.BigEndian.Uint32(b[8:]),
+		domainID: binary.BigEndian.Uint32(b[12:]),
+	}
+
+	for rest := b[messageHeaderLength:]; len(rest) > 0; {
+		setID := binary.BigEndian.Uint16(rest)
+		setLen := int(binary.BigEndian.Uint16(rest[2:]))
+		set := rest[4:setLen]
+		rest = rest[setLen:]
+
+		if setID == templateSetID {
+			m.template = true
+			id := binary.BigEndian.Uint16(set)
+			n := int(binary.BigEndian.Uint16(set[2:]))
+			set = set[4:]
+			var tfs []templateField
+			for range n {
+				tf := templateField{ieKey: ieKey{id: binary.BigEndian.Uint16(set)}, length: binary.BigEndian.Uint16(set[2:])}
+				set = set[4:]
+				if tf.id&enterpriseBit != 0 {
+					tf.id &^= enterpriseBit
+					tf.enterprise = binary.BigEndian.Uint32(set)
+					set = set[4:]
+				}
+				tfs = append(tfs, tf)
+			}
+			c.templates[id] = tfs
+			continue
+		}
+
+		tfs, ok := c.templates[setID]
+		if !ok {
+			return fmt.Errorf("data set for unknown template %d", setID)
+		}
+		for len(set) > 0 {
+			rec := map[ieKey][]byte{}
+			for _, tf := range tfs {
+				l := int(tf.length)
+				if tf.length == variableLength {
+					l = int(set[0])
+					set = set[1:]
+					if l == 255 {
+						l = int(binary.BigEndian.Uint16(set))
+						set = set[2:]
+					}
+				}
+				rec[tf.ieKey] = set[:l]
+				set = set[l:]
+			}
+			m.records = append(m.records, rec)
+		}
+	}
+	c.messages = append(c.messages, m)
+	return nil
+}
+
+func (c *collector) listenUDP(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	go func() {
+		buf := make([]byte, maxMessageSize)
+		for {
+			n, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if err := c.decode(append([]byte(nil), buf[:n]...)); err != nil {
+				t.Errorf("failed to decode message: %v", err)
+			}
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func (c *collector) listenTCP(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				for {
+					// Messages are framed by the length in their header.
+					hdr := make([]byte, 4)
+					if _, err := io.ReadFull(conn, hdr); err != nil {
+						return
+					}
+					msg := make([]byte, binary.BigEndian.Uint16(hdr[2:]))
+					copy(msg, hdr)
+					if _, err := io.ReadFull(conn, msg[4:]); err != nil {
+						return
+					}
+					if err := c.decode(msg); err != nil {
+						t.Errorf("failed to decode message: %v", err)
+					}
+				}
+			}()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func newFlow(name string, reporter proto.Reporter) *proto.Flow {
+	return &proto.Flow{
+		Key: &proto.FlowKey{
+			SourceName:      name,
+			SourceNamespace: "ns-client",
+			SourceType:      proto.EndpointType_WorkloadEndpoint,
+			DestName:        "server",
+			DestNamespace:   "ns-server",
+			DestType:        proto.EndpointType_WorkloadEndpoint,
+			DestPort:        443,
+			DestServiceName: "server-svc",
+			Proto:           "tcp",
+			Reporter:        reporter,
+			Action:          proto.Action_Allow,
+			Policies: &proto.PolicyTrace{
+				EnforcedPolicies: []*proto.PolicyHit{{
+					Kind:      proto.PolicyKind_CalicoNetworkPolicy,
+					Namespace: "ns-client",
+					Name:      "allow-server",
+					Tier:      "default",
+					Action:    proto.Action_Allow,
+					RuleIndex: 1,
+				}},
+			},
+		},
+		StartTime:             1700000000,
+		EndTime:               1700000300,
+		SourceLabels:          []string{"app=client"},
+		DestLabels:            []string{"app=server"},
+		PacketsIn:             10,
+		PacketsOut:            20,
+		BytesIn:               1000,
+		BytesOut:              2000,
+		NumConnectionsStarted: 3,
+	}
+}
+
+func TestExportUDP(t *testing.T) {
+	defer setupTest(t)()
+
+	c := newCollector()
+	exp, err := NewExporter(Options{Address: c.listenUDP(t), ObservationDomainID: 7})
+	require.NoError(t, err)
+	defer exp.Close()
+
+	// The same connection, as seen by each end. The destination sees the initiator's traffic as inbound.
+	src := newFlow("client", proto.Reporter_Src)
+	dst := newFlow("client", proto.Reporter_Dst)
+	dst.BytesIn, dst.BytesOut = src.BytesOut, src.BytesIn
+	dst.PacketsIn, dst.PacketsOut = src.PacketsOut, src.PacketsIn
+	require.NoError(t, exp.Write(context.Background(), []*proto.Flow{src, dst}))
+	require.Eventually(t, func() bool { return c.numMessages() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	c.Lock()
+	defer c.Unlock()
+	m := c.messages[0]
+	require.True(t, m.template)
+	require.Equal(t, uint32(7), m.domainID)
+	require.Equal(t, uint32(0), m.seq)
+	require.Len(t, m.records, 2)
+
+	ent := func(id uint16) ieKey { return ieKey{enterprise: DefaultEnterpriseNumber, id: id} }
+	rec := m.records[0]
+	require.Equal(t, uint32(1700000000), binary.BigEndian.Uint32(rec[ieKey{id: ieFlowStartSeconds}]))
+	require.Equal(t, uint32(1700000300), binary.BigEndian.Uint32(rec[ieKey{id: ieFlowEndSeconds}]))
+	require.Equal(t, []byte{6}, rec[ieKey{id: ieProtocolIdentifier}])
+	require.Equal(t, uint16(443), binary.BigEndian.Uint16(rec[ieKey{id: ieDestinationTransportPort}]))
+	require.Equal(t, "ns-client", string(rec[ent(ieSourceNamespace)]))
+	require.Equal(t, "client", string(rec[ent(ieSourceName)]))
+	require.Equal(t, "WorkloadEndpoint", string(rec[ent(ieSourceType)]))
+	require.Equal(t, "server", string(rec[ent(ieDestinationName)]))
+	require.Equal(t, "server-svc", string(rec[ent(ieDestinationServiceName)]))
+	require.Equal(t, "Allow", string(rec[ent(ieAction)]))
+	require.Equal(t, "0|default|CalicoNetworkPolicy:ns-client/allow-server|Allow|1", string(rec[ent(ieEnforcedPolicies)]))
+	require.Equal(t, "", string(rec[ent(iePendingPolicies)]))
+	require.Equal(t, "app=client", string(rec[ent(ieSourceLabels)]))
+	require.Equal(t, uint64(3), binary.BigEndian.Uint64(rec[ent(ieConnectionsStarted)]))
+
+	// Traffic is counted relative to the initiator of the connection, regardless of which end reported it.
+	for i, r := range m.records {
+		require.Equal(t, uint64(2000), binary.BigEndian.Uint64(r[ieKey{id: ieOctetDeltaCount}]), "record %d", i)
+		require.Equal(t, uint64(20), binary.BigEndian.Uint64(r[ieKey{id: iePacketDeltaCount}]), "record %d", i)
+		require.Equal(t, uint64(1000), binary.BigEndian.Uint64(r[ieKey{enterprise: reverseEnterpriseNumber, id: ieOctetDeltaCount}]), "record %d", i)
+		require.Equal(t, uint64(10), binary.BigEndian.Uint64(r[ieKey{enterprise: reverseEnterpriseNumber, id: iePacketDeltaCount}]), "record %d", i)
+	}
+}
+
+func TestExportUDPSplitsMessages(t *testing.T) {
+	defer setupTest(t)()
+
+	c := newCollector()
+	exp, err := NewExporter(Options{Address: c.listenUDP(t)})
+	require.NoError(t, err)
+	defer exp.Close()
+
+	var flows []*proto.Flow
+	for i := range 50 {
+		flows = append(flows, newFlow(fmt.Sprintf("client-%d", i), proto.Reporter_Src))
+	}
+	require.NoError(t, exp.Write(context.Background(), flows))
+
+	// All records should arrive, split across several messages that each fit within the default UDP size.
+	require.Eventually(t, func() bool {
+		c.Lock()
+		defer c.Unlock()
+		n := 0
+		for _, m := range c.messages {
+			n += len(m.records)
+		}
+		return n == 50
+	}, 5*time.Second, 10*time.Millisecond)
+
+	c.Lock()
+	defer c.Unlock()
+	require.Greater(t, len(c.messages), 1)
+	var seq uint32
+	for i, m := range c.messages {
+		require.LessOrEqual(t, m.length, defaultUDPMessageSize)
+		require.Equal(t, i == 0, m.template, "only the first message should include the template")
+
+		// The sequence number counts the data records sent before each message.
+		require.Equal(t, seq, m.seq)
+		seq += uint32(len(m.records))
+	}
+}
+
+func TestExportUDPTemplateRefresh(t *testing.T) {
+	defer setupTest(t)()
+
+	c := newCollector()
+	exp, err := NewExporter(Options{Address: c.listenUDP(t), TemplateRefreshSeconds: 60})
+	require.NoError(t, err)
+	defer exp.Close()
+	now := time.Unix(1700000000, 0)
+	exp.nowFunc = func() time.Time { return now }
+
+	flows := []*proto.Flow{newFlow("client", proto.Reporter_Src)}
+	require.NoError(t, exp.Write(context.Background(), flows))
+	require.Eventually(t, func() bool { return c.numMessages() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// The template should not be resent until the refresh interval has passed.
+	now = now.Add(30 * time.Second)
+	require.NoError(t, exp.Write(context.Background(), flows))
+	now = now.Add(30 * time.Second)
+	require.NoError(t, exp.Write(context.Background(), flows))
+	require.Eventually(t, func() bool { return c.numMessages() == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	c.Lock()
+	defer c.Unlock()
+	require.True(t, c.messages[0].template)
+	require.False(t, c.messages[1].template)
+	require.True(t, c.messages[2].template)
+}
+
+func TestExportTCP(t *testing.T) {
+	defer setupTest(t)()
+
+	c := newCollector()
+	addr := c.listenTCP(t)
+	exp, err := NewExporter(Options{Network: "tcp", Address: addr, TemplateRefreshSeconds: 1})
+	require.NoError(t, err)
+	defer exp.Close()
+	now := time.Unix(1700000000, 0)
+	exp.nowFunc = func() time.Time { return now }
+
+	flows := []*proto.Flow{newFlow("client", proto.Reporter_Src)}
+	require.NoError(t, exp.Write(context.Background(), flows))
+	now = now.Add(time.Minute)
+	require.NoError(t, exp.Write(context.Background(), flows))
+	require.Eventually(t, func() bool { return c.numMessages() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	// Over TCP, the template is only sent at the start of the connection.
+	c.Lock()
+	require.True(t, c.messages[0].template)
+	require.False(t, c.messages[1].template)
+	require.Equal(t, uint32(1), c.messages[1].seq)
+	c.Unlock()
+
+	// After the connection is reset, the template is resent and sequence numbers restart.
+	require.NoError(t, exp.Close())
+	require.NoError(t, exp.Write(context.Background(), flows))
+	require.Eventually(t, func() bool { return c.numMessages() == 3 }, 5*time.Second, 10*time.Millisecond)
+	c.Lock()
+	defer c.Unlock()
+	require.True(t, c.messages[2].template)
+	require.Equal(t, uint32(0), c.messages[2].seq)
+}
+
+func TestExportTCPCollectorDown(t *testing.T) {
+	defer setupTest(t)()
+
+	// Find a free port with nothing listening on it.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	exp, err := NewExporter(Options{Network: "tcp", Address: addr})
+	require.NoError(t, err)
+	require.Error(t, exp.Write(context.Background(), []*proto.Flow{newFlow("client", proto.Reporter_Src)}))
+}
+
+func TestLongStrings(t *testing.T) {
+	// Strings of 255 bytes or more use the three byte length encoding, and are truncated at the maximum length.
+	e := NewEncoder(0, DefaultEnterpriseNumber, maxMessageSize)
+	f := newFlow(string(make([]byte, 300)), proto.Reporter_Src)
+	f.SourceLabels = []string{string(make([]byte, 2*maxStringLength))}
+
+	c := newCollector()
+	msgs := e.Encode([]*proto.Flow{f}, time.Now(), true)
+	require.Len(t, msgs, 1)
+	require.NoError(t, c.decode(msgs[0]))
+	rec := c.messages[0].records[0]
+	require.Len(t, rec[ieKey{enterprise: DefaultEnterpriseNumber, id: ieSourceName}], 300)
+	require.Len(t, rec[ieKey{enterprise: DefaultEnterpriseNumber, id: ieSourceLabels}], maxStringLength)
+}
+
+func TestInvalidOptions(t *testing.T) {
+	for _, opts := range []Options{
+		{},
+		{Address: "collector:4739", Network: "sctp"},
+		{Address: "collector:4739", MaxMessageSize: 100},
+	} {
+		_, err := NewExporter(opts)
+		require.Error(t, err, "%+v", opts)
+	}
+}
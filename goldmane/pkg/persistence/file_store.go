@@ -0,0 +1,280 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistence
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protodelim"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	// fileSuffix is the suffix used for bucket snapshot files. Each file contains a gzip compressed
+	// stream of length-delimited proto.Flow messages.
+	fileSuffix = ".flows.gz"
+
+	// queueDepth is the number of completed buckets that can be queued for writing before
+	// new buckets are dropped.
+	queueDepth = 100
+)
+
+// FileStore persists completed aggregation buckets to a local directory, one file per bucket, and allows
+// them to be reloaded on restart. Writes are performed asynchronously so as not to block the aggregator.
+type FileStore struct {
+	// dir is the directory in which to store bucket snapshots.
+	dir string
+
+	// retention is the amount of time to keep bucket snapshots on disk.
+	retention time.Duration
+
+	// writeCh is the queue of buckets waiting to be written to disk.
+	writeCh chan *bucketing.FlowCollection
+
+	// nowFunc allows overriding the current time, used in tests.
+	nowFunc func() time.Time
+}
+
+// Make sure FileStore implements the Persister interface so it can be attached to the bucket ring.
+var _ bucketing.Persister = &FileStore{}
+
+func NewFileStore(opts ...Option) (*FileStore, error) {
+	s := &FileStore{
+		retention: 24 * time.Hour,
+		writeCh:   make(chan *bucketing.FlowCollection, queueDepth),
+		nowFunc:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.dir == "" {
+		return nil, fmt.Errorf("a directory must be provided")
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %w", s.dir, err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"dir":       s.dir,
+		"retention": s.retention,
+	}).Info("Created flow history file store")
+	return s, nil
+}
+
+// Persist queues the given bucket to be written to disk. It does not block - if the write queue is full,
+// the bucket is dropped.
+func (s *FileStore) Persist(c *bucketing.FlowCollection) {
+	select {
+	case s.writeCh <- c:
+	default:
+		logrus.WithFields(logrus.Fields{
+			"start": c.StartTime,
+			"end":   c.EndTime,
+		}).Warn("Flow history write queue full, dropping bucket")
+	}
+}
+
+// Run writes queued buckets to disk and removes expired ones, until the given context is canceled.
+func (s *FileStore) Run(ctx context.Context) {
+	logrus.WithField("dir", s.dir).Info("Starting flow history file store")
+	defer logrus.Warn("Flow history file store exiting")
+
+	// Start of day - clean up anything that expired while we were not running.
+	s.removeExpired()
+
+	for {
+		select {
+		case c := <-s.writeCh:
+			if err := s.write(c); err != nil {
+				logrus.WithError(err).WithFields(logrus.Fields{
+					"start": c.StartTime,
+					"end":   c.EndTime,
+				}).Error("Failed to write bucket to disk")
+			}
+			s.removeExpired()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Load returns all persisted flows with a start time greater than or equal to startGte, ordered by
+// bucket start time.
+func (s *FileStore) Load(startGte int64) ([]*types.Flow, error) {
+	files, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+
+	var flows []*types.Flow
+	for _, f := range files {
+		if f.start < startGte {
+			continue
+		}
+		loaded, err := s.read(f.path)
+		if err != nil {
+			// Don't fail the whole load due to a single bad file - e.g., one that was
+			// only partially written when we last shut down.
+			logrus.WithError(err).WithField("file", f.path).Warn("Failed to read bucket from disk, skipping")
+			continue
+		}
+		flows = append(flows, loaded...)
+	}
+	logrus.WithFields(logrus.Fields{
+		"startGte": startGte,
+		"num":      len(flows),
+	}).Info("Loaded flow history from disk")
+	return flows, nil
+}
+
+// write atomically writes the given bucket to disk.
+func (s *FileStore) write(c *bucketing.FlowCollection) error {
+	// Write to a temporary file first and then rename it, so that readers never see a partially written bucket.
+	tmp, err := os.CreateTemp(s.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Clean up the temporary file if we failed before renaming it.
+		_ = os.Remove(tmp.Name())
+	}()
+
+	gz := gzip.NewWriter(tmp)
+	w := bufio.NewWriter(gz)
+	for i := range c.Flows {
+		if _, err := protodelim.MarshalTo(w, types.FlowToProto(&c.Flows[i])); err != nil {
+			_ = tmp.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, fileName(c.StartTime, c.EndTime))
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"file": path,
+		"num":  len(c.Flows),
+	}).Debug("Wrote bucket to disk")
+	return nil
+}
+
+func (s *FileStore) read(path string) ([]*types.Flow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var flows []*types.Flow
+	r := bufio.NewReader(gz)
+	for {
+		pf := &proto.Flow{}
+		if err := protodelim.UnmarshalFrom(r, pf); err != nil {
+			if errors.Is(err, io.EOF) {
+				return flows, nil
+			}
+			return nil, err
+		}
+		flows = append(flows, types.ProtoToFlow(pf))
+	}
+}
+
+// removeExpired removes any bucket files that have aged beyond the retention period.
+func (s *FileStore) removeExpired() {
+	files, err := s.list()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to list flow history directory")
+		return
+	}
+
+	cutoff := s.nowFunc().Add(-s.retention).Unix()
+	for _, f := range files {
+		if f.end >= cutoff {
+			// Files are sorted by time, so everything after this is newer.
+			return
+		}
+		logrus.WithField("file", f.path).Debug("Removing expired bucket from disk")
+		if err := os.Remove(f.path); err != nil {
+			logrus.WithError(err).WithField("file", f.path).Warn("Failed to remove expired bucket")
+		}
+	}
+}
+
+type bucketFile struct {
+	path  string
+	start int64
+	end   int64
+}
+
+// list returns the bucket files in the store, sorted by start time.
+func (s *FileStore) list() ([]bucketFile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []bucketFile
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), fileSuffix) {
+			continue
+		}
+		var start, end int64
+		if _, err := fmt.Sscanf(strings.TrimSuffix(e.Name(), fileSuffix), "%d-%d", &start, &end); err != nil {
+			logrus.WithField("file", e.Name()).Debug("Ignoring unrecognized file in flow history directory")
+			continue
+		}
+		files = append(files, bucketFile{path: filepath.Join(s.dir, e.Name()), start: start, end: end})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].start < files[j].start
+	})
+	return files, nil
+}
+
+func fileName(start, end int64) string {
+	return fmt.Sprintf("%d-%d%s", start, end, fileSuffix)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistence_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	googleproto "google.golang.org/protobuf/proto"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/persistence"
+	"github.com/projectcalico/calico/goldmane/pkg/testutils"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+func collection(start, end int64, flows ...*proto.Flow) *bucketing.FlowCollection {
+	c := bucketing.NewFlowCollection(start, end)
+	for _, f := range flows {
+		c.AddFlow(*types.ProtoToFlow(f))
+	}
+	return c
+}
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	now := time.Unix(10000, 0)
+	store, err := persistence.NewFileStore(
+		persistence.WithDirectory(dir),
+		persistence.WithNowFunc(func() time.Time { return now }),
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go store.Run(ctx)
+
+	// Persist two buckets.
+	f1 := testutils.NewRandomFlow(9000)
+	f1.EndTime = 9015
+	f2 := testutils.NewRandomFlow(9015)
+	f2.EndTime = 9030
+	store.Persist(collection(9000, 9015, f1))
+	store.Persist(collection(9015, 9030, f2))
+
+	// Both buckets should be loaded, in order.
+	Eventually(func() int {
+		flows, err := store.Load(0)
+		Expect(err).NotTo(HaveOccurred())
+		return len(flows)
+	}, 5*time.Second, 25*time.Millisecond).Should(Equal(2))
+
+	flows, err := store.Load(0)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(googleproto.Equal(types.FlowToProto(flows[0]), f1)).To(BeTrue())
+	Expect(googleproto.Equal(types.FlowToProto(flows[1]), f2)).To(BeTrue())
+
+	// Loading from a later start time should only return the second bucket.
+	flows, err = store.Load(9015)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(flows).To(HaveLen(1))
+	Expect(googleproto.Equal(types.FlowToProto(flows[0]), f2)).To(BeTrue())
+}
+
+func TestFileStoreRetention(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	now := time.Unix(10000, 0)
+	store, err := persistence.NewFileStore(
+		persistence.WithDirectory(dir),
+		persistence.WithRetention(time.Hour),
+		persistence.WithNowFunc(func() time.Time { return now }),
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go store.Run(ctx)
+
+	// Persist a bucket that is older than the retention period, and one that is within it.
+	store.Persist(collection(1000, 1015, testutils.NewRandomFlow(1000)))
+	store.Persist(collection(9000, 9015, testutils.NewRandomFlow(9000)))
+
+	// Only the newer bucket should remain on disk.
+	Eventually(func() []string {
+		entries, err := os.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		return names
+	}, 5*time.Second, 25*time.Millisecond).Should(ConsistOf("9000-9015.flows.gz"))
+}
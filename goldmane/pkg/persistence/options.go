@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistence
+
+import "time"
+
+type Option func(*FileStore)
+
+// WithDirectory sets the directory in which bucket snapshots are stored.
+func WithDirectory(dir string) Option {
+	return func(s *FileStore) {
+		s.dir = dir
+	}
+}
+
+// WithRetention sets how long bucket snapshots are kept on disk before being removed.
+func WithRetention(d time.Duration) Option {
+	return func(s *FileStore) {
+		s.retention = d
+	}
+}
+
+// WithNowFunc allows overriding the current time, used in tests.
+func WithNowFunc(f func() time.Time) Option {
+	return func(s *FileStore) {
+		s.nowFunc = f
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/projectcalico/calico/goldmane/pkg/alerts"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+func NewAlertsServer(detector *alerts.Detector) *Alerts {
+	return &Alerts{
+		detector: detector,
+	}
+}
+
+type Alerts struct {
+	proto.UnimplementedAlertsServer
+
+	detector *alerts.Detector
+}
+
+func (s *Alerts) RegisterWith(srv *grpc.Server) {
+	// Register the server with the gRPC server.
+	proto.RegisterAlertsServer(srv, s)
+	logrus.Info("Registered alerts server")
+}
+
+func (s *Alerts) Stream(req *proto.AlertStreamRequest, server proto.Alerts_StreamServer) error {
+	sub, err := s.detector.Subscribe(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return server.Context().Err()
+		case a := <-sub.Alerts():
+			if err := server.Send(a); err != nil {
+				logrus.WithError(err).Error("Failed to send alert")
+				return err
+			}
+		}
+	}
+}
@@ -69,3 +69,15 @@ func (s *FlowsServer) Stream(req *proto.FlowStreamRequest, server proto.Flows_St
 func (s *FlowsServer) FilterHints(ctx context.Context, req *proto.FilterHintsRequest) (*proto.FilterHintsResult, error) {
 	return s.aggr.Hints(req)
 }
+
+func (s *FlowsServer) Graph(ctx context.Context, req *proto.GraphRequest) (*proto.GraphResult, error) {
+	return s.aggr.Graph(req)
+}
+
+func (s *FlowsServer) TopTalkers(ctx context.Context, req *proto.TopTalkersRequest) (*proto.TopTalkersResult, error) {
+	return s.aggr.TopTalkers(req)
+}
+
+func (s *FlowsServer) PolicyRecommendations(ctx context.Context, req *proto.PolicyRecommendationRequest) (*proto.PolicyRecommendationResult, error) {
+	return s.aggr.PolicyRecommendations(req)
+}
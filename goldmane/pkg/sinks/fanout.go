@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+)
+
+// Fanout is a bucketing.Sink that passes each FlowCollection to a set of named sinks, allowing several
+// sinks to receive Flows from a single aggregator. Sinks may be added and removed while running.
+type Fanout struct {
+	lock  sync.RWMutex
+	sinks map[string]bucketing.Sink
+}
+
+// Make sure Fanout implements the bucketing.Sink interface to be able to receive aggregated Flows.
+var _ bucketing.Sink = &Fanout{}
+
+func NewFanout() *Fanout {
+	return &Fanout{sinks: map[string]bucketing.Sink{}}
+}
+
+// Set adds the given sink under the given name, replacing any existing sink with that name. A nil sink
+// removes the named sink.
+func (f *Fanout) Set(name string, s bucketing.Sink) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if s == nil {
+		delete(f.sinks, name)
+		return
+	}
+	f.sinks[name] = s
+}
+
+// Receive passes the given FlowCollection to each sink. Sinks must not modify the collection,
+// since it is shared between them.
+func (f *Fanout) Receive(c *bucketing.FlowCollection) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for _, name := range slices.Sorted(maps.Keys(f.sinks)) {
+		f.sinks[name].Receive(c)
+	}
+}
+
+// Len returns the number of sinks.
+func (f *Fanout) Len() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return len(f.sinks)
+}
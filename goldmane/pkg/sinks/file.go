@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+// FileOptions configures a file sink.
+type FileOptions struct {
+	// Path is the path of the file to write Flows to.
+	Path string `json:"path"`
+
+	// MaxSizeMB is the size in megabytes at which the file is rotated.
+	MaxSizeMB int `json:"maxSizeMB,omitempty"`
+
+	// MaxBackups is the number of rotated files to keep. If zero, all rotated files are kept,
+	// subject to MaxAgeDays.
+	MaxBackups int `json:"maxBackups,omitempty"`
+
+	// MaxAgeDays is the number of days to keep rotated files. If zero, files are not removed based on age.
+	MaxAgeDays int `json:"maxAgeDays,omitempty"`
+
+	// Compress determines whether rotated files are compressed with gzip.
+	Compress bool `json:"compress,omitempty"`
+}
+
+// fileWriter writes Flows to a local file as JSON lines, rotating the file as it grows.
+type fileWriter struct {
+	out *lumberjack.Logger
+}
+
+func newFileWriter(options json.RawMessage) (Writer, error) {
+	opts := FileOptions{MaxSizeMB: 100, MaxBackups: 5}
+	if err := decodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+	if opts.Path == "" {
+		return nil, fmt.Errorf("a path must be provided")
+	}
+	return &fileWriter{
+		out: &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAgeDays,
+			Compress:   opts.Compress,
+		},
+	}, nil
+}
+
+func (w *fileWriter) Write(_ context.Context, flows []*proto.Flow) error {
+	for _, f := range flows {
+		line, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Errorf("error marshalling flow: %w", err)
+		}
+
+		// Write each line in a single call, so that the file is only ever rotated between lines.
+		if _, err := w.out.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("error writing flow: %w", err)
+		}
+	}
+	return nil
+}
+
+func (w *fileWriter) Close() error {
+	return w.out.Close()
+}
+
+// decodeOptions decodes type-specific sink options into the given struct, rejecting unknown fields.
+func decodeOptions(options json.RawMessage, into any) error {
+	if len(options) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(options))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(into); err != nil {
+		return fmt.Errorf("invalid options: %w", err)
+	}
+	return nil
+}
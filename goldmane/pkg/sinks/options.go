@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+type Option func(*Sink)
+
+// WithFilter sets the filter used to select the Flows written to the sink.
+func WithFilter(filter *proto.Filter) Option {
+	return func(s *Sink) {
+		s.filter = filter
+	}
+}
+
+// WithRateLimit sets the maximum number of Flows per second written to the sink. A limit of zero
+// disables rate limiting.
+func WithRateLimit(flowsPerSecond float64) Option {
+	return func(s *Sink) {
+		if flowsPerSecond <= 0 {
+			s.limiter = nil
+			return
+		}
+		// Allow up to a second's worth of Flows to be written at once.
+		burst := max(1, int(math.Ceil(flowsPerSecond)))
+		s.limiter = rate.NewLimiter(rate.Limit(flowsPerSecond), burst)
+	}
+}
+
+// WithRetryInterval sets the time to wait before retrying a failed write.
+func WithRetryInterval(d time.Duration) Option {
+	return func(s *Sink) {
+		s.retryInterval = d
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+// otlpScopeName is the instrumentation scope reported for each batch of log records.
+const otlpScopeName = "github.com/projectcalico/calico/goldmane"
+
+// OTLPOptions configures an OpenTelemetry OTLP/gRPC logs sink.
+type OTLPOptions struct {
+	// Endpoint is the address of the OTLP/gRPC logs receiver, e.g., "otel-collector:4317".
+	Endpoint string `json:"endpoint"`
+
+	// Insecure disables TLS when connecting to the receiver.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CACertPath is the path to the CA used to verify the receiver. If empty, the system roots are used.
+	CACertPath string `json:"caCertPath,omitempty"`
+
+	// ClientCertPath and ClientKeyPath are the paths to the client certificate and key, if the receiver
+	// requires mTLS.
+	ClientCertPath string `json:"clientCertPath,omitempty"`
+	ClientKeyPath  string `json:"clientKeyPath,omitempty"`
+
+	// ServerName overrides the name used to verify the receiver's certificate.
+	ServerName string `json:"serverName,omitempty"`
+
+	// Headers are additional gRPC metadata sent with each request, e.g., for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout is the timeout for each export request.
+	Timeout string `json:"timeout,omitempty"`
+
+	// ServiceName is the service.name resource attribute attached to exported logs.
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// otlpWriter exports Flows as OpenTelemetry log records, with the Flow as the JSON encoded body and its
+// key fields as attributes.
+type otlpWriter struct {
+	conn     *grpc.ClientConn
+	client   collectorlogsv1.LogsServiceClient
+	headers  metadata.MD
+	timeout  time.Duration
+	resource *resourcev1.Resource
+}
+
+func newOTLPWriter(options json.RawMessage) (Writer, error) {
+	opts := OTLPOptions{Timeout: "10s", ServiceName: "goldmane"}
+	if err := decodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+	if opts.Endpoint == "" {
+		return nil, fmt.Errorf("an endpoint must be provided")
+	}
+	timeout, err := time.ParseDuration(opts.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeout: %w", err)
+	}
+
+	creds := insecure.NewCredentials()
+	if !opts.Insecure {
+		tlsCfg, err := otlpTLSConfig(&opts)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	conn, err := grpc.NewClient(opts.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP client: %w", err)
+	}
+
+	return &otlpWriter{
+		conn:    conn,
+		client:  collectorlogsv1.NewLogsServiceClient(conn),
+		headers: metadata.New(opts.Headers),
+		timeout: timeout,
+		resource: &resourcev1.Resource{
+			Attributes: []*commonv1.KeyValue{stringAttr("service.name", opts.ServiceName)},
+		},
+	}, nil
+}
+
+func otlpTLSConfig(opts *OTLPOptions) (*tls.Config, error) {
+	tlsCfg := calicotls.NewTLSConfig()
+	tlsCfg.ServerName = opts.ServerName
+	if opts.CACertPath != "" {
+		caCert, err := os.ReadFile(opts.CACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file %s: %w", opts.CACertPath, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", opts.CACertPath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if opts.ClientCertPath != "" || opts.ClientKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertPath, opts.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client keypair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+func (w *otlpWriter) Write(ctx context.Context, flows []*proto.Flow) error {
+	observed := uint64(time.Now().UnixNano())
+	records := make([]*logsv1.LogRecord, 0, len(flows))
+	for _, f := range flows {
+		body, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Errorf("error marshalling flow: %w", err)
+		}
+		records = append(records, &logsv1.LogRecord{
+			TimeUnixNano:         uint64(f.EndTime) * uint64(time.Second),
+			ObservedTimeUnixNano: observed,
+			SeverityNumber:       logsv1.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:         "INFO",
+			Body:                 &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: string(body)}},
+			Attributes:           flowAttributes(f),
+		})
+	}
+
+	req := &collectorlogsv1.ExportLogsServiceRequest{
+		ResourceLogs: []*logsv1.ResourceLogs{{
+			Resource: w.resource,
+			ScopeLogs: []*logsv1.ScopeLogs{{
+				Scope:      &commonv1.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: records,
+			}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(ctx, w.headers), w.timeout)
+	defer cancel()
+	rsp, err := w.client.Export(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error exporting flows: %w", err)
+	}
+	if ps := rsp.GetPartialSuccess(); ps.GetRejectedLogRecords() > 0 {
+		// The receiver has told us these records can't be accepted, so there is no point retrying them.
+		logrus.WithFields(logrus.Fields{
+			"rejected": ps.GetRejectedLogRecords(),
+			"message":  ps.GetErrorMessage(),
+		}).Warn("OTLP receiver rejected flows")
+	}
+	return nil
+}
+
+func (w *otlpWriter) Close() error {
+	return w.conn.Close()
+}
+
+// flowAttributes returns the attributes attached to the log record for the given Flow, allowing flows to
+// be queried without parsing the body.
+func flowAttributes(f *proto.Flow) []*commonv1.KeyValue {
+	k := f.Key
+	return []*commonv1.KeyValue{
+		stringAttr("source.name", k.SourceName),
+		stringAttr("source.namespace", k.SourceNamespace),
+		stringAttr("source.type", k.SourceType.String()),
+		stringAttr("destination.name", k.DestName),
+		stringAttr("destination.namespace", k.DestNamespace),
+		stringAttr("destination.type", k.DestType.String()),
+		intAttr("destination.port", k.DestPort),
+		stringAttr("network.transport", k.Proto),
+		stringAttr("action", k.Action.String()),
+		stringAttr("reporter", k.Reporter.String()),
+	}
+}
+
+func stringAttr(key, val string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: val}}}
+}
+
+func intAttr(key string, val int64) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: val}}}
+}
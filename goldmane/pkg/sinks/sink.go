@@ -0,0 +1,193 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	// queueDepth is the number of FlowCollections that can be queued for a sink before new
+	// collections are dropped.
+	queueDepth = 100
+
+	// maxBatchSize is the maximum number of Flows passed to a Writer in a single call.
+	maxBatchSize = 500
+
+	// maxRetries is the number of times a failed write is retried before the batch is dropped.
+	maxRetries = 3
+)
+
+var (
+	flowsWritten = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goldmane_sink_flows_written_total",
+		Help: "Total number of flows written to each sink.",
+	}, []string{"sink"})
+
+	flowsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goldmane_sink_flows_dropped_total",
+		Help: "Total number of flows dropped by each sink, due to a full queue or write failures.",
+	}, []string{"sink"})
+)
+
+func init() {
+	prometheus.MustRegister(flowsWritten, flowsDropped)
+}
+
+// Sink is a bucketing.Sink that writes the Flows matching its filter to a Writer, subject to a rate limit.
+// Received FlowCollections are queued and written asynchronously, so as not to block the aggregator.
+type Sink struct {
+	name   string
+	writer Writer
+
+	// filter selects the Flows to write. If nil, all Flows are written.
+	filter *proto.Filter
+
+	// limiter limits the rate at which Flows are written. If nil, the rate is not limited.
+	limiter *rate.Limiter
+
+	// queue holds received FlowCollections waiting to be written.
+	queue chan *bucketing.FlowCollection
+
+	// retryInterval is the time to wait before the first retry of a failed write, doubling on each
+	// subsequent retry.
+	retryInterval time.Duration
+}
+
+// Make sure Sink implements the bucketing.Sink interface to be able to receive aggregated Flows.
+var _ bucketing.Sink = &Sink{}
+
+// NewSink returns a Sink that writes Flows to the given Writer.
+func NewSink(name string, w Writer, opts ...Option) *Sink {
+	s := &Sink{
+		name:          name,
+		writer:        w,
+		queue:         make(chan *bucketing.FlowCollection, queueDepth),
+		retryInterval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Name returns the name of the sink.
+func (s *Sink) Name() string {
+	return s.name
+}
+
+// Receive queues the given FlowCollection to be written. If the queue is full, the collection is dropped.
+func (s *Sink) Receive(c *bucketing.FlowCollection) {
+	select {
+	case s.queue <- c:
+	default:
+		logrus.WithFields(logrus.Fields{
+			"sink":  s.name,
+			"start": c.StartTime,
+			"end":   c.EndTime,
+		}).Warn("Sink queue full, dropping flows")
+		flowsDropped.WithLabelValues(s.name).Add(float64(len(c.Flows)))
+	}
+}
+
+// Run writes queued FlowCollections until the given context is cancelled, at which point the Writer is closed.
+func (s *Sink) Run(ctx context.Context) {
+	logrus.WithField("sink", s.name).Info("Starting sink")
+	defer func() {
+		if err := s.writer.Close(); err != nil {
+			logrus.WithError(err).WithField("sink", s.name).Warn("Error closing sink")
+		}
+		logrus.WithField("sink", s.name).Info("Sink exiting")
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case c := <-s.queue:
+			s.write(ctx, c)
+		}
+	}
+}
+
+// write writes the Flows in the given collection that match the sink's filter, in batches sized to
+// respect the rate limit.
+func (s *Sink) write(ctx context.Context, c *bucketing.FlowCollection) {
+	batchSize := maxBatchSize
+	if s.limiter != nil {
+		batchSize = min(batchSize, s.limiter.Burst())
+	}
+
+	batch := make([]*proto.Flow, 0, batchSize)
+	for i := range c.Flows {
+		f := &c.Flows[i]
+		if !types.Matches(s.filter, f.Key) || !types.MatchesLabels(s.filter, f.SourceLabels, f.DestLabels) {
+			continue
+		}
+		batch = append(batch, types.FlowToProto(f))
+		if len(batch) == batchSize {
+			if !s.writeBatch(ctx, batch) {
+				return
+			}
+			batch = make([]*proto.Flow, 0, batchSize)
+		}
+	}
+	if len(batch) > 0 {
+		s.writeBatch(ctx, batch)
+	}
+}
+
+// writeBatch writes a single batch of Flows, waiting for the rate limit and retrying on failure. It returns
+// false if the context was cancelled.
+func (s *Sink) writeBatch(ctx context.Context, batch []*proto.Flow) bool {
+	if s.limiter != nil {
+		if err := s.limiter.WaitN(ctx, len(batch)); err != nil {
+			return false
+		}
+	}
+
+	interval := s.retryInterval
+	for attempt := 0; ; attempt++ {
+		err := s.writer.Write(ctx, batch)
+		if err == nil {
+			flowsWritten.WithLabelValues(s.name).Add(float64(len(batch)))
+			return true
+		}
+
+		logCtx := logrus.WithError(err).WithField("sink", s.name)
+		if attempt == maxRetries {
+			logCtx.Error("Max retries exceeded, dropping flows")
+			flowsDropped.WithLabelValues(s.name).Add(float64(len(batch)))
+			return true
+		}
+		logCtx.Warn("Error writing flows, will retry")
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(interval):
+			interval *= 2
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+const (
+	TypeFile   = "file"
+	TypeIPFIX  = "ipfix"
+	TypeOTLP   = "otlp"
+	TypeSyslog = "syslog"
+)
+
+// Writer writes batches of Flows to an external destination. Each type of sink provides a Writer, while
+// filtering, rate limiting and queueing are handled by the Sink that wraps it.
+type Writer interface {
+	Write(ctx context.Context, flows []*proto.Flow) error
+	Close() error
+}
+
+// Factory creates a Writer from its type-specific options, given as raw JSON.
+type Factory func(options json.RawMessage) (Writer, error)
+
+var (
+	registryLock sync.Mutex
+	registry     = map[string]Factory{}
+)
+
+func init() {
+	Register(TypeFile, newFileWriter)
+	Register(TypeIPFIX, newIPFIXWriter)
+	Register(TypeOTLP, newOTLPWriter)
+	Register(TypeSyslog, newSyslogWriter)
+}
+
+// Register makes a type of sink available for use in sink configuration. It panics if the type
+// is already registered.
+func Register(sinkType string, f Factory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, ok := registry[sinkType]; ok {
+		panic(fmt.Sprintf("sink type %q registered twice", sinkType))
+	}
+	registry[sinkType] = f
+}
+
+func lookup(sinkType string) (Factory, bool) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	f, ok := registry[sinkType]
+	return f, ok
+}
+
+// Config is the configuration for a set of sinks.
+type Config struct {
+	Sinks []SinkConfig `json:"sinks"`
+}
+
+// SinkConfig is the configuration for a single sink.
+type SinkConfig struct {
+	// Name uniquely identifies the sink.
+	Name string `json:"name"`
+
+	// Type is the type of sink, as passed to Register.
+	Type string `json:"type"`
+
+	// Filter selects the Flows written to the sink, using the JSON encoding of a proto.Filter.
+	// If not set, all Flows are written.
+	Filter json.RawMessage `json:"filter,omitempty"`
+
+	// RateLimit is the maximum number of Flows per second written to the sink. Flows beyond this rate are
+	// queued, and dropped if the queue fills. If zero, the rate is not limited.
+	RateLimit float64 `json:"rateLimit,omitempty"`
+
+	// Options holds the type-specific options for the sink.
+	Options json.RawMessage `json:"options,omitempty"`
+}
+
+// LoadConfig reads sink configuration from a JSON file.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sink configuration: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse sink configuration %s: %w", path, err)
+	}
+
+	names := map[string]bool{}
+	for _, s := range cfg.Sinks {
+		if s.Name == "" {
+			return nil, fmt.Errorf("sink of type %q has no name", s.Type)
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("duplicate sink name %q", s.Name)
+		}
+		names[s.Name] = true
+	}
+	return &cfg, nil
+}
+
+// New creates a Sink from the given configuration. The returned Sink must be started with Run.
+func New(cfg SinkConfig) (*Sink, error) {
+	f, ok := lookup(cfg.Type)
+	if !ok {
+		return nil, fmt.Errorf("sink %s has unknown type %q", cfg.Name, cfg.Type)
+	}
+	if cfg.RateLimit < 0 {
+		return nil, fmt.Errorf("sink %s has a negative rate limit", cfg.Name)
+	}
+
+	var filter *proto.Filter
+	if len(cfg.Filter) > 0 {
+		filter = &proto.Filter{}
+		if err := protojson.Unmarshal(cfg.Filter, filter); err != nil {
+			return nil, fmt.Errorf("sink %s has an invalid filter: %w", cfg.Name, err)
+		}
+		if err := types.ValidateFilter(filter); err != nil {
+			return nil, fmt.Errorf("sink %s has an invalid filter: %w", cfg.Name, err)
+		}
+	}
+
+	w, err := f(cfg.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sink %s: %w", cfg.Name, err)
+	}
+	return NewSink(cfg.Name, w, WithFilter(filter), WithRateLimit(cfg.RateLimit)), nil
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unique"
+
+	"github.com/stretchr/testify/require"
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/projectcalico/calico/goldmane/pkg/aggregator/bucketing"
+	"github.com/projectcalico/calico/goldmane/pkg/internal/utils"
+	"github.com/projectcalico/calico/goldmane/pkg/sinks"
+	"github.com/projectcalico/calico/goldmane/pkg/types"
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
+)
+
+func setupTest(t *testing.T) (context.Context, func()) {
+	utils.ConfigureLogging("DEBUG")
+	logCancel := logutils.RedirectLogrusToTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	return ctx, func() {
+		cancel()
+		logCancel()
+	}
+}
+
+func newFlow(namespace, name string, port int64) types.Flow {
+	return types.Flow{
+		Key: types.NewFlowKey(
+			&types.FlowKeySource{
+				SourceName:      name,
+				SourceNamespace: namespace,
+				SourceType:      proto.EndpointType_WorkloadEndpoint,
+			},
+			&types.FlowKeyDestination{
+				DestName:      "server",
+				DestNamespace: "ns-server",
+				DestType:      proto.EndpointType_WorkloadEndpoint,
+				DestPort:      port,
+			},
+			&types.FlowKeyMeta{
+				Proto:    "tcp",
+				Reporter: proto.Reporter_Src,
+				Action:   proto.Action_Allow,
+			},
+			&proto.PolicyTrace{},
+		),
+		StartTime:    15,
+		EndTime:      30,
+		SourceLabels: unique.Make("app=client"),
+		DestLabels:   unique.Make("app=server"),
+		PacketsIn:    1,
+		PacketsOut:   2,
+	}
+}
+
+func newCollection(flows ...types.Flow) *bucketing.FlowCollection {
+	c := bucketing.NewFlowCollection(15, 30)
+	for _, f := range flows {
+		c.AddFlow(f)
+	}
+	return c
+}
+
+// recordingWriter is a Writer that records the Flows written to it, optionally failing a number of times first.
+type recordingWriter struct {
+	sync.Mutex
+	flows    []*proto.Flow
+	batches  int
+	failures int
+	closed   bool
+}
+
+func (w *recordingWriter) Write(_ context.Context, flows []*proto.Flow) error {
+	w.Lock()
+	defer w.Unlock()
+	if w.failures > 0 {
+		w.failures--
+		return fmt.Errorf("write failed")
+	}
+	w.flows = append(w.flows, flows...)
+	w.batches++
+	return nil
+}
+
+func (w *recordingWriter) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *recordingWriter) numFlows() int {
+	w.Lock()
+	defer w.Unlock()
+	return len(w.flows)
+}
+
+func TestFileSink(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+	defer cleanup()
+
+	path := filepath.Join(t.TempDir(), "flows.log")
+	s, err := sinks.New(sinks.SinkConfig{
+		Name:    "file",
+		Type:    sinks.TypeFile,
+		Filter:  json.RawMessage(`{"sourceNamespaces": [{"value": "ns-a"}], "sourceSelector": "app == 'client'"}`),
+		Options: json.RawMessage(fmt.Sprintf(`{"path": %q, "maxSizeMB": 1}`, path)),
+	})
+	require.NoError(t, err)
+	go s.Run(ctx)
+
+	// Send flows from two namespaces. Only the flow matching the filter should be written.
+	s.Receive(newCollection(newFlow("ns-a", "client-a", 80), newFlow("ns-b", "client-b", 80)))
+
+	var lines []string
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return false
+		}
+		lines = strings.Split(strings.TrimSpace(string(b)), "\n")
+		return len(lines) == 1 && lines[0] != ""
+	}, 5*time.Second, 50*time.Millisecond)
+
+	var f proto.Flow
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &f))
+	require.Equal(t, "ns-a", f.Key.SourceNamespace)
+	require.Equal(t, "client-a", f.Key.SourceName)
+}
+
+func TestSinkRateLimit(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+	defer cleanup()
+
+	w := &recordingWriter{}
+	s := sinks.NewSink("limited", w, sinks.WithRateLimit(10))
+	go s.Run(ctx)
+
+	// Send 30 flows. The first 10 can be written immediately, but the rest should be written at 10 flows
+	// per second, taking at least another couple of seconds.
+	var flows []types.Flow
+	for i := range 30 {
+		flows = append(flows, newFlow("ns-a", fmt.Sprintf("client-%d", i), 80))
+	}
+	start := time.Now()
+	s.Receive(newCollection(flows...))
+
+	require.Eventually(t, func() bool { return w.numFlows() == 10 }, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return w.numFlows() == 30 }, 5*time.Second, 10*time.Millisecond)
+	require.GreaterOrEqual(t, time.Since(start), 1500*time.Millisecond)
+
+	// Each batch should be no bigger than the rate limit allows.
+	w.Lock()
+	require.Equal(t, 3, w.batches)
+	w.Unlock()
+}
+
+func TestSinkRetry(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+
+	w := &recordingWriter{failures: 2}
+	s := sinks.NewSink("retry", w, sinks.WithRetryInterval(10*time.Millisecond))
+	go s.Run(ctx)
+
+	// The flow should be written once the writer stops failing.
+	s.Receive(newCollection(newFlow("ns-a", "client", 80)))
+	require.Eventually(t, func() bool { return w.numFlows() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// The writer should be closed when the sink stops.
+	cleanup()
+	require.Eventually(t, func() bool {
+		w.Lock()
+		defer w.Unlock()
+		return w.closed
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestFanout(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+	defer cleanup()
+
+	wa, wb := &recordingWriter{}, &recordingWriter{}
+	a := sinks.NewSink("a", wa)
+	b := sinks.NewSink("b", wb, sinks.WithFilter(&proto.Filter{DestPorts: []*proto.PortMatch{{Port: 443}}}))
+	go a.Run(ctx)
+	go b.Run(ctx)
+
+	f := sinks.NewFanout()
+	f.Set("a", a)
+	f.Set("b", b)
+	require.Equal(t, 2, f.Len())
+
+	// Each sink should receive the flows matching its own filter.
+	f.Receive(newCollection(newFlow("ns-a", "client", 80), newFlow("ns-a", "client", 443)))
+	require.Eventually(t, func() bool { return wa.numFlows() == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return wb.numFlows() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// Removing a sink stops it receiving flows.
+	f.Set("b", nil)
+	require.Equal(t, 1, f.Len())
+	f.Receive(newCollection(newFlow("ns-a", "client", 443)))
+	require.Eventually(t, func() bool { return wa.numFlows() == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.Never(t, func() bool { return wb.numFlows() != 1 }, 500*time.Millisecond, 50*time.Millisecond)
+}
+
+// logsServer is an OTLP logs receiver that records the requests it receives.
+type logsServer struct {
+	collectorlogsv1.UnimplementedLogsServiceServer
+
+	sync.Mutex
+	reqs    []*collectorlogsv1.ExportLogsServiceRequest
+	headers metadata.MD
+}
+
+func (s *logsServer) Export(ctx context.Context, req *collectorlogsv1.ExportLogsServiceRequest) (*collectorlogsv1.ExportLogsServiceResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.reqs = append(s.reqs, req)
+	s.headers, _ = metadata.FromIncomingContext(ctx)
+	return &collectorlogsv1.ExportLogsServiceResponse{}, nil
+}
+
+func TestOTLPSink(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+	defer cleanup()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &logsServer{}
+	grpcServer := grpc.NewServer()
+	collectorlogsv1.RegisterLogsServiceServer(grpcServer, srv)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	s, err := sinks.New(sinks.SinkConfig{
+		Name: "otlp",
+		Type: sinks.TypeOTLP,
+		Options: json.RawMessage(fmt.Sprintf(
+			`{"endpoint": %q, "insecure": true, "headers": {"authorization": "Bearer token"}}`, lis.Addr().String(),
+		)),
+	})
+	require.NoError(t, err)
+	go s.Run(ctx)
+
+	s.Receive(newCollection(newFlow("ns-a", "client", 80)))
+
+	require.Eventually(t, func() bool {
+		srv.Lock()
+		defer srv.Unlock()
+		return len(srv.reqs) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	srv.Lock()
+	defer srv.Unlock()
+	require.Equal(t, []string{"Bearer token"}, srv.headers.Get("authorization"))
+
+	rl := srv.reqs[0].ResourceLogs
+	require.Len(t, rl, 1)
+	require.Equal(t, "goldmane", rl[0].Resource.Attributes[0].Value.GetStringValue())
+	records := rl[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(30*time.Second), records[0].TimeUnixNano)
+
+	attrs := map[string]string{}
+	for _, kv := range records[0].Attributes {
+		attrs[kv.Key] = kv.Value.GetStringValue()
+	}
+	require.Equal(t, "ns-a", attrs["source.namespace"])
+	require.Equal(t, "Allow", attrs["action"])
+
+	var f proto.Flow
+	require.NoError(t, json.Unmarshal([]byte(records[0].Body.GetStringValue()), &f))
+	require.Equal(t, "client", f.Key.SourceName)
+}
+
+func TestSyslogSink(t *testing.T) {
+	ctx, cleanup := setupTest(t)
+	defer cleanup()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	s, err := sinks.New(sinks.SinkConfig{
+		Name:    "syslog",
+		Type:    sinks.TypeSyslog,
+		Options: json.RawMessage(fmt.Sprintf(`{"network": "udp", "address": %q, "tag": "flows"}`, conn.LocalAddr().String())),
+	})
+	require.NoError(t, err)
+	go s.Run(ctx)
+
+	s.Receive(newCollection(newFlow("ns-a", "client", 80)))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 64*1024)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := string(buf[:n])
+
+	// Messages are sent with the local0 facility at info severity: (16 * 8) + 6.
+	require.True(t, strings.HasPrefix(msg, "<134>"), msg)
+	require.Contains(t, msg, "flows[")
+
+	// The message body is the JSON encoded flow.
+	body := msg[strings.Index(msg, "{"):]
+	var f proto.Flow
+	require.NoError(t, json.NewDecoder(bufio.NewReader(strings.NewReader(body))).Decode(&f))
+	require.Equal(t, "client", f.Key.SourceName)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	_, cleanup := setupTest(t)
+	defer cleanup()
+
+	tests := []struct {
+		name string
+		cfg  sinks.SinkConfig
+	}{
+		{"unknown type", sinks.SinkConfig{Name: "a", Type: "carrier-pigeon"}},
+		{"bad filter", sinks.SinkConfig{Name: "a", Type: sinks.TypeFile, Filter: json.RawMessage(`{"sourceSelector": "app =="}`)}},
+		{"negative rate", sinks.SinkConfig{Name: "a", Type: sinks.TypeFile, RateLimit: -1}},
+		{"unknown option", sinks.SinkConfig{Name: "a", Type: sinks.TypeFile, Options: json.RawMessage(`{"path": "/tmp/x", "size": 1}`)}},
+		{"missing path", sinks.SinkConfig{Name: "a", Type: sinks.TypeFile}},
+		{"missing endpoint", sinks.SinkConfig{Name: "a", Type: sinks.TypeOTLP}},
+		{"bad ipfix network", sinks.SinkConfig{Name: "a", Type: sinks.TypeIPFIX, Options: json.RawMessage(`{"address": "collector:4739", "network": "sctp"}`)}},
+		{"bad facility", sinks.SinkConfig{Name: "a", Type: sinks.TypeSyslog, Options: json.RawMessage(`{"facility": "mail"}`)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := sinks.New(tc.cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	_, cleanup := setupTest(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sinks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"sinks": [
+		{"name": "siem", "type": "syslog", "rateLimit": 100, "options": {"network": "tcp", "address": "siem:514"}},
+		{"name": "lake", "type": "file", "filter": {"actions": ["Deny"]}, "options": {"path": "/var/log/flows.log"}}
+	]}`), 0o644))
+
+	cfg, err := sinks.LoadConfig(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.Sinks, 2)
+	require.Equal(t, "siem", cfg.Sinks[0].Name)
+	require.Equal(t, 100.0, cfg.Sinks[0].RateLimit)
+	for _, sc := range cfg.Sinks {
+		_, err := sinks.New(sc)
+		require.NoError(t, err)
+	}
+
+	// Sink names must be unique.
+	require.NoError(t, os.WriteFile(path, []byte(`{"sinks": [{"name": "a", "type": "file"}, {"name": "a", "type": "file"}]}`), 0o644))
+	_, err = sinks.LoadConfig(path)
+	require.ErrorContains(t, err, "duplicate")
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+var syslogFacilities = map[string]syslog.Priority{
+	"user":   syslog.LOG_USER,
+	"daemon": syslog.LOG_DAEMON,
+	"local0": syslog.LOG_LOCAL0,
+	"local1": syslog.LOG_LOCAL1,
+	"local2": syslog.LOG_LOCAL2,
+	"local3": syslog.LOG_LOCAL3,
+	"local4": syslog.LOG_LOCAL4,
+	"local5": syslog.LOG_LOCAL5,
+	"local6": syslog.LOG_LOCAL6,
+	"local7": syslog.LOG_LOCAL7,
+}
+
+// SyslogOptions configures a syslog sink.
+type SyslogOptions struct {
+	// Network is the network used to reach the syslog server, e.g., "udp" or "tcp". If empty, the local
+	// syslog daemon is used.
+	Network string `json:"network,omitempty"`
+
+	// Address is the address of the syslog server.
+	Address string `json:"address,omitempty"`
+
+	// Tag is the tag included in each message.
+	Tag string `json:"tag,omitempty"`
+
+	// Facility is the syslog facility used for each message, e.g., "local0".
+	Facility string `json:"facility,omitempty"`
+}
+
+// syslogWriter writes each Flow to syslog as a JSON encoded message.
+type syslogWriter struct {
+	opts     SyslogOptions
+	priority syslog.Priority
+
+	// w is the connection to the syslog server. It is created on first use, so that the sink can start
+	// before the server is reachable.
+	w *syslog.Writer
+}
+
+func newSyslogWriter(options json.RawMessage) (Writer, error) {
+	opts := SyslogOptions{Tag: "goldmane", Facility: "local0"}
+	if err := decodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+	facility, ok := syslogFacilities[opts.Facility]
+	if !ok {
+		return nil, fmt.Errorf("unknown syslog facility %q", opts.Facility)
+	}
+	if opts.Network != "" && opts.Address == "" {
+		return nil, fmt.Errorf("an address must be provided for network %q", opts.Network)
+	}
+	return &syslogWriter{opts: opts, priority: facility | syslog.LOG_INFO}, nil
+}
+
+func (w *syslogWriter) Write(_ context.Context, flows []*proto.Flow) error {
+	if w.w == nil {
+		sw, err := syslog.Dial(w.opts.Network, w.opts.Address, w.priority, w.opts.Tag)
+		if err != nil {
+			return fmt.Errorf("error connecting to syslog: %w", err)
+		}
+		w.w = sw
+	}
+
+	for _, f := range flows {
+		msg, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Errorf("error marshalling flow: %w", err)
+		}
+		if err := w.w.Info(string(msg)); err != nil {
+			return fmt.Errorf("error writing flow to syslog: %w", err)
+		}
+	}
+	return nil
+}
+
+func (w *syslogWriter) Close() error {
+	if w.w == nil {
+		return nil
+	}
+	return w.w.Close()
+}
@@ -268,7 +268,35 @@ func (d *DiachronicFlow) Matches(filter *proto.Filter, startGte, startLt int64)
 	if filter == nil {
 		return true
 	}
-	return Matches(filter, &d.Key)
+	if !Matches(filter, &d.Key) {
+		return false
+	}
+	if HasLabelSelectors(filter) {
+		// Labels are not part of the key, so we need to consult the windows in range.
+		src, dst := d.labels(startGte, startLt)
+		return MatchesLabels(filter, src, dst)
+	}
+	return true
+}
+
+// labels returns the source and destination labels for the DiachronicFlow across the given time range.
+// As with Aggregate, this is the intersection of labels across all windows in range.
+func (d *DiachronicFlow) labels(startGte, startLt int64) (unique.Handle[string], unique.Handle[string]) {
+	src, dst := unique.Make(""), unique.Make("")
+	first := true
+	for _, w := range d.Windows {
+		if (startGte == 0 || w.start >= startGte) &&
+			(startLt == 0 || w.end <= startLt) {
+			if first {
+				src, dst = w.SourceLabels, w.DestLabels
+				first = false
+				continue
+			}
+			src = intersection(src, w.SourceLabels)
+			dst = intersection(dst, w.DestLabels)
+		}
+	}
+	return src, dst
 }
 
 func (d *DiachronicFlow) Within(startGte, startLt int64) bool {
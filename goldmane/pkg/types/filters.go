@@ -15,10 +15,16 @@
 package types
 
 import (
+	"fmt"
 	"slices"
 	"strings"
+	"sync"
+	"unique"
+
+	"github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 )
 
 const (
@@ -83,6 +89,50 @@ func Matches(filter *proto.Filter, key *FlowKey) bool {
 	return true
 }
 
+// ValidateFilter checks that the given filter is well-formed, returning an error if not.
+func ValidateFilter(filter *proto.Filter) error {
+	if filter == nil {
+		return nil
+	}
+	if filter.SourceSelector != "" {
+		if _, err := selectors.get(filter.SourceSelector); err != nil {
+			return fmt.Errorf("invalid source selector %q: %w", filter.SourceSelector, err)
+		}
+	}
+	if filter.DestSelector != "" {
+		if _, err := selectors.get(filter.DestSelector); err != nil {
+			return fmt.Errorf("invalid destination selector %q: %w", filter.DestSelector, err)
+		}
+	}
+	return nil
+}
+
+// HasLabelSelectors returns true if the given filter includes any label selectors. Label selectors
+// are evaluated separately from the rest of the filter, since labels are not part of the FlowKey.
+func HasLabelSelectors(filter *proto.Filter) bool {
+	return filter != nil && (filter.SourceSelector != "" || filter.DestSelector != "")
+}
+
+// MatchesLabels returns true if the given source and destination labels match the label selectors
+// in the given filter.
+func MatchesLabels(filter *proto.Filter, sourceLabels, destLabels unique.Handle[string]) bool {
+	if filter == nil {
+		// No filter provided - all Flows match.
+		return true
+	}
+
+	comps := []matcher{
+		&selectorComparison{selector: filter.SourceSelector, labels: sourceLabels},
+		&selectorComparison{selector: filter.DestSelector, labels: destLabels},
+	}
+	for _, c := range comps {
+		if !c.matches() {
+			return false
+		}
+	}
+	return true
+}
+
 type matcher interface {
 	matches() bool
 }
@@ -207,3 +257,64 @@ func (c policyComparison) filterMatches(h *proto.PolicyHit, filter *proto.Policy
 
 	return true
 }
+
+type selectorComparison struct {
+	selector string
+	labels   unique.Handle[string]
+}
+
+func (c *selectorComparison) matches() bool {
+	if c.selector == "" {
+		// No filter value specified, so this comparison matches.
+		return true
+	}
+	sel, err := selectors.get(c.selector)
+	if err != nil {
+		// Selectors are validated when the request is received, so this should never happen.
+		logrus.WithError(err).WithField("selector", c.selector).Warn("Invalid selector in filter")
+		return false
+	}
+	return sel.Evaluate(LabelMap(c.labels))
+}
+
+// LabelMap converts the compact label representation stored on Flows into a map of label key to value.
+func LabelMap(labels unique.Handle[string]) map[string]string {
+	m := map[string]string{}
+	for _, l := range fromHandles(labels) {
+		k, v, _ := strings.Cut(l, "=")
+		m[k] = v
+	}
+	return m
+}
+
+// maxCachedSelectors is the maximum number of parsed selectors to hold in the cache.
+const maxCachedSelectors = 1000
+
+// selectors caches parsed selectors, since the same filter is typically evaluated against a large number of
+// flows and parsing is comparatively expensive.
+var selectors = &selectorCache{cache: map[string]selector.Selector{}}
+
+type selectorCache struct {
+	sync.Mutex
+	cache map[string]selector.Selector
+}
+
+func (c *selectorCache) get(s string) (selector.Selector, error) {
+	c.Lock()
+	defer c.Unlock()
+	if sel, ok := c.cache[s]; ok {
+		return sel, nil
+	}
+
+	sel, err := selector.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.cache) >= maxCachedSelectors {
+		// Rather than track usage, simply start afresh. Selectors are cheap enough to re-parse
+		// that this is not worth optimizing further.
+		c.cache = map[string]selector.Selector{}
+	}
+	c.cache[s] = sel
+	return sel, nil
+}
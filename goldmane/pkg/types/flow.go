@@ -102,6 +102,10 @@ func (k *FlowKey) SourceNamespace() string {
 	return k.source.Value().SourceNamespace
 }
 
+func (k *FlowKey) SourceType() proto.EndpointType {
+	return k.source.Value().SourceType
+}
+
 func (k *FlowKey) DestName() string {
 	return k.dest.Value().DestName
 }
@@ -110,6 +114,10 @@ func (k *FlowKey) DestNamespace() string {
 	return k.dest.Value().DestNamespace
 }
 
+func (k *FlowKey) DestType() proto.EndpointType {
+	return k.dest.Value().DestType
+}
+
 func (k *FlowKey) DestPort() int64 {
 	return k.dest.Value().DestPort
 }
@@ -20,9 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Flows_List_FullMethodName        = "/goldmane.Flows/List"
-	Flows_Stream_FullMethodName      = "/goldmane.Flows/Stream"
-	Flows_FilterHints_FullMethodName = "/goldmane.Flows/FilterHints"
+	Flows_List_FullMethodName                  = "/goldmane.Flows/List"
+	Flows_Stream_FullMethodName                = "/goldmane.Flows/Stream"
+	Flows_FilterHints_FullMethodName           = "/goldmane.Flows/FilterHints"
+	Flows_Graph_FullMethodName                 = "/goldmane.Flows/Graph"
+	Flows_TopTalkers_FullMethodName            = "/goldmane.Flows/TopTalkers"
+	Flows_PolicyRecommendations_FullMethodName = "/goldmane.Flows/PolicyRecommendations"
 )
 
 // FlowsClient is the client API for Flows service.
@@ -43,6 +46,16 @@ type FlowsClient interface {
 	// other filters. i.e., return the flow destinations given a source namespace.
 	// Note that this API provides hints to the UI based on past flows and other values may be valid.
 	FilterHints(ctx context.Context, in *FilterHintsRequest, opts ...grpc.CallOption) (*FilterHintsResult, error)
+	// Graph returns a graph of the communication between endpoints within a time window, built from
+	// the aggregated Flows. Nodes represent endpoints or namespaces, depending on the requested granularity,
+	// and edges carry the traffic statistics between them.
+	Graph(ctx context.Context, in *GraphRequest, opts ...grpc.CallOption) (*GraphResult, error)
+	// TopTalkers returns the top N sources, destinations, namespace pairs or ports within a time window, ranked
+	// by the requested traffic statistic.
+	TopTalkers(ctx context.Context, in *TopTalkersRequest, opts ...grpc.CallOption) (*TopTalkersResult, error)
+	// PolicyRecommendations returns staged policies that would allow the traffic observed within a time window,
+	// for the endpoints in a namespace.
+	PolicyRecommendations(ctx context.Context, in *PolicyRecommendationRequest, opts ...grpc.CallOption) (*PolicyRecommendationResult, error)
 }
 
 type flowsClient struct {
@@ -92,6 +105,36 @@ func (c *flowsClient) FilterHints(ctx context.Context, in *FilterHintsRequest, o
 	return out, nil
 }
 
+func (c *flowsClient) Graph(ctx context.Context, in *GraphRequest, opts ...grpc.CallOption) (*GraphResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphResult)
+	err := c.cc.Invoke(ctx, Flows_Graph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowsClient) TopTalkers(ctx context.Context, in *TopTalkersRequest, opts ...grpc.CallOption) (*TopTalkersResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopTalkersResult)
+	err := c.cc.Invoke(ctx, Flows_TopTalkers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowsClient) PolicyRecommendations(ctx context.Context, in *PolicyRecommendationRequest, opts ...grpc.CallOption) (*PolicyRecommendationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyRecommendationResult)
+	err := c.cc.Invoke(ctx, Flows_PolicyRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FlowsServer is the server API for Flows service.
 // All implementations must embed UnimplementedFlowsServer
 // for forward compatibility.
@@ -110,6 +153,16 @@ type FlowsServer interface {
 	// other filters. i.e., return the flow destinations given a source namespace.
 	// Note that this API provides hints to the UI based on past flows and other values may be valid.
 	FilterHints(context.Context, *FilterHintsRequest) (*FilterHintsResult, error)
+	// Graph returns a graph of the communication between endpoints within a time window, built from
+	// the aggregated Flows. Nodes represent endpoints or namespaces, depending on the requested granularity,
+	// and edges carry the traffic statistics between them.
+	Graph(context.Context, *GraphRequest) (*GraphResult, error)
+	// TopTalkers returns the top N sources, destinations, namespace pairs or ports within a time window, ranked
+	// by the requested traffic statistic.
+	TopTalkers(context.Context, *TopTalkersRequest) (*TopTalkersResult, error)
+	// PolicyRecommendations returns staged policies that would allow the traffic observed within a time window,
+	// for the endpoints in a namespace.
+	PolicyRecommendations(context.Context, *PolicyRecommendationRequest) (*PolicyRecommendationResult, error)
 	mustEmbedUnimplementedFlowsServer()
 }
 
@@ -129,6 +182,15 @@ func (UnimplementedFlowsServer) Stream(*FlowStreamRequest, grpc.ServerStreamingS
 func (UnimplementedFlowsServer) FilterHints(context.Context, *FilterHintsRequest) (*FilterHintsResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterHints not implemented")
 }
+func (UnimplementedFlowsServer) Graph(context.Context, *GraphRequest) (*GraphResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Graph not implemented")
+}
+func (UnimplementedFlowsServer) TopTalkers(context.Context, *TopTalkersRequest) (*TopTalkersResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopTalkers not implemented")
+}
+func (UnimplementedFlowsServer) PolicyRecommendations(context.Context, *PolicyRecommendationRequest) (*PolicyRecommendationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyRecommendations not implemented")
+}
 func (UnimplementedFlowsServer) mustEmbedUnimplementedFlowsServer() {}
 func (UnimplementedFlowsServer) testEmbeddedByValue()               {}
 
@@ -197,6 +259,60 @@ func _Flows_FilterHints_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Flows_Graph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowsServer).Graph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flows_Graph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowsServer).Graph(ctx, req.(*GraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flows_TopTalkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopTalkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowsServer).TopTalkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flows_TopTalkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowsServer).TopTalkers(ctx, req.(*TopTalkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flows_PolicyRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowsServer).PolicyRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flows_PolicyRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowsServer).PolicyRecommendations(ctx, req.(*PolicyRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Flows_ServiceDesc is the grpc.ServiceDesc for Flows service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -212,6 +328,18 @@ var Flows_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FilterHints",
 			Handler:    _Flows_FilterHints_Handler,
 		},
+		{
+			MethodName: "Graph",
+			Handler:    _Flows_Graph_Handler,
+		},
+		{
+			MethodName: "TopTalkers",
+			Handler:    _Flows_TopTalkers_Handler,
+		},
+		{
+			MethodName: "PolicyRecommendations",
+			Handler:    _Flows_PolicyRecommendations_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -449,3 +577,116 @@ var Statistics_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "api.proto",
 }
+
+const (
+	Alerts_Stream_FullMethodName = "/goldmane.Alerts/Stream"
+)
+
+// AlertsClient is the client API for Alerts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Alerts provides a stream of alerts raised when goldmane detects unusual network activity, such as
+// a sudden increase in denied traffic.
+type AlertsClient interface {
+	// Stream returns a long running stream of Alerts as they are raised.
+	Stream(ctx context.Context, in *AlertStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Alert], error)
+}
+
+type alertsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertsClient(cc grpc.ClientConnInterface) AlertsClient {
+	return &alertsClient{cc}
+}
+
+func (c *alertsClient) Stream(ctx context.Context, in *AlertStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Alert], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Alerts_ServiceDesc.Streams[0], Alerts_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AlertStreamRequest, Alert]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Alerts_StreamClient = grpc.ServerStreamingClient[Alert]
+
+// AlertsServer is the server API for Alerts service.
+// All implementations must embed UnimplementedAlertsServer
+// for forward compatibility.
+//
+// Alerts provides a stream of alerts raised when goldmane detects unusual network activity, such as
+// a sudden increase in denied traffic.
+type AlertsServer interface {
+	// Stream returns a long running stream of Alerts as they are raised.
+	Stream(*AlertStreamRequest, grpc.ServerStreamingServer[Alert]) error
+	mustEmbedUnimplementedAlertsServer()
+}
+
+// UnimplementedAlertsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlertsServer struct{}
+
+func (UnimplementedAlertsServer) Stream(*AlertStreamRequest, grpc.ServerStreamingServer[Alert]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedAlertsServer) mustEmbedUnimplementedAlertsServer() {}
+func (UnimplementedAlertsServer) testEmbeddedByValue()                {}
+
+// UnsafeAlertsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertsServer will
+// result in compilation errors.
+type UnsafeAlertsServer interface {
+	mustEmbedUnimplementedAlertsServer()
+}
+
+func RegisterAlertsServer(s grpc.ServiceRegistrar, srv AlertsServer) {
+	// If the following call pancis, it indicates UnimplementedAlertsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Alerts_ServiceDesc, srv)
+}
+
+func _Alerts_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertsServer).Stream(m, &grpc.GenericServerStream[AlertStreamRequest, Alert]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Alerts_StreamServer = grpc.ServerStreamingServer[Alert]
+
+// Alerts_ServiceDesc is the grpc.ServiceDesc for Alerts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Alerts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goldmane.Alerts",
+	HandlerType: (*AlertsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Alerts_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
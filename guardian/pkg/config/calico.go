@@ -14,7 +14,11 @@
 
 package config
 
-import "github.com/projectcalico/calico/guardian/pkg/server"
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/guardian/pkg/server"
+)
 
 type CalicoConfig struct {
 	Config
@@ -35,17 +39,35 @@ func (cfg *CalicoConfig) Targets() []server.Target {
 		server.WithToken(defaultTokenPath),
 		server.WithCAFile(defaultCABundlePath),
 	}
-	return []server.Target{
+	targets := []server.Target{
 		// Access to the Kubernetes API server.
-		server.MustCreateTarget("/api/", cfg.K8sEndpoint, apiServerOpts...),
-		server.MustCreateTarget("/apis/", cfg.K8sEndpoint, apiServerOpts...),
+		cfg.mustCreateTarget("/api/", cfg.K8sEndpoint, apiServerOpts...),
+		cfg.mustCreateTarget("/apis/", cfg.K8sEndpoint, apiServerOpts...),
 
 		// Access to Goldmane APIs.
-		server.MustCreateTarget(
+		cfg.mustCreateTarget(
 			"/goldmane.Statistics/List",
 			cfg.GoldmaneEndpoint,
 			server.WithCAFile(cfg.CAFile),
 			server.WithCertKeyPair(cfg.GoldmaneClientCert, cfg.GoldmaneClientKey),
 		),
 	}
+
+	// Don't silently ignore rules for a target that doesn't exist; they may well be deny rules.
+	for path := range cfg.TargetAccessRules {
+		found := false
+		for _, t := range targets {
+			found = found || t.Path == path
+		}
+		if !found {
+			logrus.Fatalf("access rules specified for unknown target path '%s'", path)
+		}
+	}
+	return targets
+}
+
+// mustCreateTarget creates a target, with the access rules configured for its path.
+func (cfg *CalicoConfig) mustCreateTarget(path, dest string, opts ...server.TargetOption) server.Target {
+	opts = append(opts, server.WithAccessRules(cfg.TargetAccessRules[path]))
+	return server.MustCreateTarget(path, dest, opts...)
 }
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/guardian/pkg/server"
+)
+
+func TestTargetAccessRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	g.Expect(os.WriteFile(tokenFile, []byte("token"), 0o600)).To(Succeed())
+	origTokenPath := defaultTokenPath
+	defaultTokenPath = tokenFile
+	defer func() { defaultTokenPath = origTokenPath }()
+
+	t.Setenv("GUARDIAN_VOLTRON_URL", "voltron:9000")
+	t.Setenv("GUARDIAN_TARGET_ACCESS_RULES", `{
+		"/api/": [
+			{"action": "Deny", "methods": ["DELETE"], "pathRegexp": "/secrets"},
+			{"action": "Allow", "pathRegexp": "^/api/v1/"}
+		],
+		"/goldmane.Statistics/List": [{"action": "deny", "pathRegexp": ".*"}]
+	}`)
+	cfg, err := NewCalicoConfig()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	rules := map[string][]server.AccessRule{}
+	for _, tgt := range cfg.Targets() {
+		rules[tgt.Path] = tgt.AccessRules
+	}
+
+	g.Expect(rules["/api/"]).To(HaveLen(2))
+	g.Expect(rules["/api/"][0].Allow).To(BeFalse())
+	g.Expect(rules["/api/"][0].Methods).To(Equal([]string{"DELETE"}))
+	g.Expect(rules["/api/"][0].PathRegexp.String()).To(Equal("/secrets"))
+	g.Expect(rules["/api/"][1].Allow).To(BeTrue())
+	g.Expect(rules["/api/"][1].Methods).To(BeEmpty())
+	g.Expect(rules["/api/"][1].PathRegexp.String()).To(Equal("^/api/v1/"))
+
+	g.Expect(rules["/apis/"]).To(BeEmpty())
+
+	g.Expect(rules["/goldmane.Statistics/List"]).To(HaveLen(1))
+	g.Expect(rules["/goldmane.Statistics/List"][0].Allow).To(BeFalse())
+}
+
+func TestTargetAccessRulesInvalid(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Setenv("GUARDIAN_VOLTRON_URL", "voltron:9000")
+	t.Setenv("GUARDIAN_TARGET_ACCESS_RULES", `{"/api/": {"action": "Deny"}}`)
+	_, err := NewCalicoConfig()
+	g.Expect(err).To(HaveOccurred())
+}
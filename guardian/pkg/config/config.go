@@ -34,14 +34,17 @@ import (
 
 	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
 	"github.com/projectcalico/calico/guardian/pkg/cryptoutils"
+	"github.com/projectcalico/calico/guardian/pkg/server"
 	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
 )
 
 const (
-	defaultTokenPath    = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	defaultCABundlePath = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
 )
 
+// defaultTokenPath is a variable, rather than a constant, so that tests can override it.
+var defaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
 const (
 	// EnvConfigPrefix represents the prefix used to load ENV variables required for startup
 	EnvConfigPrefix = "GUARDIAN"
@@ -79,6 +82,23 @@ type Config struct {
 	Listen     bool   `default:"true"`
 	ListenHost string `default:"" split_words:"true"`
 	ListenPort string `default:"8080" split_words:"true"`
+
+	// TargetAccessRules restricts which requests are proxied to each target.  It maps target paths
+	// to lists of access rules, as JSON, for example:
+	//
+	//   {"/api/": [{"action": "Deny", "pathRegexp": "/secrets"}]}
+	TargetAccessRules TargetAccessRules `split_words:"true"`
+}
+
+// TargetAccessRules maps target paths to the access rules for those targets.
+type TargetAccessRules map[string][]server.AccessRuleParam
+
+// Decode implements envconfig.Decoder.
+func (r *TargetAccessRules) Decode(value string) error {
+	if err := json.Unmarshal([]byte(value), r); err != nil {
+		return fmt.Errorf("failed to parse target access rules: %w", err)
+	}
+	return nil
 }
 
 func newConfig() (*Config, error) {
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// AccessRule allows or denies requests to a Target by method and path.
+type AccessRule struct {
+	// Allow is true for an allow rule and false for a deny rule.
+	Allow bool
+	// Methods are the HTTP methods that the rule matches.  If empty, the rule matches any method.
+	Methods []string
+	// PathRegexp, if not nil, must match the request path (before any PathReplace rewriting)
+	// for the rule to match.
+	PathRegexp *regexp.Regexp
+}
+
+// AccessRuleParam is the configuration format of an AccessRule.
+type AccessRuleParam struct {
+	// Action is "Allow" or "Deny".
+	Action string `json:"action"`
+	// Methods are the HTTP methods that the rule matches.  If empty, the rule matches any method.
+	Methods []string `json:"methods,omitempty"`
+	// PathRegexp must match the request path for the rule to match.
+	PathRegexp string `json:"pathRegexp"`
+}
+
+const (
+	AccessRuleActionAllow = "Allow"
+	AccessRuleActionDeny  = "Deny"
+)
+
+func (a AccessRule) matches(r *http.Request) bool {
+	if len(a.Methods) > 0 {
+		methodMatches := false
+		for _, m := range a.Methods {
+			if strings.EqualFold(m, r.Method) {
+				methodMatches = true
+				break
+			}
+		}
+		if !methodMatches {
+			return false
+		}
+	}
+	return a.PathRegexp == nil || a.PathRegexp.MatchString(r.URL.Path)
+}
+
+// allowed checks the request against the given rules, in order; the first rule that matches
+// decides.  If no rule matches, the request is denied if there are any allow rules (since the
+// target has an allow-list) and allowed otherwise.
+func allowed(rules []AccessRule, r *http.Request) bool {
+	haveAllowRules := false
+	for _, rule := range rules {
+		if rule.matches(r) {
+			return rule.Allow
+		}
+		haveAllowRules = haveAllowRules || rule.Allow
+	}
+	return !haveAllowRules
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	authnv1 "k8s.io/api/authentication/v1"
+
+	"github.com/projectcalico/calico/guardian/pkg/tunnel"
+)
+
+// AuditLogger is the logger that audit records are written to.  It's separate from the standard
+// logger, and always logs at Info level, so that the audit trail doesn't depend on the configured
+// log level.  It writes JSON, to keep audit records distinct from ordinary log output.
+var AuditLogger = newAuditLogger()
+
+func newAuditLogger() *log.Logger {
+	l := log.New()
+	l.SetOutput(os.Stdout)
+	l.SetLevel(log.InfoLevel)
+	l.SetFormatter(&log.JSONFormatter{})
+	return l
+}
+
+// auditRecord collects the details of a proxied request for the audit log.
+type auditRecord struct {
+	start   time.Time
+	target  string
+	method  string
+	path    string
+	user    string
+	groups  []string
+	peer    string
+	allowed bool
+}
+
+func newAuditRecord(tgt Target, r *http.Request) *auditRecord {
+	a := &auditRecord{
+		start:  time.Now(),
+		target: tgt.Path,
+		method: r.Method,
+		// Record the path that the caller asked for, before any rewriting.
+		path:   r.URL.Path,
+		user:   r.Header.Get(authnv1.ImpersonateUserHeader),
+		groups: r.Header.Values(authnv1.ImpersonateGroupHeader),
+	}
+	a.peer, _ = r.Context().Value(peerIdentityKey{}).(string)
+	return a
+}
+
+type peerIdentityKey struct{}
+
+// peerConnContext is used as the http.Server's ConnContext.  It adds the identity of the tunnel peer,
+// from the certificate that it presented for the tunnel's mTLS session, to the context of the
+// requests received over c.  c is the TLS connection that we terminate for HTTP/2, inside the tunnel,
+// so we unwrap that first.
+func peerConnContext(ctx context.Context, c net.Conn) context.Context {
+	if tlsConn, ok := c.(*tls.Conn); ok {
+		c = tlsConn.NetConn()
+	}
+	if state := tunnel.ConnectionState(c); state != nil && len(state.PeerCertificates) > 0 {
+		ctx = context.WithValue(ctx, peerIdentityKey{}, state.PeerCertificates[0].Subject.CommonName)
+	}
+	return ctx
+}
+
+func (a *auditRecord) log(status int) {
+	AuditLogger.WithFields(log.Fields{
+		"audit":     true,
+		"user":      a.user,
+		"groups":    a.groups,
+		"peer":      a.peer,
+		"target":    a.target,
+		"method":    a.method,
+		"path":      a.path,
+		"allowed":   a.allowed,
+		"status":    status,
+		"latencyMS": time.Since(a.start).Milliseconds(),
+	}).Info("Proxied request")
+}
+
+// statusRecorder records the status code written to a ResponseWriter.  Unwrap lets
+// http.ResponseController (used by the reverse proxy) get at the underlying ResponseWriter.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// Hijack is used by the reverse proxy for protocol upgrades, which it completes on the hijacked
+// connection rather than through WriteHeader.
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(s.ResponseWriter).Hijack()
+	if err == nil && s.status == 0 {
+		s.status = http.StatusSwitchingProtocols
+	}
+	return conn, brw, err
+}
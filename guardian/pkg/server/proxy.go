@@ -110,6 +110,19 @@ func newTargetHandler(tgt Target) (func(http.ResponseWriter, *http.Request), err
 
 	return func(w http.ResponseWriter, r *http.Request) {
 		logCtx := log.WithField("dst", tgt)
+
+		audit := newAuditRecord(tgt, r)
+		rec := &statusRecorder{ResponseWriter: w}
+		w = rec
+		defer func() { audit.log(rec.status) }()
+
+		if !allowed(tgt.AccessRules, r) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			logCtx.Debugf("Received request %s %s denied by access rules", r.Method, r.RequestURI)
+			return
+		}
+		audit.allowed = true
+
 		if tgt.PathRegexp != nil {
 			if !tgt.PathRegexp.MatchString(r.URL.Path) {
 				http.Error(w, "Not found", 404)
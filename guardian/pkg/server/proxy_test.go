@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/projectcalico/calico/guardian/pkg/server"
+)
+
+func newTestProxy(t *testing.T, opts ...server.TargetOption) *server.Proxy {
+	dest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(dest.Close)
+
+	proxy, err := server.NewProxy([]server.Target{
+		server.MustCreateTarget("/api/", dest.URL, opts...),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return proxy
+}
+
+func TestProxyAccessRules(t *testing.T) {
+	setupTest(t)
+
+	tt := []struct {
+		description    string
+		opts           []server.TargetOption
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			description:    "no rules allows everything",
+			method:         http.MethodDelete,
+			path:           "/api/v1/namespaces",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			description:    "request matching an allow rule is allowed",
+			opts:           []server.TargetOption{server.WithAllowRule("^/api/v1/", "get")},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			description:    "request matching no rule is denied if there are allow rules",
+			opts:           []server.TargetOption{server.WithAllowRule("^/api/v1/", "GET")},
+			method:         http.MethodPost,
+			path:           "/api/v1/namespaces",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description:    "request matching a deny rule is denied",
+			opts:           []server.TargetOption{server.WithDenyRule("/secrets")},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces/default/secrets",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description:    "request matching no rule is allowed if there are only deny rules",
+			opts:           []server.TargetOption{server.WithDenyRule("/secrets")},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces/default/pods",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			description: "first matching rule wins",
+			opts: []server.TargetOption{
+				server.WithDenyRule("/secrets", "GET"),
+				server.WithAllowRule("^/api/"),
+			},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces/default/secrets",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description: "later rule matches if earlier rule's method doesn't",
+			opts: []server.TargetOption{
+				server.WithDenyRule("/secrets", "DELETE"),
+				server.WithAllowRule("^/api/"),
+			},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces/default/secrets",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			description: "rules from config are applied in order",
+			opts: []server.TargetOption{server.WithAccessRules([]server.AccessRuleParam{
+				{Action: "Deny", Methods: []string{"GET"}, PathRegexp: "/secrets"},
+				{Action: "allow", PathRegexp: "^/api/"},
+			})},
+			method:         http.MethodGet,
+			path:           "/api/v1/namespaces/default/secrets",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			setupTest(t)
+			proxy := newTestProxy(t, tc.opts...)
+
+			w := httptest.NewRecorder()
+			proxy.ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, nil))
+			Expect(w.Code).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestProxyAuditLog(t *testing.T) {
+	setupTest(t)
+	hook := logtest.NewLocal(server.AuditLogger)
+	defer hook.Reset()
+
+	proxy := newTestProxy(t, server.WithDenyRule("/secrets"))
+
+	auditEntries := func() []*logrus.Entry {
+		var entries []*logrus.Entry
+		for _, e := range hook.AllEntries() {
+			if e.Data["audit"] == true {
+				entries = append(entries, e)
+			}
+		}
+		return entries
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil)
+	req.Header.Set("Impersonate-User", "jane")
+	req.Header.Add("Impersonate-Group", "admins")
+	req.Header.Add("Impersonate-Group", "system:authenticated")
+	proxy.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := auditEntries()
+	Expect(entries).To(HaveLen(1))
+	Expect(entries[0].Data).To(HaveKeyWithValue("user", "jane"))
+	Expect(entries[0].Data).To(HaveKeyWithValue("groups", []string{"admins", "system:authenticated"}))
+	Expect(entries[0].Data).To(HaveKeyWithValue("target", "/api/"))
+	Expect(entries[0].Data).To(HaveKeyWithValue("method", http.MethodGet))
+	Expect(entries[0].Data).To(HaveKeyWithValue("path", "/api/v1/namespaces"))
+	Expect(entries[0].Data).To(HaveKeyWithValue("allowed", true))
+	Expect(entries[0].Data).To(HaveKeyWithValue("status", http.StatusOK))
+	Expect(entries[0].Data).To(HaveKey("latencyMS"))
+
+	hook.Reset()
+	proxy.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/secrets", nil))
+	entries = auditEntries()
+	Expect(entries).To(HaveLen(1))
+	Expect(entries[0].Data).To(HaveKeyWithValue("allowed", false))
+	Expect(entries[0].Data).To(HaveKeyWithValue("status", http.StatusForbidden))
+}
+
+func TestProxyAuditLogIgnoresLogLevel(t *testing.T) {
+	setupTest(t)
+	hook := logtest.NewLocal(server.AuditLogger)
+	defer hook.Reset()
+
+	logrus.SetLevel(logrus.WarnLevel)
+	defer logrus.SetLevel(logrus.DebugLevel)
+
+	proxy := newTestProxy(t)
+	proxy.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil))
+	Expect(hook.AllEntries()).To(HaveLen(1))
+	Expect(hook.LastEntry().Data).To(HaveKeyWithValue("path", "/api/v1/namespaces"))
+}
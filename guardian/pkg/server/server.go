@@ -77,6 +77,7 @@ func New(shutdownCtx context.Context, tunnelCert *tls.Certificate, dialer tunnel
 
 	srv.proxyMux = http.NewServeMux()
 	srv.http.Handler = srv.proxyMux
+	srv.http.ConnContext = peerConnContext
 
 	handler, err := NewProxy(srv.targets)
 	if err != nil {
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	. "github.com/onsi/gomega"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/projectcalico/calico/guardian/pkg/server"
+	"github.com/projectcalico/calico/guardian/pkg/tunnel"
+)
+
+// newCert returns a self-signed certificate for the given name, and a pool containing it.
+func newCert(name string) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}, pool
+}
+
+func TestAuditLogTunnelPeer(t *testing.T) {
+	setupTest(t)
+	hook := logtest.NewLocal(server.AuditLogger)
+	defer hook.Reset()
+
+	voltronCert, voltronPool := newCert("voltron")
+	guardianCert, guardianPool := newCert("guardian")
+
+	// Play the part of Voltron: accept the tunnel, then send a request over it.
+	voltronListener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{voltronCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    guardianPool,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	defer voltronListener.Close()
+
+	dest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer dest.Close()
+
+	dialer, err := tunnel.NewTLSSessionDialer(voltronListener.Addr().String(), &tls.Config{
+		Certificates: []tls.Certificate{guardianCert},
+		RootCAs:      voltronPool,
+		ServerName:   "voltron",
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv, err := server.New(ctx, &guardianCert, dialer,
+		server.WithProxyTargets([]server.Target{server.MustCreateTarget("/api/", dest.URL)}))
+	Expect(err).NotTo(HaveOccurred())
+	go func() {
+		_ = srv.ListenAndServeManagementCluster()
+	}()
+
+	tunnelConn, err := voltronListener.Accept()
+	Expect(err).NotTo(HaveOccurred())
+	session, err := yamux.Server(tunnelConn, nil)
+	Expect(err).NotTo(HaveOccurred())
+	defer session.Close()
+	stream, err := session.Open()
+	Expect(err).NotTo(HaveOccurred())
+	conn := tls.Client(stream, &tls.Config{RootCAs: guardianPool, ServerName: "guardian"})
+	defer conn.Close()
+
+	req, err := http.NewRequest(http.MethodGet, "https://guardian/api/v1/namespaces", nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(req.Write(conn)).To(Succeed())
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	// The request is audited once the proxy has handled it, which may be after we get the response.
+	auditData := func() []map[string]any {
+		var data []map[string]any
+		for _, e := range hook.AllEntries() {
+			if e.Data["audit"] == true {
+				data = append(data, e.Data)
+			}
+		}
+		return data
+	}
+	Eventually(auditData).Should(HaveLen(1))
+	Expect(auditData()[0]).To(HaveKeyWithValue("peer", "voltron"))
+	Expect(auditData()[0]).To(HaveKeyWithValue("path", "/api/v1/namespaces"))
+}
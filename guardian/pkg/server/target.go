@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
@@ -55,6 +56,10 @@ type TargetParam struct {
 	ClientKeyPath  string `json:"clientKeyPath"`
 
 	Unauthenticated bool `json:"unauthenticated,omitempty"`
+
+	// AccessRules, if not empty, restrict which requests are proxied to the target.  They are
+	// checked in order; see WithAllowRule and WithDenyRule.
+	AccessRules []AccessRuleParam `json:"accessRules,omitempty"`
 }
 
 // Target describes which path is proxied to what destination URL
@@ -79,6 +84,10 @@ type Target struct {
 	// Configures client key and certificate for mTLS from Voltron with the target.
 	ClientKeyPath  string
 	ClientCertPath string
+
+	// AccessRules, if not empty, restrict which requests are proxied to the target.  See
+	// WithAllowRule and WithDenyRule.
+	AccessRules []AccessRule
 }
 
 type TargetOption func(*Target) error
@@ -136,6 +145,56 @@ func WithCertKeyPair(certPath, keyPath string) TargetOption {
 	}
 }
 
+// WithAllowRule adds a rule that allows requests with one of the given methods (or any method, if
+// none are given) and a path matching pathReg.  Rules are checked in the order they were added and
+// the first match decides.  Once a target has an allow rule, requests that match no rule are denied.
+func WithAllowRule(pathReg string, methods ...string) TargetOption {
+	return withAccessRule(true, pathReg, methods)
+}
+
+// WithDenyRule adds a rule that denies requests with one of the given methods (or any method, if
+// none are given) and a path matching pathReg.  See WithAllowRule for how rules are checked.
+func WithDenyRule(pathReg string, methods ...string) TargetOption {
+	return withAccessRule(false, pathReg, methods)
+}
+
+// WithAccessRules adds the given rules, in order, as WithAllowRule and WithDenyRule would.
+func WithAccessRules(rules []AccessRuleParam) TargetOption {
+	return func(t *Target) error {
+		for _, rule := range rules {
+			var allow bool
+			switch {
+			case strings.EqualFold(rule.Action, AccessRuleActionAllow):
+				allow = true
+			case strings.EqualFold(rule.Action, AccessRuleActionDeny):
+				allow = false
+			default:
+				return fmt.Errorf("access rule action must be %s or %s, not %q",
+					AccessRuleActionAllow, AccessRuleActionDeny, rule.Action)
+			}
+			if err := withAccessRule(allow, rule.PathRegexp, rule.Methods)(t); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func withAccessRule(allow bool, pathReg string, methods []string) TargetOption {
+	return func(t *Target) error {
+		r, err := regexp.Compile(pathReg)
+		if err != nil {
+			return fmt.Errorf("access rule path regexp failed: %s", err)
+		}
+		t.AccessRules = append(t.AccessRules, AccessRule{
+			Allow:      allow,
+			Methods:    methods,
+			PathRegexp: r,
+		})
+		return nil
+	}
+}
+
 func MustCreateTarget(path, dest string, opts ...TargetOption) Target {
 	if path == "" {
 		logrus.Fatal("proxy target path cannot be empty")
@@ -131,9 +131,49 @@ func (d *sessionDialer) Dial() (Session, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed creating muxer: %s", err)
 	}
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		return &tlsSession{Session: session, state: tlsConn.ConnectionState()}, nil
+	}
 	return session, nil
 }
 
+// tlsSession is a Session over a TLS connection.  The connections that it opens and accepts carry
+// the state of that TLS connection, see ConnectionState.
+type tlsSession struct {
+	*yamux.Session
+	state tls.ConnectionState
+}
+
+func (s *tlsSession) Open() (net.Conn, error) {
+	c, err := s.Session.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &tlsSessionConn{Conn: c, state: &s.state}, nil
+}
+
+func (s *tlsSession) Accept() (net.Conn, error) {
+	c, err := s.Session.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &tlsSessionConn{Conn: c, state: &s.state}, nil
+}
+
+type tlsSessionConn struct {
+	net.Conn
+	state *tls.ConnectionState
+}
+
+// ConnectionState returns the state of the TLS connection that the tunnel runs over, for a connection
+// opened or accepted over the tunnel.  It returns nil if the tunnel doesn't use TLS.
+func ConnectionState(c net.Conn) *tls.ConnectionState {
+	if c, ok := c.(*tlsSessionConn); ok {
+		return c.state
+	}
+	return nil
+}
+
 // DialTLS creates a TLS connection based on the config, must not be nil.
 func (d *sessionDialer) dialTLS() (net.Conn, error) {
 	logrus.Infof("Starting TLS dial to %s with a timeout of %v", d.addr, d.timeout)
@@ -38,11 +38,13 @@ import (
 	"github.com/projectcalico/calico/kube-controllers/pkg/config"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/controller"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/flannelmigration"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/ippooldrain"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/loadbalancer"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/namespace"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/networkpolicy"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/node"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/pod"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/routereflector"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/serviceaccount"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/utils"
 	"github.com/projectcalico/calico/kube-controllers/pkg/status"
@@ -465,6 +467,17 @@ func (cc *controllerControl) InitControllers(ctx context.Context, cfg config.Run
 		cc.controllers["LoadBalancer"] = loadBalancerController
 		cc.registerInformers(serviceInformer)
 	}
+
+	if cfg.Controllers.RouteReflector != nil {
+		routeReflectorController := routereflector.NewRouteReflectorController(ctx, calicoClient, *cfg.Controllers.RouteReflector, nodeInformer, dataFeed)
+		cc.controllers["RouteReflector"] = routeReflectorController
+		cc.registerInformers(nodeInformer)
+	}
+
+	if cfg.Controllers.IPPoolDrain != nil {
+		ipPoolDrainController := ippooldrain.NewIPPoolDrainController(ctx, k8sClientset, calicoClient, *cfg.Controllers.IPPoolDrain, dataFeed)
+		cc.controllers["IPPoolDrain"] = ipPoolDrainController
+	}
 }
 
 // registerInformers registers the given informers, if not already registered. Registered informers
@@ -498,7 +511,7 @@ func (cc *controllerControl) RunControllers(dataFeed *utils.DataFeed, cfg config
 		go c.Run(cc.stop)
 	}
 
-	if cfg.Controllers.Node != nil || cfg.Controllers.LoadBalancer != nil {
+	if cfg.Controllers.Node != nil || cfg.Controllers.LoadBalancer != nil || cfg.Controllers.RouteReflector != nil || cfg.Controllers.IPPoolDrain != nil {
 		// Start dataFeed for controllers that need it
 		dataFeed.Start()
 	}
@@ -148,6 +148,8 @@ var _ = Describe("Config", func() {
 				Expect(rc.LoadBalancer).To(Equal(&config.LoadBalancerControllerConfig{
 					AssignIPs: v3.AllServices,
 				}))
+				Expect(rc.RouteReflector).To(BeNil())
+				Expect(rc.IPPoolDrain).To(BeNil())
 				close(done)
 			})
 
@@ -188,6 +190,7 @@ var _ = Describe("Config", func() {
 			var cancel context.CancelFunc
 
 			BeforeEach(func() {
+				reflectorsPerZone := 2
 				kcc := v3.NewKubeControllersConfiguration()
 				kcc.Name = "default"
 				kcc.Spec = v3.KubeControllersConfigurationSpec{
@@ -212,6 +215,14 @@ var _ = Describe("Config", func() {
 						LoadBalancer: &v3.LoadBalancerControllerConfig{
 							AssignIPs: v3.RequestedServicesOnly,
 						},
+						RouteReflector: &v3.RouteReflectorControllerConfig{
+							NodeSelector:      "has(rr-capable)",
+							ZoneLabel:         "rack",
+							ReflectorsPerZone: &reflectorsPerZone,
+						},
+						IPPoolDrain: &v3.IPPoolDrainControllerConfig{
+							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 30},
+						},
 					},
 				}
 				m = &mockKCC{get: kcc}
@@ -258,6 +269,14 @@ var _ = Describe("Config", func() {
 				Expect(rc.LoadBalancer).To(Equal(&config.LoadBalancerControllerConfig{
 					AssignIPs: v3.RequestedServicesOnly,
 				}))
+				Expect(rc.RouteReflector).To(Equal(&config.RouteReflectorControllerConfig{
+					NodeSelector:      "has(rr-capable)",
+					ZoneLabel:         "rack",
+					ReflectorsPerZone: 2,
+				}))
+				Expect(rc.IPPoolDrain).To(Equal(&config.GenericControllerConfig{
+					ReconcilerPeriod: time.Second * 30,
+				}))
 				close(done)
 			})
 
@@ -679,6 +698,74 @@ var _ = Describe("Config", func() {
 			close(done)
 		})
 	})
+
+	Context("with ENABLED_CONTROLLERS including the route reflector controller", func() {
+
+		BeforeEach(func() {
+			unsetEnv()
+			err := os.Setenv("ENABLED_CONTROLLERS", "node,routereflector")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			unsetEnv()
+		})
+
+		It("should use route reflector defaults", func(done Done) {
+			cfg := new(config.Config)
+			err := cfg.Parse()
+			Expect(err).ToNot(HaveOccurred())
+			m := &mockKCC{get: config.DefaultKCC.DeepCopy()}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ctrl := config.NewRunConfigController(ctx, *cfg, m)
+			runCfg := <-ctrl.ConfigChan()
+			Expect(runCfg.Controllers.RouteReflector).To(Equal(&config.RouteReflectorControllerConfig{
+				NodeSelector:      "all()",
+				ZoneLabel:         "topology.kubernetes.io/zone",
+				ReflectorsPerZone: 3,
+			}))
+
+			reflectorsPerZone := 3
+			Expect(m.update.Status.RunningConfig.Controllers.RouteReflector).To(Equal(&v3.RouteReflectorControllerConfig{
+				NodeSelector:      "all()",
+				ZoneLabel:         "topology.kubernetes.io/zone",
+				ReflectorsPerZone: &reflectorsPerZone,
+			}))
+			close(done)
+		})
+	})
+
+	Context("with ENABLED_CONTROLLERS including the IP pool drain controller", func() {
+
+		BeforeEach(func() {
+			unsetEnv()
+			err := os.Setenv("ENABLED_CONTROLLERS", "node,ippooldrain")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			unsetEnv()
+		})
+
+		It("should use the default reconciler period", func(done Done) {
+			cfg := new(config.Config)
+			err := cfg.Parse()
+			Expect(err).ToNot(HaveOccurred())
+			m := &mockKCC{get: config.DefaultKCC.DeepCopy()}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ctrl := config.NewRunConfigController(ctx, *cfg, m)
+			runCfg := <-ctrl.ConfigChan()
+			Expect(runCfg.Controllers.IPPoolDrain).To(Equal(&config.GenericControllerConfig{
+				ReconcilerPeriod: time.Minute,
+			}))
+			Expect(m.update.Status.RunningConfig.Controllers.IPPoolDrain).To(Equal(&v3.IPPoolDrainControllerConfig{
+				ReconcilerPeriod: &v1.Duration{Duration: time.Minute},
+			}))
+			close(done)
+		})
+	})
 })
 
 type mockKCC struct {
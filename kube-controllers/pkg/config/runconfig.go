@@ -25,6 +25,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
@@ -95,6 +96,8 @@ type ControllersConfig struct {
 	ServiceAccount   *GenericControllerConfig
 	Namespace        *GenericControllerConfig
 	LoadBalancer     *LoadBalancerControllerConfig
+	RouteReflector   *RouteReflectorControllerConfig
+	IPPoolDrain      *GenericControllerConfig
 }
 
 type GenericControllerConfig struct {
@@ -133,6 +136,17 @@ type LoadBalancerControllerConfig struct {
 	AssignIPs v3.AssignIPs
 }
 
+type RouteReflectorControllerConfig struct {
+	// NodeSelector selects the nodes that may be chosen as route reflectors.
+	NodeSelector string
+
+	// ZoneLabel is the node label used to group nodes into zones.
+	ZoneLabel string
+
+	// ReflectorsPerZone is the number of route reflectors to maintain in each zone.
+	ReflectorsPerZone int
+}
+
 type RunConfigController struct {
 	out chan RunConfig
 }
@@ -381,6 +395,10 @@ func mergeConfig(envVars map[string]string, envCfg Config, apiCfg v3.KubeControl
 		rc.Namespace.NumberOfWorkers = envCfg.ProfileWorkers
 	}
 
+	if rc.RouteReflector != nil {
+		mergeRouteReflector(&status, &rCfg, apiCfg)
+	}
+
 	if rc.LoadBalancer != nil {
 		if apiCfg.Controllers.LoadBalancer != nil {
 			rc.LoadBalancer.AssignIPs = apiCfg.Controllers.LoadBalancer.AssignIPs
@@ -391,6 +409,35 @@ func mergeConfig(envVars map[string]string, envCfg Config, apiCfg v3.KubeControl
 	return rCfg, status
 }
 
+func mergeRouteReflector(status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
+	// make these names shorter
+	rc := &rCfg.Controllers
+	ac := &apiCfg.Controllers
+	sc := &status.RunningConfig.Controllers
+
+	// There is no env var config for these, so start from the defaults and
+	// merge in anything set on the API.
+	rc.RouteReflector.NodeSelector = "all()"
+	rc.RouteReflector.ZoneLabel = corev1.LabelTopologyZone
+	rc.RouteReflector.ReflectorsPerZone = 3
+	if ac.RouteReflector != nil {
+		if ac.RouteReflector.NodeSelector != "" {
+			rc.RouteReflector.NodeSelector = ac.RouteReflector.NodeSelector
+		}
+		if ac.RouteReflector.ZoneLabel != "" {
+			rc.RouteReflector.ZoneLabel = ac.RouteReflector.ZoneLabel
+		}
+		if ac.RouteReflector.ReflectorsPerZone != nil {
+			rc.RouteReflector.ReflectorsPerZone = *ac.RouteReflector.ReflectorsPerZone
+		}
+	}
+
+	reflectorsPerZone := rc.RouteReflector.ReflectorsPerZone
+	sc.RouteReflector.NodeSelector = rc.RouteReflector.NodeSelector
+	sc.RouteReflector.ZoneLabel = rc.RouteReflector.ZoneLabel
+	sc.RouteReflector.ReflectorsPerZone = &reflectorsPerZone
+}
+
 func mergeAutoHostEndpoints(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
 	// make these names shorter
 	rc := &rCfg.Controllers
@@ -578,6 +625,10 @@ func mergeReconcilerPeriod(envVars map[string]string, status *v3.KubeControllers
 			rc.Namespace.ReconcilerPeriod = d
 			sc.Namespace.ReconcilerPeriod = &v1.Duration{Duration: d}
 		}
+		if rc.IPPoolDrain != nil {
+			rc.IPPoolDrain.ReconcilerPeriod = d
+			sc.IPPoolDrain.ReconcilerPeriod = &v1.Duration{Duration: d}
+		}
 	}
 }
 
@@ -592,6 +643,8 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 	s := ac.ServiceAccount
 	ns := ac.Namespace
 	lb := ac.LoadBalancer
+	rr := ac.RouteReflector
+	ipd := ac.IPPoolDrain
 
 	v, p := envVars[EnvEnabledControllers]
 	if p {
@@ -616,6 +669,12 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			case "loadbalancer":
 				rc.LoadBalancer = &LoadBalancerControllerConfig{}
 				sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
+			case "routereflector":
+				rc.RouteReflector = &RouteReflectorControllerConfig{}
+				sc.RouteReflector = &v3.RouteReflectorControllerConfig{}
+			case "ippooldrain":
+				rc.IPPoolDrain = &GenericControllerConfig{}
+				sc.IPPoolDrain = &v3.IPPoolDrainControllerConfig{}
 			case "flannelmigration":
 				log.WithField(EnvEnabledControllers, v).Fatal("cannot run flannelmigration with other controllers")
 			default:
@@ -659,6 +718,16 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			rc.LoadBalancer = &LoadBalancerControllerConfig{}
 			sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
 		}
+
+		if rr != nil {
+			rc.RouteReflector = &RouteReflectorControllerConfig{}
+			sc.RouteReflector = &v3.RouteReflectorControllerConfig{}
+		}
+
+		if ipd != nil {
+			rc.IPPoolDrain = &GenericControllerConfig{}
+			sc.IPPoolDrain = &v3.IPPoolDrainControllerConfig{}
+		}
 	}
 
 	// Set reconciler periods, if enabled
@@ -694,6 +763,16 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 		}
 		sc.ServiceAccount.ReconcilerPeriod = s.ReconcilerPeriod
 	}
+	if rc.IPPoolDrain != nil {
+		// The IP pool drain controller is disabled by default, so it may have been enabled
+		// by the environment without any API config.
+		if ipd == nil || ipd.ReconcilerPeriod == nil {
+			rc.IPPoolDrain.ReconcilerPeriod = time.Minute
+		} else {
+			rc.IPPoolDrain.ReconcilerPeriod = ipd.ReconcilerPeriod.Duration
+		}
+		sc.IPPoolDrain.ReconcilerPeriod = &v1.Duration{Duration: rc.IPPoolDrain.ReconcilerPeriod}
+	}
 }
 
 func mergeLogLevel(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
@@ -0,0 +1,482 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippooldrain
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/utils"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+const (
+	batchUpdateSize = 1000
+
+	defaultEvictionBatchSize = 10
+	defaultEvictionInterval  = time.Minute
+)
+
+// podAllocation is an address in a draining pool that is held by a pod.
+type podAllocation struct {
+	namespace string
+	name      string
+	ip        string
+}
+
+// ipPoolDrainController implements the Controller interface. It migrates workloads off IP pools that
+// have drain set, by releasing empty blocks and evicting the pods that still hold addresses in the pool.
+type ipPoolDrainController struct {
+	ctx           context.Context
+	calicoClient  client.Interface
+	clientSet     kubernetes.Interface
+	cfg           config.GenericControllerConfig
+	syncerUpdates chan interface{}
+	syncStatus    bapi.SyncStatus
+	syncChan      chan interface{}
+	pools         map[string]*api.IPPool
+	blocks        map[string]*model.AllocationBlock
+
+	// nextEviction is when the next eviction batch is due in any draining pool, or zero if none
+	// is pending.  evictionTimer fires then so that evictions don't depend on the periodic sync.
+	nextEviction  time.Time
+	evictionTimer *time.Timer
+}
+
+// NewIPPoolDrainController returns a controller which drains IP pools.
+func NewIPPoolDrainController(ctx context.Context, clientset kubernetes.Interface, calicoClient client.Interface, cfg config.GenericControllerConfig, dataFeed *utils.DataFeed) *ipPoolDrainController {
+	c := &ipPoolDrainController{
+		ctx:           ctx,
+		calicoClient:  calicoClient,
+		clientSet:     clientset,
+		cfg:           cfg,
+		syncerUpdates: make(chan interface{}, batchUpdateSize),
+		syncChan:      make(chan interface{}, 1),
+		pools:         make(map[string]*api.IPPool),
+		blocks:        make(map[string]*model.AllocationBlock),
+	}
+
+	c.RegisterWith(dataFeed)
+	return c
+}
+
+// Run starts the controller.
+func (c *ipPoolDrainController) Run(stopCh chan struct{}) {
+	defer uruntime.HandleCrash()
+
+	go c.acceptScheduledRequests(stopCh)
+
+	<-stopCh
+	log.Info("Stopping IP pool drain controller")
+}
+
+func (c *ipPoolDrainController) RegisterWith(f *utils.DataFeed) {
+	f.RegisterForNotification(model.BlockKey{}, c.onUpdate)
+	f.RegisterForNotification(model.ResourceKey{}, c.onUpdate)
+	f.RegisterForSyncStatus(c.onStatusUpdate)
+}
+
+func (c *ipPoolDrainController) onStatusUpdate(s bapi.SyncStatus) {
+	c.syncerUpdates <- s
+}
+
+func (c *ipPoolDrainController) onUpdate(update bapi.Update) {
+	switch update.KVPair.Key.(type) {
+	case model.ResourceKey:
+		switch update.KVPair.Key.(model.ResourceKey).Kind {
+		case api.KindIPPool:
+			c.syncerUpdates <- update.KVPair
+		}
+	case model.BlockKey:
+		c.syncerUpdates <- update.KVPair
+	}
+}
+
+func (c *ipPoolDrainController) acceptScheduledRequests(stopCh <-chan struct{}) {
+	// As for the other controllers, a ReconcilerPeriod of 0 disables the periodic sync; we still
+	// sync on pool spec changes, on block changes in draining pools, and when an eviction batch
+	// is due.
+	var tickC <-chan time.Time
+	if c.cfg.ReconcilerPeriod > 0 {
+		log.Infof("Will run periodic IP pool drain sync every %s", c.cfg.ReconcilerPeriod)
+		t := time.NewTicker(c.cfg.ReconcilerPeriod)
+		defer t.Stop()
+		tickC = t.C
+	} else {
+		log.Info("Periodic IP pool drain sync is disabled")
+	}
+	defer c.stopEvictionTimer()
+	for {
+		var evictionC <-chan time.Time
+		if c.evictionTimer != nil {
+			evictionC = c.evictionTimer.C
+		}
+		select {
+		case update := <-c.syncerUpdates:
+			c.handleUpdate(update)
+		case <-tickC:
+			c.syncPools()
+		case <-c.syncChan:
+			c.syncPools()
+		case <-evictionC:
+			c.evictionTimer = nil
+			c.syncPools()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (c *ipPoolDrainController) handleUpdate(update interface{}) {
+	switch update := update.(type) {
+	case bapi.SyncStatus:
+		c.syncStatus = update
+		switch update {
+		case bapi.InSync:
+			log.WithField("status", update).Info("Syncer is InSync, kicking sync channel")
+			kick(c.syncChan)
+		}
+	case model.KVPair:
+		switch key := update.Key.(type) {
+		case model.ResourceKey:
+			if c.handleIPPoolUpdate(key.Name, update) {
+				kick(c.syncChan)
+			}
+		case model.BlockKey:
+			if update.Value == nil {
+				delete(c.blocks, key.String())
+			} else {
+				c.blocks[key.String()] = update.Value.(*model.AllocationBlock)
+			}
+			if c.inDrainingPool(key.CIDR) {
+				// An address was released or a block was deleted, so the status needs updating,
+				// and an empty block may need releasing.
+				kick(c.syncChan)
+			}
+		}
+	}
+}
+
+// handleIPPoolUpdate updates the pool cache, and returns true if the pool's spec has changed. Status
+// updates are ignored so that the controller's own writes don't trigger another sync.
+func (c *ipPoolDrainController) handleIPPoolUpdate(name string, kvp model.KVPair) bool {
+	old := c.pools[name]
+	if kvp.Value == nil {
+		delete(c.pools, name)
+		return false
+	}
+
+	pool := kvp.Value.(*api.IPPool)
+	c.pools[name] = pool
+	return old == nil || !reflect.DeepEqual(old.Spec, pool.Spec)
+}
+
+// inDrainingPool returns true if the block falls within a pool that is draining.
+func (c *ipPoolDrainController) inDrainingPool(blockCIDR cnet.IPNet) bool {
+	for _, pool := range c.pools {
+		if pool.Spec.Drain == nil {
+			continue
+		}
+		_, poolCIDR, err := cnet.ParseCIDR(pool.Spec.CIDR)
+		if err == nil && poolCIDR.Contains(blockCIDR.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// syncPools reconciles every pool that is draining, or that has drain status left over from a
+// previous drain.
+func (c *ipPoolDrainController) syncPools() {
+	if c.syncStatus != bapi.InSync {
+		log.WithField("status", c.syncStatus).Debug("Have not yet received InSync notification, skipping IP pool drain sync.")
+		return
+	}
+
+	c.nextEviction = time.Time{}
+	defer c.resetEvictionTimer()
+	for _, pool := range c.pools {
+		if pool.Spec.Drain != nil {
+			if err := c.syncPool(pool); err != nil {
+				log.WithError(err).WithField("pool", pool.Name).Error("Failed to drain IP pool, will retry on next sync")
+			}
+		} else if pool.Status != nil && pool.Status.Drain != nil {
+			if err := c.updateStatus(pool.Name, nil); err != nil {
+				log.WithError(err).WithField("pool", pool.Name).Error("Failed to clear IP pool drain status")
+			}
+		}
+	}
+}
+
+// syncPool does the following for a draining pool:
+// - Releases the affinity of any empty blocks, which deletes them.
+// - Evicts the next batch of pods that hold addresses in the pool, if eviction is enabled and due.
+// - Reports the remaining allocations in the pool status.
+func (c *ipPoolDrainController) syncPool(pool *api.IPPool) error {
+	logCtx := log.WithField("pool", pool.Name)
+	_, poolCIDR, err := cnet.ParseCIDR(pool.Spec.CIDR)
+	if err != nil {
+		return err
+	}
+	blocks := blocksInPool(*poolCIDR, c.blocks)
+
+	for _, b := range blocks {
+		if b.Affinity != nil && countAllocations(b) == 0 {
+			logCtx.WithField("block", b.CIDR.String()).Info("Releasing empty block in draining IP pool")
+			if err := c.calicoClient.IPAM().ReleaseBlockAffinity(c.ctx, b, true); err != nil {
+				logCtx.WithError(err).WithField("block", b.CIDR.String()).Warn("Failed to release empty block")
+			}
+		}
+	}
+
+	util, err := c.calicoClient.IPAM().GetUtilization(c.ctx, ipam.GetUtilizationArgs{Pools: []string{pool.Name}})
+	if err != nil {
+		return err
+	}
+
+	var lastEviction *metav1.Time
+	if pool.Status != nil && pool.Status.Drain != nil {
+		lastEviction = pool.Status.Drain.LastEviction
+	}
+	allocs := podAllocations(blocks)
+	if pool.Spec.Drain.EvictPods && len(allocs) > 0 && evictionDue(pool.Spec.Drain, lastEviction, time.Now()) {
+		batchSize := defaultEvictionBatchSize
+		if pool.Spec.Drain.EvictionBatchSize != nil {
+			batchSize = *pool.Spec.Drain.EvictionBatchSize
+		}
+		if n := c.evictPods(allocs, batchSize); n > 0 {
+			logCtx.WithField("evicted", n).Info("Evicted pods from draining IP pool")
+			lastEviction = &metav1.Time{Time: time.Now()}
+		}
+	}
+	if pool.Spec.Drain.EvictPods && len(allocs) > 0 {
+		now := time.Now()
+		next := nextEvictionTime(pool.Spec.Drain, lastEviction, now)
+		if !next.After(now) {
+			// We couldn't evict anything this time, for example due to PodDisruptionBudgets.
+			// Try again after the interval.
+			next = nextEvictionTime(pool.Spec.Drain, &metav1.Time{Time: now}, now)
+		}
+		c.scheduleEviction(next)
+	}
+
+	status := drainStatus(util, allocs)
+	status.LastEviction = lastEviction
+	if pool.Status != nil && reflect.DeepEqual(pool.Status.Drain, status) {
+		return nil
+	}
+	return c.updateStatus(pool.Name, status)
+}
+
+// scheduleEviction records that an eviction batch is due at the given time.
+func (c *ipPoolDrainController) scheduleEviction(t time.Time) {
+	if c.nextEviction.IsZero() || t.Before(c.nextEviction) {
+		c.nextEviction = t
+	}
+}
+
+// resetEvictionTimer arms the eviction timer for the next eviction batch, if any.
+func (c *ipPoolDrainController) resetEvictionTimer() {
+	c.stopEvictionTimer()
+	if c.nextEviction.IsZero() {
+		return
+	}
+	log.WithField("nextEviction", c.nextEviction).Debug("Scheduling next IP pool drain eviction batch")
+	c.evictionTimer = time.NewTimer(time.Until(c.nextEviction))
+}
+
+func (c *ipPoolDrainController) stopEvictionTimer() {
+	if c.evictionTimer != nil {
+		c.evictionTimer.Stop()
+		c.evictionTimer = nil
+	}
+}
+
+// evictPods evicts up to batchSize pods that hold addresses in the pool, returning the number evicted.
+// Pods that are already terminating count towards the batch, so that each batch has finished
+// before the next one starts.
+func (c *ipPoolDrainController) evictPods(allocs []podAllocation, batchSize int) int {
+	evicted := 0
+	for _, a := range allocs {
+		if batchSize <= 0 {
+			break
+		}
+		logCtx := log.WithFields(log.Fields{"namespace": a.namespace, "pod": a.name, "ip": a.ip})
+
+		pod, err := c.clientSet.CoreV1().Pods(a.namespace).Get(c.ctx, a.name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logCtx.WithError(err).Warn("Failed to get pod for eviction")
+			}
+			continue
+		}
+		if pod.DeletionTimestamp != nil {
+			batchSize--
+			continue
+		}
+		if !podHasIP(pod.Status.PodIPs, a.ip) {
+			// The allocation is stale, or belongs to an earlier pod with the same name.
+			continue
+		}
+
+		err = c.clientSet.PolicyV1().Evictions(a.namespace).Evict(c.ctx, &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: a.name, Namespace: a.namespace},
+		})
+		if err != nil {
+			// Evictions that would violate a PodDisruptionBudget are rejected, and retried on a later batch.
+			logCtx.WithError(err).Info("Failed to evict pod from draining IP pool")
+			continue
+		}
+		logCtx.Debug("Evicted pod from draining IP pool")
+		evicted++
+		batchSize--
+	}
+	return evicted
+}
+
+func (c *ipPoolDrainController) updateStatus(name string, status *api.IPPoolDrainStatus) error {
+	pool, err := c.calicoClient.IPPools().Get(c.ctx, name, options.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		pool.Status = nil
+	} else {
+		pool.Status = &api.IPPoolStatus{Drain: status}
+	}
+	_, err = c.calicoClient.IPPools().Update(c.ctx, pool, options.SetOptions{})
+	return err
+}
+
+// blocksInPool returns the blocks that fall within the pool CIDR, sorted by CIDR.
+func blocksInPool(poolCIDR cnet.IPNet, blocks map[string]*model.AllocationBlock) []*model.AllocationBlock {
+	var inPool []*model.AllocationBlock
+	for _, b := range blocks {
+		if poolCIDR.Contains(b.CIDR.IP) {
+			inPool = append(inPool, b)
+		}
+	}
+	sort.Slice(inPool, func(i, j int) bool { return inPool[i].CIDR.String() < inPool[j].CIDR.String() })
+	return inPool
+}
+
+func countAllocations(b *model.AllocationBlock) int {
+	n := 0
+	for _, a := range b.Allocations {
+		if a != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// podAllocations returns the pod addresses in the given blocks, sorted by namespace and name.
+func podAllocations(blocks []*model.AllocationBlock) []podAllocation {
+	var allocs []podAllocation
+	for _, b := range blocks {
+		for ord, attrIdx := range b.Allocations {
+			if attrIdx == nil || *attrIdx >= len(b.Attributes) {
+				continue
+			}
+			attrs := b.Attributes[*attrIdx].AttrSecondary
+			pod, ns := attrs[ipam.AttributePod], attrs[ipam.AttributeNamespace]
+			if pod == "" || ns == "" {
+				// Not a pod address, e.g. a tunnel address.
+				continue
+			}
+			allocs = append(allocs, podAllocation{namespace: ns, name: pod, ip: b.OrdinalToIP(ord).String()})
+		}
+	}
+	sort.Slice(allocs, func(i, j int) bool {
+		if allocs[i].namespace != allocs[j].namespace {
+			return allocs[i].namespace < allocs[j].namespace
+		}
+		return allocs[i].name < allocs[j].name
+	})
+	return allocs
+}
+
+// drainStatus builds the status for a draining pool from its utilization and remaining pod addresses.
+func drainStatus(util []*ipam.PoolUtilization, allocs []podAllocation) *api.IPPoolDrainStatus {
+	status := &api.IPPoolDrainStatus{}
+	for _, p := range util {
+		for _, b := range p.Blocks {
+			status.Blocks++
+			status.AllocatedIPs += b.Capacity - b.Available
+		}
+	}
+
+	for _, a := range allocs {
+		n := len(status.Namespaces)
+		if n > 0 && status.Namespaces[n-1].Namespace == a.namespace {
+			status.Namespaces[n-1].AllocatedIPs++
+			continue
+		}
+		status.Namespaces = append(status.Namespaces, api.IPPoolNamespaceAllocations{Namespace: a.namespace, AllocatedIPs: 1})
+	}
+	return status
+}
+
+// evictionDue returns true if enough time has passed since the last eviction batch.
+func evictionDue(drain *api.IPPoolDrain, lastEviction *metav1.Time, now time.Time) bool {
+	return !now.Before(nextEvictionTime(drain, lastEviction, now))
+}
+
+// nextEvictionTime returns when the next eviction batch is due, which is now if there hasn't been one.
+func nextEvictionTime(drain *api.IPPoolDrain, lastEviction *metav1.Time, now time.Time) time.Time {
+	if lastEviction == nil {
+		return now
+	}
+	interval := defaultEvictionInterval
+	if drain.EvictionInterval != nil {
+		interval = drain.EvictionInterval.Duration
+	}
+	return lastEviction.Add(interval)
+}
+
+func podHasIP(podIPs []v1.PodIP, ip string) bool {
+	for _, p := range podIPs {
+		if p.IP == ip {
+			return true
+		}
+	}
+	return false
+}
+
+func kick(c chan<- interface{}) {
+	select {
+	case c <- nil:
+		// pass
+	default:
+		// pass
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippooldrain
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+func podAttr(namespace, pod string) model.AllocationAttribute {
+	return model.AllocationAttribute{AttrSecondary: map[string]string{
+		ipam.AttributeNamespace: namespace,
+		ipam.AttributePod:       pod,
+	}}
+}
+
+func newPod(namespace, name, ip string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: ip}}},
+	}
+}
+
+var _ = Describe("IP pool drain controller UTs", func() {
+	idx0, idx1, idx2 := 0, 1, 2
+	block := &model.AllocationBlock{
+		CIDR:        cnet.MustParseCIDR("10.0.0.0/30"),
+		Allocations: []*int{&idx0, &idx1, &idx2, nil},
+		Attributes: []model.AllocationAttribute{
+			podAttr("ns-b", "pod-1"),
+			podAttr("ns-a", "pod-2"),
+			// A tunnel address, which isn't held by a pod.
+			{AttrSecondary: map[string]string{ipam.AttributeNode: "node1", ipam.AttributeType: ipam.AttributeTypeVXLAN}},
+		},
+	}
+	otherPoolBlock := &model.AllocationBlock{
+		CIDR:        cnet.MustParseCIDR("10.1.0.0/30"),
+		Allocations: []*int{&idx0, nil, nil, nil},
+		Attributes:  []model.AllocationAttribute{podAttr("ns-a", "pod-3")},
+	}
+
+	It("should find the blocks in a pool", func() {
+		blocks := blocksInPool(cnet.MustParseCIDR("10.0.0.0/16"), map[string]*model.AllocationBlock{
+			"a": block,
+			"b": otherPoolBlock,
+		})
+		Expect(blocks).To(Equal([]*model.AllocationBlock{block}))
+	})
+
+	It("should list pod allocations, skipping other addresses", func() {
+		Expect(countAllocations(block)).To(Equal(3))
+		Expect(podAllocations([]*model.AllocationBlock{block})).To(Equal([]podAllocation{
+			{namespace: "ns-a", name: "pod-2", ip: "10.0.0.1"},
+			{namespace: "ns-b", name: "pod-1", ip: "10.0.0.0"},
+		}))
+	})
+
+	It("should build the drain status", func() {
+		util := []*ipam.PoolUtilization{{
+			Name: "pool",
+			Blocks: []ipam.BlockUtilization{
+				{Capacity: 4, Available: 1},
+				{Capacity: 4, Available: 4},
+			},
+		}}
+		allocs := []podAllocation{
+			{namespace: "ns-a", name: "pod-1"},
+			{namespace: "ns-a", name: "pod-2"},
+			{namespace: "ns-b", name: "pod-3"},
+		}
+		Expect(drainStatus(util, allocs)).To(Equal(&apiv3.IPPoolDrainStatus{
+			Blocks:       2,
+			AllocatedIPs: 3,
+			Namespaces: []apiv3.IPPoolNamespaceAllocations{
+				{Namespace: "ns-a", AllocatedIPs: 2},
+				{Namespace: "ns-b", AllocatedIPs: 1},
+			},
+		}))
+	})
+
+	It("should space out eviction batches", func() {
+		now := time.Now()
+		drain := &apiv3.IPPoolDrain{}
+		Expect(evictionDue(drain, nil, now)).To(BeTrue())
+		Expect(evictionDue(drain, &metav1.Time{Time: now.Add(-30 * time.Second)}, now)).To(BeFalse())
+		Expect(evictionDue(drain, &metav1.Time{Time: now.Add(-time.Minute)}, now)).To(BeTrue())
+
+		drain.EvictionInterval = &metav1.Duration{Duration: 10 * time.Second}
+		Expect(evictionDue(drain, &metav1.Time{Time: now.Add(-30 * time.Second)}, now)).To(BeTrue())
+	})
+
+	It("should schedule the next eviction batch", func() {
+		now := time.Now()
+		drain := &apiv3.IPPoolDrain{}
+		Expect(nextEvictionTime(drain, nil, now)).To(Equal(now))
+		Expect(nextEvictionTime(drain, &metav1.Time{Time: now}, now)).To(Equal(now.Add(time.Minute)))
+
+		c := &ipPoolDrainController{}
+		c.scheduleEviction(now.Add(time.Minute))
+		c.scheduleEviction(now.Add(time.Millisecond))
+		c.scheduleEviction(now.Add(time.Hour))
+		Expect(c.nextEviction).To(Equal(now.Add(time.Millisecond)))
+
+		c.resetEvictionTimer()
+		Expect(c.evictionTimer).NotTo(BeNil())
+		Eventually(c.evictionTimer.C).Should(Receive())
+
+		c.nextEviction = time.Time{}
+		c.resetEvictionTimer()
+		Expect(c.evictionTimer).To(BeNil())
+	})
+
+	Describe("evicting pods", func() {
+		var c *ipPoolDrainController
+		var cs *fake.Clientset
+
+		BeforeEach(func() {
+			terminating := newPod("ns-a", "terminating", "10.0.0.2")
+			terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			cs = fake.NewSimpleClientset(
+				newPod("ns-a", "pod-1", "10.0.0.0"),
+				newPod("ns-a", "pod-2", "10.0.0.1"),
+				newPod("ns-a", "recreated", "10.2.0.1"),
+				terminating,
+			)
+			c = &ipPoolDrainController{ctx: context.Background(), clientSet: cs}
+		})
+
+		evictions := func() []string {
+			var evicted []string
+			for _, a := range cs.Actions() {
+				if a.GetVerb() == "create" && a.GetSubresource() == "eviction" {
+					evicted = append(evicted, a.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction).Name)
+				}
+			}
+			return evicted
+		}
+
+		It("should evict pods that hold addresses in the pool", func() {
+			n := c.evictPods([]podAllocation{
+				{namespace: "ns-a", name: "missing", ip: "10.0.0.3"},
+				{namespace: "ns-a", name: "pod-1", ip: "10.0.0.0"},
+				{namespace: "ns-a", name: "pod-2", ip: "10.0.0.1"},
+				{namespace: "ns-a", name: "recreated", ip: "10.0.0.4"},
+			}, 10)
+			Expect(n).To(Equal(2))
+			Expect(evictions()).To(Equal([]string{"pod-1", "pod-2"}))
+		})
+
+		It("should count terminating pods towards the batch", func() {
+			n := c.evictPods([]podAllocation{
+				{namespace: "ns-a", name: "terminating", ip: "10.0.0.2"},
+				{namespace: "ns-a", name: "pod-1", ip: "10.0.0.0"},
+				{namespace: "ns-a", name: "pod-2", ip: "10.0.0.1"},
+			}, 2)
+			Expect(n).To(Equal(1))
+			Expect(evictions()).To(Equal([]string{"pod-1"}))
+		})
+	})
+
+	It("should only resync on pool spec changes", func() {
+		c := &ipPoolDrainController{pools: make(map[string]*apiv3.IPPool)}
+		key := model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool"}
+		pool := apiv3.NewIPPool()
+		pool.Name = "pool"
+		pool.Spec.CIDR = "10.0.0.0/16"
+
+		Expect(c.handleIPPoolUpdate("pool", model.KVPair{Key: key, Value: pool})).To(BeTrue())
+
+		withStatus := pool.DeepCopy()
+		withStatus.Status = &apiv3.IPPoolStatus{Drain: &apiv3.IPPoolDrainStatus{Blocks: 1}}
+		Expect(c.handleIPPoolUpdate("pool", model.KVPair{Key: key, Value: withStatus})).To(BeFalse())
+
+		draining := withStatus.DeepCopy()
+		draining.Spec.Disabled = true
+		draining.Spec.Drain = &apiv3.IPPoolDrain{}
+		Expect(c.handleIPPoolUpdate("pool", model.KVPair{Key: key, Value: draining})).To(BeTrue())
+
+		Expect(c.handleIPPoolUpdate("pool", model.KVPair{Key: key})).To(BeFalse())
+		Expect(c.pools).To(BeEmpty())
+	})
+
+	It("should resync on block changes in draining pools", func() {
+		c := &ipPoolDrainController{
+			syncChan: make(chan interface{}, 1),
+			pools:    make(map[string]*apiv3.IPPool),
+			blocks:   make(map[string]*model.AllocationBlock),
+		}
+		draining := apiv3.NewIPPool()
+		draining.Spec.CIDR = "10.0.0.0/16"
+		draining.Spec.Drain = &apiv3.IPPoolDrain{}
+		other := apiv3.NewIPPool()
+		other.Spec.CIDR = "10.1.0.0/16"
+		c.pools["draining"] = draining
+		c.pools["other"] = other
+
+		blockUpdate := func(b *model.AllocationBlock, deleted bool) model.KVPair {
+			kvp := model.KVPair{Key: model.BlockKey{CIDR: b.CIDR}}
+			if !deleted {
+				kvp.Value = b
+			}
+			return kvp
+		}
+
+		c.handleUpdate(blockUpdate(otherPoolBlock, false))
+		Expect(c.syncChan).NotTo(Receive())
+
+		c.handleUpdate(blockUpdate(block, false))
+		Expect(c.syncChan).To(Receive())
+		Expect(c.blocks).To(HaveLen(2))
+
+		c.handleUpdate(blockUpdate(block, true))
+		Expect(c.syncChan).To(Receive())
+		Expect(c.blocks).To(HaveLen(1))
+	})
+
+	It("should run with the periodic sync disabled", func() {
+		c := &ipPoolDrainController{
+			cfg:           config.GenericControllerConfig{ReconcilerPeriod: 0},
+			syncerUpdates: make(chan interface{}, batchUpdateSize),
+			syncChan:      make(chan interface{}, 1),
+			pools:         make(map[string]*apiv3.IPPool),
+			blocks:        make(map[string]*model.AllocationBlock),
+		}
+		stopCh := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			c.acceptScheduledRequests(stopCh)
+		}()
+		Consistently(done, "100ms").ShouldNot(BeClosed())
+		close(stopCh)
+		Eventually(done).Should(BeClosed())
+	})
+})
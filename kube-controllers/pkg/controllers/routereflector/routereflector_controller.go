@@ -0,0 +1,526 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routereflector
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	uruntime "k8s.io/apimachinery/pkg/util/runtime"
+	v1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/utils"
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+const (
+	// routeReflectorLabel is set on Calico nodes that this controller has promoted to route reflectors.
+	routeReflectorLabel = "projectcalico.org/route-reflector"
+	createdByLabelKey   = "projectcalico.org/created-by"
+	createdByLabelValue = "calico-kube-controllers"
+
+	peerNamePrefix       = "rr-"
+	clientPeerNamePrefix = peerNamePrefix + "clients-"
+	meshPeerName         = peerNamePrefix + "mesh"
+
+	timer            = 5 * time.Minute
+	batchUpdateSize  = 1000
+	maxUpdateRetries = 5
+)
+
+var retrySleepTime = 100 * time.Millisecond
+
+// nodeState captures the parts of a node that drive route reflector selection.
+type nodeState struct {
+	name string
+	zone string
+	// eligible is true if the node runs BGP, matches the configured node selector and is not
+	// configured as a route reflector by something other than this controller.
+	eligible bool
+	// healthy is true if the Kubernetes node is Ready and schedulable.
+	healthy bool
+	// reflector is true if the node is currently a route reflector managed by this controller.
+	reflector bool
+	clusterID string
+}
+
+// routeReflectorController implements the Controller interface. It maintains a set of route reflectors
+// in each topology zone, and the BGPPeers that connect the remaining nodes to them.
+type routeReflectorController struct {
+	ctx           context.Context
+	calicoClient  client.Interface
+	cfg           config.RouteReflectorControllerConfig
+	nodeSelector  selector.Selector
+	syncerUpdates chan interface{}
+	syncStatus    bapi.SyncStatus
+	syncChan      chan interface{}
+	nodeCache     map[string]*libapi.Node
+	nodeInformer  cache.SharedIndexInformer
+	nodeLister    v1lister.NodeLister
+}
+
+// NewRouteReflectorController returns a controller which manages the route reflector topology of the cluster.
+func NewRouteReflectorController(ctx context.Context, calicoClient client.Interface, cfg config.RouteReflectorControllerConfig, nodeInformer cache.SharedIndexInformer, dataFeed *utils.DataFeed) *routeReflectorController {
+	sel, err := selector.Parse(cfg.NodeSelector)
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to parse route reflector node selector %q", cfg.NodeSelector)
+		return nil
+	}
+
+	c := &routeReflectorController{
+		ctx:           ctx,
+		calicoClient:  calicoClient,
+		cfg:           cfg,
+		nodeSelector:  sel,
+		syncerUpdates: make(chan interface{}, batchUpdateSize),
+		syncChan:      make(chan interface{}, 1),
+		nodeCache:     make(map[string]*libapi.Node),
+		nodeInformer:  nodeInformer,
+		nodeLister:    v1lister.NewNodeLister(nodeInformer.GetIndexer()),
+	}
+
+	c.RegisterWith(dataFeed)
+
+	// Kubernetes node events only matter to us when they change the health of a node.
+	_, err = c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { kick(c.syncChan) },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, ok1 := oldObj.(*v1.Node)
+			newNode, ok2 := newObj.(*v1.Node)
+			if !ok1 || !ok2 || isHealthy(oldNode) != isHealthy(newNode) {
+				kick(c.syncChan)
+			}
+		},
+		DeleteFunc: func(interface{}) { kick(c.syncChan) },
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to add event handler for route reflector controller")
+		return nil
+	}
+	return c
+}
+
+// Run starts the controller.
+func (c *routeReflectorController) Run(stopCh chan struct{}) {
+	defer uruntime.HandleCrash()
+
+	log.Debug("Waiting to sync with Kubernetes API (Nodes)")
+	if !cache.WaitForNamedCacheSync("routereflector", stopCh, c.nodeInformer.HasSynced) {
+		log.Info("Failed to sync resources, received signal for controller to shut down.")
+		return
+	}
+
+	go c.acceptScheduledRequests(stopCh)
+
+	<-stopCh
+	log.Info("Stopping route reflector controller")
+}
+
+func (c *routeReflectorController) RegisterWith(f *utils.DataFeed) {
+	f.RegisterForNotification(model.ResourceKey{}, c.onUpdate)
+	f.RegisterForSyncStatus(c.onStatusUpdate)
+}
+
+func (c *routeReflectorController) onStatusUpdate(s bapi.SyncStatus) {
+	c.syncerUpdates <- s
+}
+
+func (c *routeReflectorController) onUpdate(update bapi.Update) {
+	switch update.KVPair.Key.(type) {
+	case model.ResourceKey:
+		switch update.KVPair.Key.(model.ResourceKey).Kind {
+		case libapi.KindNode:
+			c.syncerUpdates <- update.KVPair
+		}
+	}
+}
+
+func (c *routeReflectorController) acceptScheduledRequests(stopCh <-chan struct{}) {
+	log.Infof("Will run periodic route reflector sync every %s", timer)
+	t := time.NewTicker(timer)
+	for {
+		select {
+		case update := <-c.syncerUpdates:
+			c.handleUpdate(update)
+		case <-t.C:
+			log.Info("Running periodic route reflector sync")
+			c.syncRouteReflectors()
+		case <-c.syncChan:
+			c.syncRouteReflectors()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (c *routeReflectorController) handleUpdate(update interface{}) {
+	switch update := update.(type) {
+	case bapi.SyncStatus:
+		c.syncStatus = update
+		switch update {
+		case bapi.InSync:
+			log.WithField("status", update).Info("Syncer is InSync, kicking sync channel")
+			kick(c.syncChan)
+		}
+	case model.KVPair:
+		if c.handleNodeUpdate(update) {
+			kick(c.syncChan)
+		}
+	}
+}
+
+// handleNodeUpdate updates the node cache and returns true if the update changed any of the
+// fields that route reflector selection depends on.
+func (c *routeReflectorController) handleNodeUpdate(kvp model.KVPair) bool {
+	name := kvp.Key.(model.ResourceKey).Name
+	old := c.nodeCache[name]
+	if kvp.Value == nil {
+		delete(c.nodeCache, name)
+		return old != nil
+	}
+
+	node := kvp.Value.(*libapi.Node)
+	c.nodeCache[name] = node
+	if old == nil {
+		return true
+	}
+	return !reflect.DeepEqual(old.Labels, node.Labels) ||
+		!reflect.DeepEqual(old.Spec.BGP, node.Spec.BGP) ||
+		!reflect.DeepEqual(old.Spec.OrchRefs, node.Spec.OrchRefs)
+}
+
+// syncRouteReflectors brings the route reflector topology in line with the current set of nodes. It does the following:
+// 1. Works out which nodes should be route reflectors in each zone.
+// 2. Promotes any new route reflectors, so that clients never lose all of their reflectors during a change.
+// 3. Creates, updates and deletes the BGPPeers that connect clients to their reflectors.
+// 4. Demotes any route reflectors that are no longer required.
+func (c *routeReflectorController) syncRouteReflectors() {
+	if c.syncStatus != bapi.InSync {
+		log.WithField("status", c.syncStatus).Debug("Have not yet received InSync notification, skipping route reflector sync.")
+		return
+	}
+
+	nodes := c.nodeStates()
+	desired := selectRouteReflectors(nodes, c.cfg.ReflectorsPerZone)
+
+	reflectorZones := make(map[string]bool)
+	allZones := make(map[string]bool)
+	for _, n := range nodes {
+		allZones[n.zone] = true
+		if !desired[n.name] {
+			continue
+		}
+		reflectorZones[n.zone] = true
+
+		clusterID := clusterIDForZone(n.zone)
+		if n.reflector && n.clusterID == clusterID {
+			continue
+		}
+		log.WithFields(log.Fields{"node": n.name, "zone": n.zone, "clusterID": clusterID}).Info("Promoting node to route reflector")
+		if err := c.updateNode(n.name, func(node *libapi.Node) {
+			if node.Labels == nil {
+				node.Labels = make(map[string]string)
+			}
+			node.Labels[routeReflectorLabel] = "true"
+			node.Spec.BGP.RouteReflectorClusterID = clusterID
+		}); err != nil {
+			log.WithError(err).WithField("node", n.name).Error("Failed to promote node to route reflector, will retry on next sync")
+		}
+	}
+
+	if err := c.syncBGPPeers(desiredBGPPeers(allZones, reflectorZones, c.cfg.ZoneLabel)); err != nil {
+		log.WithError(err).Error("Failed to sync route reflector BGPPeers, will retry on next sync")
+	}
+
+	for _, n := range nodes {
+		if desired[n.name] || !n.reflector {
+			continue
+		}
+		log.WithFields(log.Fields{"node": n.name, "zone": n.zone}).Info("Demoting route reflector")
+		if err := c.updateNode(n.name, func(node *libapi.Node) {
+			delete(node.Labels, routeReflectorLabel)
+			node.Spec.BGP.RouteReflectorClusterID = ""
+		}); err != nil {
+			log.WithError(err).WithField("node", n.name).Error("Failed to demote route reflector, will retry on next sync")
+		}
+	}
+}
+
+// nodeStates builds the selection input from the cached Calico nodes and the Kubernetes node lister.
+func (c *routeReflectorController) nodeStates() []nodeState {
+	var nodes []nodeState
+	for _, node := range c.nodeCache {
+		_, managed := node.Labels[routeReflectorLabel]
+		s := nodeState{
+			name:      node.Name,
+			zone:      node.Labels[c.cfg.ZoneLabel],
+			healthy:   true,
+			reflector: managed && node.Spec.BGP != nil,
+		}
+		if node.Spec.BGP != nil {
+			s.clusterID = node.Spec.BGP.RouteReflectorClusterID
+			// Nodes that have been made route reflectors by hand are left alone.
+			s.eligible = c.nodeSelector.Evaluate(node.Labels) && (managed || s.clusterID == "")
+		}
+
+		if k8sNodeName := getK8sNodeName(node); k8sNodeName != "" {
+			k8sNode, err := c.nodeLister.Get(k8sNodeName)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					log.WithError(err).WithField("node", k8sNodeName).Warn("Failed to get Kubernetes node")
+				}
+				s.healthy = false
+			} else {
+				s.healthy = isHealthy(k8sNode)
+			}
+		}
+		nodes = append(nodes, s)
+	}
+	return nodes
+}
+
+// updateNode applies the given change to the named Calico node, retrying on update conflicts.
+func (c *routeReflectorController) updateNode(name string, mutate func(*libapi.Node)) error {
+	var err error
+	for i := 0; i < maxUpdateRetries; i++ {
+		var node *libapi.Node
+		node, err = c.calicoClient.Nodes().Get(c.ctx, name, options.GetOptions{})
+		if err != nil {
+			if isNotExist(err) {
+				return nil
+			}
+			time.Sleep(retrySleepTime)
+			continue
+		}
+		if node.Spec.BGP == nil {
+			return fmt.Errorf("node %s has no BGP configuration", name)
+		}
+
+		mutate(node)
+		_, err = c.calicoClient.Nodes().Update(c.ctx, node, options.SetOptions{})
+		if err == nil {
+			return nil
+		}
+		if !isConflict(err) {
+			return err
+		}
+		time.Sleep(retrySleepTime)
+	}
+	return err
+}
+
+// syncBGPPeers makes the controller-owned BGPPeers match the desired set.
+func (c *routeReflectorController) syncBGPPeers(desired []api.BGPPeer) error {
+	peers, err := c.calicoClient.BGPPeers().List(c.ctx, options.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]api.BGPPeer)
+	for _, p := range peers.Items {
+		if isControllerBGPPeer(&p) {
+			existing[p.Name] = p
+		}
+	}
+
+	for _, want := range desired {
+		have, ok := existing[want.Name]
+		delete(existing, want.Name)
+		if !ok {
+			log.WithField("peer", want.Name).Info("Creating route reflector BGPPeer")
+			if _, err := c.calicoClient.BGPPeers().Create(c.ctx, &want, options.SetOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+		if reflect.DeepEqual(have.Spec, want.Spec) {
+			continue
+		}
+		log.WithField("peer", want.Name).Info("Updating route reflector BGPPeer")
+		have.Spec = want.Spec
+		if _, err := c.calicoClient.BGPPeers().Update(c.ctx, &have, options.SetOptions{}); err != nil {
+			return err
+		}
+	}
+
+	for name := range existing {
+		log.WithField("peer", name).Info("Deleting route reflector BGPPeer")
+		if _, err := c.calicoClient.BGPPeers().Delete(c.ctx, name, options.DeleteOptions{}); err != nil {
+			if !isNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// selectRouteReflectors returns the names of the nodes that should be route reflectors. Within each zone it
+// keeps existing healthy reflectors where possible, to avoid churning BGP sessions, and tops up with healthy
+// eligible nodes in name order. Unhealthy reflectors are only kept while there is nothing to replace them with.
+func selectRouteReflectors(nodes []nodeState, perZone int) map[string]bool {
+	byZone := make(map[string][]nodeState)
+	for _, n := range nodes {
+		if n.eligible {
+			byZone[n.zone] = append(byZone[n.zone], n)
+		}
+	}
+
+	desired := make(map[string]bool)
+	for _, candidates := range byZone {
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].name < candidates[j].name })
+
+		var selected []string
+		pick := func(match func(nodeState) bool) {
+			for _, n := range candidates {
+				if len(selected) >= perZone {
+					return
+				}
+				if match(n) {
+					selected = append(selected, n.name)
+				}
+			}
+		}
+		pick(func(n nodeState) bool { return n.reflector && n.healthy })
+		pick(func(n nodeState) bool { return !n.reflector && n.healthy })
+		pick(func(n nodeState) bool { return n.reflector && !n.healthy })
+
+		for _, name := range selected {
+			desired[name] = true
+		}
+	}
+	return desired
+}
+
+// desiredBGPPeers returns the BGPPeers needed for the given zones. Clients peer with the reflectors in their
+// own zone, or with every reflector if their zone has none, and the reflectors form a full mesh between them.
+func desiredBGPPeers(allZones, reflectorZones map[string]bool, zoneLabel string) []api.BGPPeer {
+	if len(reflectorZones) == 0 {
+		return nil
+	}
+
+	isReflector := fmt.Sprintf("has(%s)", routeReflectorLabel)
+	isClient := fmt.Sprintf("!has(%s)", routeReflectorLabel)
+	peers := []api.BGPPeer{newBGPPeer(meshPeerName, isReflector, isReflector)}
+
+	var zones []string
+	for zone := range allZones {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	for _, zone := range zones {
+		inZone := zoneSelector(zoneLabel, zone)
+		peerSelector := isReflector
+		if reflectorZones[zone] {
+			peerSelector = fmt.Sprintf("%s && %s", isReflector, inZone)
+		}
+		peers = append(peers, newBGPPeer(clientPeerName(zone), fmt.Sprintf("%s && %s", isClient, inZone), peerSelector))
+	}
+	return peers
+}
+
+func newBGPPeer(name, nodeSelector, peerSelector string) api.BGPPeer {
+	p := api.NewBGPPeer()
+	p.Name = name
+	p.Labels = map[string]string{createdByLabelKey: createdByLabelValue}
+	p.Spec.NodeSelector = nodeSelector
+	p.Spec.PeerSelector = peerSelector
+	return *p
+}
+
+// zoneSelector returns a selector matching nodes in the given zone. Nodes without the zone label are
+// treated as a zone of their own.
+func zoneSelector(zoneLabel, zone string) string {
+	if zone == "" {
+		return fmt.Sprintf("!has(%s)", zoneLabel)
+	}
+	return fmt.Sprintf("%s == '%s'", zoneLabel, zone)
+}
+
+// clientPeerName returns a stable BGPPeer name for the zone. Zone values are label values, which are
+// not all valid resource names, so the name is derived from a hash.
+func clientPeerName(zone string) string {
+	h := sha256.Sum256([]byte(zone))
+	return fmt.Sprintf("%s%x", clientPeerNamePrefix, h[:8])
+}
+
+// clusterIDForZone returns a stable route reflector cluster ID for the zone. Reflectors in different zones
+// need different cluster IDs, otherwise they would drop the routes that they reflect to each other.
+func clusterIDForZone(zone string) string {
+	h := sha256.Sum256([]byte(zone))
+	return fmt.Sprintf("244.%d.%d.%d", h[0], h[1], h[2])
+}
+
+func isControllerBGPPeer(p *api.BGPPeer) bool {
+	return p.Labels[createdByLabelKey] == createdByLabelValue && strings.HasPrefix(p.Name, peerNamePrefix)
+}
+
+// isHealthy returns true if the Kubernetes node is Ready and schedulable.
+func isHealthy(node *v1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == v1.NodeReady {
+			return cond.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// getK8sNodeName returns the Kubernetes node name for the Calico node, or "" if it isn't a Kubernetes node.
+func getK8sNodeName(node *libapi.Node) string {
+	for _, orchRef := range node.Spec.OrchRefs {
+		if orchRef.Orchestrator == "k8s" {
+			return orchRef.NodeName
+		}
+	}
+	return ""
+}
+
+func isNotExist(err error) bool {
+	_, ok := err.(cerrors.ErrorResourceDoesNotExist)
+	return ok
+}
+
+func isConflict(err error) bool {
+	_, ok := err.(cerrors.ErrorResourceUpdateConflict)
+	return ok
+}
+
+func kick(c chan<- interface{}) {
+	select {
+	case c <- nil:
+		// pass
+	default:
+		// pass
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routereflector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+var _ = Describe("Route reflector controller UTs", func() {
+	eligible := func(name, zone string) nodeState {
+		return nodeState{name: name, zone: zone, eligible: true, healthy: true}
+	}
+
+	DescribeTable("selecting route reflectors",
+		func(nodes []nodeState, perZone int, expected []string) {
+			desired := selectRouteReflectors(nodes, perZone)
+			Expect(desired).To(HaveLen(len(expected)))
+			for _, name := range expected {
+				Expect(desired).To(HaveKey(name))
+			}
+		},
+		Entry("picks nodes in name order",
+			[]nodeState{eligible("c", "a"), eligible("b", "a"), eligible("a", "a")},
+			2, []string{"a", "b"},
+		),
+		Entry("picks per zone",
+			[]nodeState{eligible("n1", "a"), eligible("n2", "a"), eligible("n3", "b"), eligible("n4", "")},
+			1, []string{"n1", "n3", "n4"},
+		),
+		Entry("skips ineligible nodes",
+			[]nodeState{{name: "a", zone: "a", healthy: true}, eligible("b", "a")},
+			2, []string{"b"},
+		),
+		Entry("keeps existing reflectors",
+			[]nodeState{eligible("a", "a"), eligible("b", "a"), {name: "c", zone: "a", eligible: true, healthy: true, reflector: true}},
+			1, []string{"c"},
+		),
+		Entry("replaces unhealthy reflectors",
+			[]nodeState{eligible("b", "a"), {name: "a", zone: "a", eligible: true, reflector: true}},
+			1, []string{"b"},
+		),
+		Entry("keeps unhealthy reflectors when there is no replacement",
+			[]nodeState{{name: "a", zone: "a", eligible: true, reflector: true}, {name: "b", zone: "a", eligible: true}},
+			1, []string{"a"},
+		),
+		Entry("demotes reflectors that are no longer eligible",
+			[]nodeState{eligible("b", "a"), {name: "a", zone: "a", healthy: true, reflector: true}},
+			1, []string{"b"},
+		),
+	)
+
+	It("should generate peers for each zone", func() {
+		peers := desiredBGPPeers(
+			map[string]bool{"a": true, "b": true, "": true},
+			map[string]bool{"a": true, "": true},
+			"zone",
+		)
+		Expect(peers).To(HaveLen(4))
+
+		specs := map[string][2]string{}
+		for _, p := range peers {
+			Expect(p.Labels).To(HaveKeyWithValue(createdByLabelKey, createdByLabelValue))
+			Expect(isControllerBGPPeer(&p)).To(BeTrue())
+			specs[p.Name] = [2]string{p.Spec.NodeSelector, p.Spec.PeerSelector}
+		}
+		Expect(specs).To(Equal(map[string][2]string{
+			meshPeerName: {
+				"has(projectcalico.org/route-reflector)",
+				"has(projectcalico.org/route-reflector)",
+			},
+			clientPeerName("a"): {
+				"!has(projectcalico.org/route-reflector) && zone == 'a'",
+				"has(projectcalico.org/route-reflector) && zone == 'a'",
+			},
+			clientPeerName(""): {
+				"!has(projectcalico.org/route-reflector) && !has(zone)",
+				"has(projectcalico.org/route-reflector) && !has(zone)",
+			},
+			// Zone b has no reflectors of its own, so its clients peer with all of them.
+			clientPeerName("b"): {
+				"!has(projectcalico.org/route-reflector) && zone == 'b'",
+				"has(projectcalico.org/route-reflector)",
+			},
+		}))
+	})
+
+	It("should generate no peers when there are no reflectors", func() {
+		Expect(desiredBGPPeers(map[string]bool{"a": true}, map[string]bool{}, "zone")).To(BeEmpty())
+	})
+
+	It("should generate stable, distinct names and cluster IDs per zone", func() {
+		Expect(clusterIDForZone("a")).To(Equal(clusterIDForZone("a")))
+		Expect(clusterIDForZone("a")).NotTo(Equal(clusterIDForZone("b")))
+		Expect(clusterIDForZone("a")).To(HavePrefix("244."))
+		Expect(clientPeerName("a")).NotTo(Equal(clientPeerName("b")))
+		Expect(clientPeerName("Zone_A")).To(MatchRegexp(`^rr-clients-[0-9a-f]{16}$`))
+	})
+
+	It("should only treat Ready, schedulable nodes as healthy", func() {
+		node := &v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}}}
+		Expect(isHealthy(node)).To(BeTrue())
+
+		node.Spec.Unschedulable = true
+		Expect(isHealthy(node)).To(BeFalse())
+
+		node.Spec.Unschedulable = false
+		node.Status.Conditions[0].Status = v1.ConditionFalse
+		Expect(isHealthy(node)).To(BeFalse())
+
+		Expect(isHealthy(&v1.Node{})).To(BeFalse())
+	})
+
+	It("should only resync on relevant node changes", func() {
+		c := &routeReflectorController{nodeCache: make(map[string]*libapi.Node)}
+		key := model.ResourceKey{Kind: libapi.KindNode, Name: "node1"}
+		node := libapi.NewNode()
+		node.ObjectMeta = metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"zone": "a"}}
+		node.Spec.BGP = &libapi.NodeBGPSpec{IPv4Address: "10.0.0.1/24"}
+
+		Expect(c.handleNodeUpdate(model.KVPair{Key: key, Value: node})).To(BeTrue())
+
+		unchanged := node.DeepCopy()
+		unchanged.ResourceVersion = "2"
+		Expect(c.handleNodeUpdate(model.KVPair{Key: key, Value: unchanged})).To(BeFalse())
+
+		relabelled := node.DeepCopy()
+		relabelled.Labels["zone"] = "b"
+		Expect(c.handleNodeUpdate(model.KVPair{Key: key, Value: relabelled})).To(BeTrue())
+
+		Expect(c.handleNodeUpdate(model.KVPair{Key: key})).To(BeTrue())
+		Expect(c.nodeCache).To(BeEmpty())
+	})
+})
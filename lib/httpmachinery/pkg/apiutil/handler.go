@@ -82,6 +82,15 @@ func NewJSONListOrEventStreamHandler[RequestParams any, ResponseBody any](f func
 	}
 }
 
+// NewJSONSingleHandler creates a handler that responds with a single json object.
+func NewJSONSingleHandler[RequestParams any, ResponseBody any](f func(apicontext.Context, RequestParams) SingleResponse[ResponseBody]) handler {
+	return genericHandler[RequestParams, ResponseBody]{
+		f: func(ctx apicontext.Context, params RequestParams) responseType {
+			return f(ctx, params)
+		},
+	}
+}
+
 func (l genericHandler[RequestParams, Body]) ServeHTTP(cfg RouterConfig, w http.ResponseWriter, req *http.Request) {
 	ctx := apicontext.NewRequestContext(req)
 
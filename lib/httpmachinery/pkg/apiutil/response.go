@@ -92,6 +92,40 @@ func (l ListResponse[E]) ResponseWriter() ResponseWriter {
 	return &jsonListResponseWriter[E]{items: l.rsp}
 }
 
+// SingleResponse implements the ResponseWriter and writes the response as a single object.
+type SingleResponse[E any] struct {
+	baseResponse
+	rsp E
+}
+
+func NewSingleResponse[E any]() SingleResponse[E] {
+	return SingleResponse[E]{}
+}
+
+func (s SingleResponse[E]) SetStatus(status int) SingleResponse[E] {
+	s.status = status
+	return s
+}
+
+func (s SingleResponse[E]) SetError(err string) SingleResponse[E] {
+	s.errMsg = err
+	return s
+}
+
+func (s SingleResponse[E]) SetItem(item E) SingleResponse[E] {
+	s.rsp = item
+	return s
+}
+
+// ResponseWriter returns a ResponseWriter to write the http response as a single json object.
+func (s SingleResponse[E]) ResponseWriter() ResponseWriter {
+	if s.errMsg != "" {
+		return &jsonErrorResponseWriter{s.errMsg}
+	}
+
+	return &jsonResponseWriter[E]{item: s.rsp}
+}
+
 // ListOrStreamResponse implements the ResponseWriter and writes the response as either a stream or a list, depending
 // on whether SendStream or SendList was called.
 type ListOrStreamResponse[E any] struct {
@@ -181,6 +215,17 @@ func (rs *jsonListResponseWriter[Body]) WriteResponse(ctx apicontext.Context, st
 	return nil
 }
 
+// jsonResponseWriter is used to write a single json object.
+type jsonResponseWriter[Body any] struct {
+	item Body
+}
+
+func (rs *jsonResponseWriter[Body]) WriteResponse(ctx apicontext.Context, status int, w http.ResponseWriter) error {
+	w.WriteHeader(status)
+	writeJSONResponse(w, rs.item)
+	return nil
+}
+
 // jsonErrorResponseWriter is used to respond with a json error.
 type jsonErrorResponseWriter struct {
 	error string
@@ -27,5 +27,6 @@ import (
 type IPPool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              v3.IPPoolSpec `json:"spec,omitempty"`
+	Spec              v3.IPPoolSpec    `json:"spec,omitempty"`
+	Status            *v3.IPPoolStatus `json:"status,omitempty"`
 }
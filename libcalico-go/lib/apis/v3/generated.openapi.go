@@ -2573,10 +2573,26 @@ func schema_libcalico_go_lib_apis_v3_IPAMConfigSpec(ref common.ReferenceCallback
 							Format:      "int32",
 						},
 					},
+					"ipQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPQuotas limits the number of addresses allocated to pods in the selected namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPQuota"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"strictAffinity", "autoAllocateBlocks"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPQuota"},
 	}
 }
 
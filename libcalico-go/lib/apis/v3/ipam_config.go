@@ -50,6 +50,10 @@ type IPAMConfigSpec struct {
 	// +kubebuilder:validation:Maximum:=2147483647
 	// +optional
 	MaxBlocksPerHost int `json:"maxBlocksPerHost,omitempty"`
+
+	// IPQuotas limits the number of addresses allocated to pods in the selected namespaces.
+	// +optional
+	IPQuotas []apiv3.IPQuota `json:"ipQuotas,omitempty" validate:"omitempty,dive"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -20,6 +20,7 @@
 package v3
 
 import (
+	projectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	numorstring "github.com/projectcalico/api/pkg/lib/numorstring"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -244,7 +245,7 @@ func (in *IPAMConfig) DeepCopyInto(out *IPAMConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
@@ -302,6 +303,13 @@ func (in *IPAMConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMConfigSpec) DeepCopyInto(out *IPAMConfigSpec) {
 	*out = *in
+	if in.IPQuotas != nil {
+		in, out := &in.IPQuotas, &out.IPQuotas
+		*out = make([]projectcalicov3.IPQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
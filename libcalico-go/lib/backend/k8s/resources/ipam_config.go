@@ -74,6 +74,7 @@ func (c ipamConfigClient) toV1(kvpv3 *model.KVPair) (*model.KVPair, error) {
 			StrictAffinity:     v3obj.Spec.StrictAffinity,
 			AutoAllocateBlocks: v3obj.Spec.AutoAllocateBlocks,
 			MaxBlocksPerHost:   v3obj.Spec.MaxBlocksPerHost,
+			IPQuotas:           v3obj.Spec.IPQuotas,
 		},
 		Revision: kvpv3.Revision,
 		UID:      &kvpv3.Value.(*libapiv3.IPAMConfig).UID,
@@ -105,6 +106,7 @@ func (c ipamConfigClient) toV3(kvpv1 *model.KVPair) *model.KVPair {
 				StrictAffinity:     v1obj.StrictAffinity,
 				AutoAllocateBlocks: v1obj.AutoAllocateBlocks,
 				MaxBlocksPerHost:   v1obj.MaxBlocksPerHost,
+				IPQuotas:           v1obj.IPQuotas,
 			},
 		},
 		Revision: kvpv1.Revision,
@@ -16,6 +16,8 @@ package model
 
 import (
 	"reflect"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 )
 
 const (
@@ -50,4 +52,6 @@ type IPAMConfig struct {
 	StrictAffinity     bool `json:"strict_affinity,omitempty"`
 	AutoAllocateBlocks bool `json:"auto_allocate_blocks,omitempty"`
 	MaxBlocksPerHost   int  `json:"maxBlocksPerHost,omitempty"`
+
+	IPQuotas []v3.IPQuota `json:"ipQuotas,omitempty"`
 }
@@ -161,6 +161,7 @@ type Policy struct {
 	Types            []string                      `json:"types,omitempty"`
 	PerformanceHints []apiv3.PolicyPerformanceHint `json:"performance_hints,omitempty" validate:"omitempty,unique,dive,oneof=AssumeNeededOnEveryNode"`
 	StagedAction     *apiv3.StagedAction           `json:"staged_action,omitempty"`
+	Schedule         *apiv3.PolicySchedule         `json:"schedule,omitempty"`
 }
 
 func (p Policy) String() string {
@@ -189,5 +190,8 @@ func (p Policy) String() string {
 	if p.StagedAction != nil {
 		parts = append(parts, fmt.Sprintf("staged_action:%v", p.StagedAction))
 	}
+	if p.Schedule != nil {
+		parts = append(parts, fmt.Sprintf("schedule:%+v", *p.Schedule))
+	}
 	return strings.Join(parts, ",")
 }
@@ -55,6 +55,7 @@ type Rule struct {
 	DstPorts            []numorstring.Port `json:"dst_ports,omitempty" validate:"omitempty,dive"`
 	DstService          string             `json:"dst_service,omitempty" validate:"omitempty"`
 	DstServiceNamespace string             `json:"dst_service_ns,omitempty" validate:"omitempty"`
+	DstDomains          []string           `json:"dst_domains,omitempty" validate:"omitempty"`
 
 	NotSrcTag      string             `json:"!src_tag,omitempty" validate:"omitempty,tag"`
 	NotSrcNet      *net.IPNet         `json:"!src_net,omitempty" validate:"omitempty"`
@@ -86,12 +87,26 @@ type Rule struct {
 
 	LogPrefix string `json:"log_prefix,omitempty" validate:"omitempty"`
 
+	RateLimit *RuleRateLimit `json:"rate_limit,omitempty" validate:"omitempty"`
+
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
 }
 
 type HTTPMatch struct {
-	Methods []string         `json:"methods,omitempty" validate:"omitempty"`
-	Paths   []apiv3.HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	Methods     []string                `json:"methods,omitempty" validate:"omitempty"`
+	Paths       []apiv3.HTTPPath        `json:"paths,omitempty" validate:"omitempty"`
+	Headers     []apiv3.HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty"`
+	Hosts       []string                `json:"hosts,omitempty" validate:"omitempty"`
+	GRPCMethods []apiv3.GRPCMethodMatch `json:"grpc_methods,omitempty" validate:"omitempty"`
+}
+
+// RuleRateLimit is the backend form of the v3 rule rate limit.  A zero rate means that
+// the rate isn't limited; a zero burst means that the burst defaults to the rate.
+type RuleRateLimit struct {
+	ConnectionsPerSecond uint32 `json:"conns_per_sec,omitempty"`
+	PacketsPerSecond     uint32 `json:"pkts_per_sec,omitempty"`
+	Burst                uint32 `json:"burst,omitempty"`
+	PerSourceIP          bool   `json:"per_source_ip,omitempty"`
 }
 
 type RuleMetadata struct {
@@ -230,6 +245,9 @@ func (r Rule) String() string {
 		if len(dstNets) != 0 {
 			toParts = append(toParts, "cidr", joinNets(dstNets))
 		}
+		if len(r.DstDomains) != 0 {
+			toParts = append(toParts, "domains", strings.Join(r.DstDomains, ","))
+		}
 		if len(r.NotDstPorts) > 0 {
 			notDstPorts := make([]string, len(r.NotDstPorts))
 			for ii, port := range r.NotDstPorts {
@@ -256,6 +274,15 @@ func (r Rule) String() string {
 			if len(r.HTTPMatch.Paths) > 0 {
 				toParts = append(toParts, "httpPaths", fmt.Sprintf("%+v", r.HTTPMatch.Paths))
 			}
+			if len(r.HTTPMatch.Headers) > 0 {
+				toParts = append(toParts, "httpHeaders", fmt.Sprintf("%+v", r.HTTPMatch.Headers))
+			}
+			if len(r.HTTPMatch.Hosts) > 0 {
+				toParts = append(toParts, "httpHosts", fmt.Sprintf("%+v", r.HTTPMatch.Hosts))
+			}
+			if len(r.HTTPMatch.GRPCMethods) > 0 {
+				toParts = append(toParts, "grpcMethods", fmt.Sprintf("%+v", r.HTTPMatch.GRPCMethods))
+			}
 		}
 
 		if len(toParts) > 0 {
@@ -264,5 +291,9 @@ func (r Rule) String() string {
 		}
 	}
 
+	if r.RateLimit != nil {
+		parts = append(parts, "limit", fmt.Sprintf("%+v", *r.RateLimit))
+	}
+
 	return strings.Join(parts, " ")
 }
@@ -83,6 +83,7 @@ func ConvertGlobalNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error)
 		PreDNAT:          spec.PreDNAT,
 		ApplyOnForward:   spec.ApplyOnForward,
 		PerformanceHints: v3res.Spec.PerformanceHints,
+		Schedule:         spec.Schedule,
 	}
 
 	return v1value, nil
@@ -15,6 +15,8 @@
 package updateprocessors_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -126,6 +128,23 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: nil}}))
 		})
 
+		It("should pass through the schedule of a GlobalNetworkPolicy", func() {
+			scheduledGNP := fullGNPv3(ns1, selector)
+			scheduledGNP.Name = "scheduled"
+			scheduledGNP.Spec.Schedule = &apiv3.PolicySchedule{
+				NotAfter: &metav1.Time{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+			}
+			scheduledGNPKey := model.ResourceKey{Kind: apiv3.KindGlobalNetworkPolicy, Name: "scheduled"}
+			kvps, err := up.Process(&model.KVPair{Key: scheduledGNPKey, Value: scheduledGNP, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+
+			policy := fullGNPv1()
+			policy.Selector = `mylabel == 'selectme'`
+			policy.Schedule = scheduledGNP.Spec.Schedule
+			v1Key := model.PolicyKey{Tier: "default", Name: "scheduled"}
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
+		})
+
 		It("should NOT accept a GlobalNetworkPolicy with the wrong Key type", func() {
 			_, err := up.Process(&model.KVPair{
 				Key:      model.GlobalBGPPeerKey{PeerIP: cnet.MustParseIP("1.2.3.4")},
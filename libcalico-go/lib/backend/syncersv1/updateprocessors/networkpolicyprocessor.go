@@ -80,6 +80,7 @@ func ConvertNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error) {
 		Types:            policyTypesAPIV3ToBackend(spec.Types),
 		ApplyOnForward:   false,
 		PerformanceHints: v3res.Spec.PerformanceHints,
+		Schedule:         spec.Schedule,
 	}
 
 	return v1value, nil
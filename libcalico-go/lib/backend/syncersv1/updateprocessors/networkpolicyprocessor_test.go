@@ -16,6 +16,7 @@ package updateprocessors_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -101,6 +102,25 @@ var _ = Describe("Test the NetworkPolicy update processor", func() {
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: nil}}))
 		})
 
+		It("should pass through the schedule of a NetworkPolicy", func() {
+			scheduledNP := fullNPv3("scheduled", ns2, selector)
+			scheduledNP.Spec.Schedule = &apiv3.PolicySchedule{
+				Windows: []apiv3.PolicyScheduleWindow{{
+					Start:    "0 2 * * 6",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				}},
+			}
+			scheduledNPKey := model.ResourceKey{Kind: apiv3.KindNetworkPolicy, Name: "scheduled", Namespace: ns2}
+			kvps, err := up.Process(&model.KVPair{Key: scheduledNPKey, Value: scheduledNP, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+
+			policy := fullNPv1(ns2)
+			policy.Selector = fmt.Sprintf("(mylabel == 'selectme') && projectcalico.org/namespace == '%s'", ns2)
+			policy.Schedule = scheduledNP.Spec.Schedule
+			v1Key := model.PolicyKey{Tier: "default", Name: ns2 + "/scheduled"}
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
+		})
+
 		It("should NOT accept a NetworkPolicy with the wrong Key type", func() {
 			_, err := up.Process(&model.KVPair{
 				Key:      model.GlobalBGPPeerKey{PeerIP: cnet.MustParseIP("1.2.3.4")},
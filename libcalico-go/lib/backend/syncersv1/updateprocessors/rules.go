@@ -181,6 +181,7 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		DstPorts:            ar.Destination.Ports,
 		DstService:          dstService,
 		DstServiceNamespace: dstServiceNS,
+		DstDomains:          ar.Destination.Domains,
 
 		NotSrcNets:     ConvertStringsToNets(ar.Source.NotNets),
 		NotSrcSelector: ar.Source.NotSelector,
@@ -202,7 +203,27 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		OriginalDstServiceAccountSelector: dstServiceAcctMatch.Selector,
 	}
 	if ar.HTTP != nil {
-		r.HTTPMatch = &model.HTTPMatch{Methods: ar.HTTP.Methods, Paths: ar.HTTP.Paths}
+		r.HTTPMatch = &model.HTTPMatch{
+			Methods:     ar.HTTP.Methods,
+			Paths:       ar.HTTP.Paths,
+			Headers:     ar.HTTP.Headers,
+			Hosts:       ar.HTTP.Hosts,
+			GRPCMethods: ar.HTTP.GRPCMethods,
+		}
+	}
+	if ar.RateLimit != nil {
+		r.RateLimit = &model.RuleRateLimit{
+			PerSourceIP: ar.RateLimit.Per == apiv3.RuleRateLimitScopeSourceIP,
+		}
+		if ar.RateLimit.ConnectionsPerSecond != nil {
+			r.RateLimit.ConnectionsPerSecond = *ar.RateLimit.ConnectionsPerSecond
+		}
+		if ar.RateLimit.PacketsPerSecond != nil {
+			r.RateLimit.PacketsPerSecond = *ar.RateLimit.PacketsPerSecond
+		}
+		if ar.RateLimit.Burst != nil {
+			r.RateLimit.Burst = *ar.RateLimit.Burst
+		}
 	}
 	if ar.Metadata != nil {
 		if ar.Metadata.Annotations != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 )
@@ -474,6 +475,71 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 		})
 	})
 
+	It("should parse a destination rule domains match", func() {
+		r := apiv3.Rule{
+			Action: apiv3.Allow,
+			Destination: apiv3.EntityRule{
+				Domains: []string{"api.example.com", "*.example.org"},
+			},
+		}
+
+		// Process the rule in a namespaced policy and get the corresponding v1 representation.
+		rulev1 := updateprocessors.RuleAPIV3ToBackend(r, "namespace1")
+
+		By("generating an empty destination selector", func() {
+			Expect(rulev1.DstSelector).To(Equal(""))
+		})
+
+		By("copying the domains", func() {
+			Expect(rulev1.DstDomains).To(Equal([]string{"api.example.com", "*.example.org"}))
+		})
+	})
+
+	It("should parse a rule rate limit", func() {
+		rate := uint32(50)
+		burst := uint32(200)
+		r := apiv3.Rule{
+			Action: apiv3.Allow,
+			RateLimit: &apiv3.RuleRateLimit{
+				ConnectionsPerSecond: &rate,
+				Burst:                &burst,
+				Per:                  apiv3.RuleRateLimitScopeSourceIP,
+			},
+		}
+
+		rulev1 := updateprocessors.RuleAPIV3ToBackend(r, "namespace1")
+		Expect(rulev1.RateLimit).To(Equal(&model.RuleRateLimit{
+			ConnectionsPerSecond: 50,
+			Burst:                200,
+			PerSourceIP:          true,
+		}))
+	})
+
+	It("should parse HTTP header, host and gRPC method matches", func() {
+		present := true
+		r := apiv3.Rule{
+			Action: apiv3.Allow,
+			HTTP: &apiv3.HTTPMatch{
+				Headers: []apiv3.HTTPHeaderMatch{
+					{Name: "X-Tenant", Exact: "a"},
+					{Name: "Authorization", Present: &present},
+				},
+				Hosts:       []string{"api.example.com"},
+				GRPCMethods: []apiv3.GRPCMethodMatch{{Service: "helloworld.Greeter", Method: "SayHello"}},
+			},
+		}
+
+		rulev1 := updateprocessors.RuleAPIV3ToBackend(r, "namespace1")
+		Expect(rulev1.HTTPMatch.Headers).To(Equal([]apiv3.HTTPHeaderMatch{
+			{Name: "X-Tenant", Exact: "a"},
+			{Name: "Authorization", Present: &present},
+		}))
+		Expect(rulev1.HTTPMatch.Hosts).To(Equal([]string{"api.example.com"}))
+		Expect(rulev1.HTTPMatch.GRPCMethods).To(Equal([]apiv3.GRPCMethodMatch{
+			{Service: "helloworld.Greeter", Method: "SayHello"},
+		}))
+	})
+
 	It("should parse a source rule services match", func() {
 		r := apiv3.Rule{
 			Action: apiv3.Allow,
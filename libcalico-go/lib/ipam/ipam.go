@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"math/bits"
+	"reflect"
 	"runtime"
 	"strings"
 	"time"
@@ -333,8 +334,9 @@ func (c ipamClient) determinePools(ctx context.Context, requestedPoolNets []net.
 
 // prepareAffinityBlocksForHost returns a list of blocks affine to a node based on requested IP pools.
 // It also releases any emptied blocks still affine to this host but no longer part of an IP Pool which
-// selects this node. It returns matching pools, list of host-affine blocks and any error encountered.
-func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedPools []net.IPNet, version int, host string, rsvdAttr *HostReservedAttr, use v3.IPPoolAllowedUse) ([]v3.IPPool, []net.IPNet, error) {
+// selects this node, or outside of the node's topology range in a topology-aware pool. It returns matching
+// pools, list of host-affine blocks, the node's labels and any error encountered.
+func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedPools []net.IPNet, version int, host string, rsvdAttr *HostReservedAttr, use v3.IPPoolAllowedUse) ([]v3.IPPool, []net.IPNet, map[string]string, error) {
 	// Retrieve node for given hostname to use for ip pool node selection
 	var node *model.KVPair
 	var err error
@@ -349,13 +351,13 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 		node, err = c.client.Get(ctx, model.ResourceKey{Kind: libapiv3.KindNode, Name: host}, "")
 		if err != nil {
 			log.WithError(err).WithField("node", host).Error("failed to get node for host")
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		// Make sure the returned value is OK.
 		v3n, ok = node.Value.(*libapiv3.Node)
 		if !ok {
-			return nil, nil, fmt.Errorf("Datastore returned malformed node object")
+			return nil, nil, nil, fmt.Errorf("Datastore returned malformed node object")
 		}
 	} else {
 		// Special case for Service LoadBalancer that is affined to virtual node
@@ -366,16 +368,16 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 
 	maxPrefixLen, err := getMaxPrefixLen(version, rsvdAttr)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Determine the correct set of IP pools to use for this request.
 	poolsSelectingNode, allPools, err := c.determinePools(ctx, requestedPools, version, *v3n, maxPrefixLen)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if len(poolsSelectingNode) == 0 {
-		return nil, nil, fmt.Errorf("no configured Calico pools for node %s", host)
+		return nil, nil, nil, fmt.Errorf("no configured Calico pools for node %s", host)
 	}
 
 	// Figure out what subset of the selecting pools we're allowed to use for the request according to the
@@ -385,7 +387,7 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 
 	// If there are no allowed pools, we cannot assign addresses.
 	if len(poolsAllowedByUse) == 0 {
-		return nil, nil, fmt.Errorf("%w, no pools match the required use (%v)", ErrNoQualifiedPool, use)
+		return nil, nil, nil, fmt.Errorf("%w, no pools match the required use (%v)", ErrNoQualifiedPool, use)
 	}
 
 	logCtx := log.WithFields(log.Fields{"host": host})
@@ -394,21 +396,28 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 	logCtx.Info("Looking up existing affinities for host")
 	allAffBlocks, err := c.blockReaderWriter.getAffineBlocks(ctx, affinityCfg, version)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Split the blocks into ones that we're allowed to use and ones that we're not allowed to use for this
 	// allocation.
 	allowedAffBlocks, nonAllowedAffBlocks, err := filterBlocksByPools(allAffBlocks, poolsAllowedByUse)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	// Further, split the non-allowed blocks into ones that are from pools that select this node and pools that
 	// don't select this node.  We'll try to release the latter below.
 	_, affBlocksToRelease, err := filterBlocksByPools(nonAllowedAffBlocks, poolsSelectingNode)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
+	// Blocks in topology-aware pools that are outside of the node's topology range are left over from before
+	// the node's or the pool's topology changed.  Stop using them, and try to release them below.
+	allowedAffBlocks, outOfRangeAffBlocks, err := filterBlocksByTopology(allowedAffBlocks, poolsAllowedByUse, v3n.Labels)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	affBlocksToRelease = append(affBlocksToRelease, outOfRangeAffBlocks...)
 
 	// Release any emptied blocks still affine to this host but no longer part of an IP Pool which selects this node.
 	for _, block := range affBlocksToRelease {
@@ -429,7 +438,7 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 			logCtx.WithError(err).WithField("pool", pool).Error("Failed to determine if node matches pool, skipping")
 			continue
 		}
-		if blockSelectsNode {
+		if blockSelectsNode && inTopologyRange(*pool, v3n.Labels, block) {
 			logCtx.WithFields(log.Fields{"pool": pool, "block": block}).Debug("Block's pool still selects node, refusing to remove affinity")
 			continue
 		}
@@ -453,7 +462,7 @@ func (c ipamClient) prepareAffinityBlocksForHost(ctx context.Context, requestedP
 		}
 	}
 
-	return poolsAllowedByUse, allowedAffBlocks, nil
+	return poolsAllowedByUse, allowedAffBlocks, v3n.Labels, nil
 }
 
 // filterPoolsByUse returns a slice containing the subset of the input pools that are allowed for the given use.
@@ -478,6 +487,7 @@ type blockAssignState struct {
 	affinityCfg           AffinityConfig
 	pools                 []v3.IPPool
 	remainingAffineBlocks []net.IPNet
+	nodeLabels            map[string]string
 	hostReservedAttr      *HostReservedAttr
 	allowNewClaim         bool
 	reservations          addrFilter
@@ -558,7 +568,7 @@ func (s *blockAssignState) findOrClaimBlock(ctx context.Context, minFreeIps int)
 			// allocated affine block. This may happen due to a race condition where another process on the host allocates a new block
 			// after we decide that a new block is required to satisfy this request, but before we actually allocate a new block.
 			logCtx.Info("Tried all affine blocks. Looking for an affine block with space, or a new unclaimed block")
-			subnet, err := s.client.blockReaderWriter.findUsableBlock(ctx, s.affinityCfg, s.version, s.pools, s.reservations, *config)
+			subnet, err := s.client.blockReaderWriter.findUsableBlock(ctx, s.affinityCfg, s.version, s.pools, s.nodeLabels, s.reservations, *config)
 			if err != nil {
 				if _, ok := err.(noFreeBlocksError); ok {
 					// No free blocks.  Break.
@@ -689,7 +699,7 @@ func (c ipamClient) autoAssign(ctx context.Context, num int, handleID *string, a
 		logCtx = logCtx.WithField("handle", *handleID)
 	}
 	logCtx.Info("Looking up existing affinities for host")
-	pools, affBlocks, err := c.prepareAffinityBlocksForHost(ctx, requestedPools, version, host, rsvdAttr, use)
+	pools, affBlocks, nodeLabels, err := c.prepareAffinityBlocksForHost(ctx, requestedPools, version, host, rsvdAttr, use)
 	if err != nil {
 		return nil, err
 	}
@@ -704,6 +714,18 @@ func (c ipamClient) autoAssign(ctx context.Context, num int, handleID *string, a
 		return nil, err
 	}
 
+	// Drop any pools that the requesting namespace has used up its IP quota for.
+	if len(config.IPQuotas) > 0 {
+		pools, err = c.poolsWithinQuota(ctx, config.IPQuotas, attrs[AttributeNamespace], pools, num)
+		if err != nil {
+			return nil, err
+		}
+		affBlocks, _, err = filterBlocksByPools(affBlocks, pools)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Merge in any global config, if it exists. We use the more restrictive value between
 	// the global max block limit, and the limit provided on this particular request.
 	if config.MaxBlocksPerHost > 0 && maxNumBlocks > 0 && maxNumBlocks > config.MaxBlocksPerHost {
@@ -736,6 +758,7 @@ func (c ipamClient) autoAssign(ctx context.Context, num int, handleID *string, a
 		affinityCfg:           affinityCfg,
 		pools:                 pools,
 		remainingAffineBlocks: affBlocks,
+		nodeLabels:            nodeLabels,
 		hostReservedAttr:      rsvdAttr,
 		allowNewClaim:         true,
 		reservations:          reservations,
@@ -827,7 +850,7 @@ func (c ipamClient) autoAssign(ctx context.Context, num int, handleID *string, a
 		exhaustedPools := []string{}
 		for _, p := range pools {
 			logCtx.Debugf("Assigning from non-affine blocks in pool %s", p.Spec.CIDR)
-			newBlockCIDR := randomBlockGenerator(p, host)
+			newBlockCIDR := nodeBlockGenerator(p, host, nodeLabels)
 			for rem > 0 {
 				// Grab a new random block.
 				blockCIDR := newBlockCIDR()
@@ -914,6 +937,10 @@ func (c ipamClient) AssignIP(ctx context.Context, args AssignIPArgs) error {
 		return err
 	}
 
+	if _, err := c.poolsWithinQuota(ctx, cfg.IPQuotas, args.Attrs[AttributeNamespace], []v3.IPPool{*pool}, 1); err != nil {
+		return err
+	}
+
 	blockCIDR := getBlockCIDRForAddress(args.IP, pool)
 	log.Debugf("IP %s is in block '%s'", args.IP.String(), blockCIDR.String())
 	for i := 0; i < datastoreRetries; i++ {
@@ -1260,7 +1287,8 @@ func (c ipamClient) assignFromExistingBlock(ctx context.Context, block *model.KV
 // ClaimAffinity makes a best effort to claim affinity to the given host for all blocks
 // within the given CIDR.  The given CIDR must fall within a configured
 // pool.  Returns a list of blocks that were claimed, as well as a
-// list of blocks that were claimed by another host.
+// list of blocks that were claimed by another host, or that are outside of
+// the host's topology range in a topology-aware pool.
 // If an empty string is passed as the host, then the hostname is automatically detected.
 func (c ipamClient) ClaimAffinity(ctx context.Context, cidr net.IPNet, affinityCfg AffinityConfig) ([]net.IPNet, []net.IPNet, error) {
 	logCtx := log.WithFields(log.Fields{string(affinityCfg.AffinityType): affinityCfg.Host, "cidr": cidr})
@@ -1296,9 +1324,25 @@ func (c ipamClient) ClaimAffinity(ctx context.Context, cidr net.IPNet, affinityC
 		return nil, nil, err
 	}
 
+	// For a topology-aware pool, look up the node's labels to find its topology range.
+	var nodeLabels map[string]string
+	if pool.Spec.Topology != nil && affinityCfg.AffinityType == AffinityTypeHost {
+		node, err := c.client.Get(ctx, model.ResourceKey{Kind: libapiv3.KindNode, Name: hostname}, "")
+		if err != nil {
+			logCtx.WithError(err).Error("Failed to get node for host")
+			return nil, nil, err
+		}
+		nodeLabels = node.Value.(*libapiv3.Node).Labels
+	}
+
 	// Claim all blocks within the given cidr.
 	blocks := blockGenerator(pool, cidr)
 	for blockCIDR := blocks(); blockCIDR != nil; blockCIDR = blocks() {
+		if !inTopologyRange(*pool, nodeLabels, *blockCIDR) {
+			logCtx.Debugf("Block %s is outside of the host's topology range", blockCIDR.String())
+			failed = append(failed, *blockCIDR)
+			continue
+		}
 		for i := 0; i < datastoreRetries; i++ {
 			// First, claim a pending affinity.
 			pa, err := c.blockReaderWriter.getPendingAffinity(ctx, affinityCfg, *blockCIDR)
@@ -1961,7 +2005,7 @@ func (c ipamClient) SetIPAMConfig(ctx context.Context, cfg IPAMConfig) error {
 		return err
 	}
 
-	if *current == cfg {
+	if reflect.DeepEqual(*current, cfg) {
 		return nil
 	}
 
@@ -2007,6 +2051,7 @@ func (c ipamClient) convertIPAMConfigToBackend(cfg *IPAMConfig) *model.IPAMConfi
 		StrictAffinity:     cfg.StrictAffinity,
 		AutoAllocateBlocks: cfg.AutoAllocateBlocks,
 		MaxBlocksPerHost:   cfg.MaxBlocksPerHost,
+		IPQuotas:           cfg.IPQuotas,
 	}
 }
 
@@ -2015,6 +2060,7 @@ func (c ipamClient) convertBackendToIPAMConfig(cfg *model.IPAMConfig) *IPAMConfi
 		StrictAffinity:     cfg.StrictAffinity,
 		AutoAllocateBlocks: cfg.AutoAllocateBlocks,
 		MaxBlocksPerHost:   cfg.MaxBlocksPerHost,
+		IPQuotas:           cfg.IPQuotas,
 	}
 }
 
@@ -2150,9 +2196,11 @@ func (c ipamClient) GetUtilization(ctx context.Context, args GetUtilizationArgs)
 	if err != nil {
 		return nil, err
 	}
+	var allBlocks []*model.AllocationBlock
 	for _, kvp := range blocks.KVPairs {
 		b := kvp.Value.(*model.AllocationBlock)
 		log.Debugf("Got block: %v", b)
+		allBlocks = append(allBlocks, b)
 
 		// Find which pool this block belongs to.
 		for _, poolUse := range usage {
@@ -2167,6 +2215,36 @@ func (c ipamClient) GetUtilization(ctx context.Context, args GetUtilizationArgs)
 			}
 		}
 	}
+
+	// Report the usage of any IP quotas against the pools that they cover.  Read the config
+	// directly rather than through GetIPAMConfig, which would create it if it doesn't exist.
+	cfg, err := c.client.Get(ctx, model.IPAMConfigKey{}, "")
+	if err != nil {
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			return usage, nil
+		}
+		return nil, err
+	}
+	labelsFor := func(ns string) (map[string]string, error) {
+		return c.namespaceLabels(ctx, ns)
+	}
+	quotas := newQuotaUsage(allPools, allBlocks, labelsFor)
+	for _, q := range cfg.Value.(*model.IPAMConfig).IPQuotas {
+		quotaUse, err := quotas.utilization(q)
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range allPools {
+			if !covers(q, pool) {
+				continue
+			}
+			for _, poolUse := range usage {
+				if poolUse.Name == pool.Name {
+					poolUse.Quotas = append(poolUse.Quotas, quotaUse...)
+				}
+			}
+		}
+	}
 	return usage, nil
 }
 
@@ -2257,7 +2335,7 @@ func (c ipamClient) ensureBlock(ctx context.Context, rsvdAttr *HostReservedAttr,
 	logCtx := log.WithFields(log.Fields{string(affinityCfg.AffinityType): affinityCfg.Host})
 
 	logCtx.Info("Looking up existing affinities for host")
-	pools, affBlocks, err := c.prepareAffinityBlocksForHost(ctx, requestedPools, version, affinityCfg.Host, rsvdAttr, v3.IPPoolAllowedUseWorkload)
+	pools, affBlocks, nodeLabels, err := c.prepareAffinityBlocksForHost(ctx, requestedPools, version, affinityCfg.Host, rsvdAttr, v3.IPPoolAllowedUseWorkload)
 	if err != nil {
 		return nil, err
 	}
@@ -2276,6 +2354,7 @@ func (c ipamClient) ensureBlock(ctx context.Context, rsvdAttr *HostReservedAttr,
 		affinityCfg:           affinityCfg,
 		pools:                 pools,
 		remainingAffineBlocks: affBlocks,
+		nodeLabels:            nodeLabels,
 		hostReservedAttr:      rsvdAttr,
 		allowNewClaim:         true,
 		reservations:          reservations,
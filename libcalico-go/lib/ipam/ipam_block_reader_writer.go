@@ -87,6 +87,24 @@ func filterBlocksByPools(blocks []cnet.IPNet, pools []v3.IPPool) (blocksInPools,
 	return
 }
 
+// filterBlocksByTopology splits the given blocks into those that the node with the given labels may use,
+// according to the topology of the pools that contain them, and those that it may not.
+func filterBlocksByTopology(blocks []cnet.IPNet, pools []v3.IPPool, nodeLabels map[string]string) (inRange, outOfRange []cnet.IPNet, err error) {
+	for _, block := range blocks {
+		var pool *v3.IPPool
+		pool, err = findContainingPool(pools, block.IP)
+		if err != nil {
+			return
+		}
+		if pool != nil && !inTopologyRange(*pool, nodeLabels, block) {
+			outOfRange = append(outOfRange, block)
+		} else {
+			inRange = append(inRange, block)
+		}
+	}
+	return
+}
+
 func findContainingPool(pools []v3.IPPool, addr net.IP) (*v3.IPPool, error) {
 	for _, pool := range pools {
 		var poolNet *cnet.IPNet
@@ -105,10 +123,11 @@ func findContainingPool(pools []v3.IPPool, addr net.IP) (*v3.IPPool, error) {
 
 // findUsableBlock finds a block cidr which either does not yet exist within the given list of pools, or does exist but is affine to this host
 // and has available address space. The provided pools should already be sanitized and only include existing, enabled pools.
+// Blocks in topology-aware pools are limited to those that the node with the given labels may claim.
 //
 // Note that the block may become claimed between receiving the CIDR from this function and attempting to claim the corresponding
 // block as this function does not reserve the returned IPNet.
-func (rw blockReaderWriter) findUsableBlock(ctx context.Context, affinityCfg AffinityConfig, version int, pools []v3.IPPool, reservations addrFilter, config IPAMConfig) (*cnet.IPNet, error) {
+func (rw blockReaderWriter) findUsableBlock(ctx context.Context, affinityCfg AffinityConfig, version int, pools []v3.IPPool, nodeLabels map[string]string, reservations addrFilter, config IPAMConfig) (*cnet.IPNet, error) {
 	// If there are no pools, we cannot assign addresses.
 	if len(pools) == 0 {
 		return nil, fmt.Errorf("no configured Calico pools for %s:%s", affinityCfg.AffinityType, affinityCfg.Host)
@@ -142,9 +161,9 @@ func (rw blockReaderWriter) findUsableBlock(ctx context.Context, affinityCfg Aff
 	// Iterate through pools to find a new block.
 	for _, pool := range pools {
 		// Use a block generator to iterate through all of the blocks
-		// that fall within the pool.
+		// that fall within the pool and that this node may claim.
 		log.Debugf("Looking for blocks in pool %+v", pool)
-		blocks := randomBlockGenerator(pool, affinityCfg.Host)
+		blocks := nodeBlockGenerator(pool, affinityCfg.Host, nodeLabels)
 		for subnet := blocks(); subnet != nil; subnet = blocks() {
 			// Check if the whole subnet is reserved.
 			if reservations.MatchesWholeCIDR(subnet) {
@@ -601,6 +620,30 @@ func randomBlockGenerator(ipPool v3.IPPool, hostName string) func() *cnet.IPNet
 	}
 }
 
+// Returns a generator like randomBlockGenerator, but which only returns the
+// blocks of a topology-aware pool that the node with the given labels may claim.
+func nodeBlockGenerator(ipPool v3.IPPool, hostName string, nodeLabels map[string]string) func() *cnet.IPNet {
+	include, exclude := topologyRanges(ipPool, nodeLabels)
+	if include != nil {
+		// Walk just the node's range, as though it were the whole pool.
+		ipPool.Spec.CIDR = include.String()
+		return randomBlockGenerator(ipPool, hostName)
+	}
+
+	blocks := randomBlockGenerator(ipPool, hostName)
+	if len(exclude) == 0 {
+		return blocks
+	}
+	return func() *cnet.IPNet {
+		for subnet := blocks(); subnet != nil; subnet = blocks() {
+			if inTopologyRange(ipPool, nodeLabels, *subnet) {
+				return subnet
+			}
+		}
+		return nil
+	}
+}
+
 // Find the block for a given IP (without needing a pool)
 func (rw blockReaderWriter) getBlockForIP(ctx context.Context, ip cnet.IP) (*cnet.IPNet, error) {
 	// Lookup all blocks by providing an empty BlockListOptions to the List operation.
@@ -18,6 +18,20 @@ import (
 	"fmt"
 )
 
+// QuotaExceededError indicates that an assignment was refused because it would take
+// a namespace over one of its IP quotas.
+type QuotaExceededError struct {
+	Quota     string
+	Namespace string
+	MaxIPs    int
+	InUse     int
+}
+
+func (e QuotaExceededError) Error() string {
+	return fmt.Sprintf("IP quota '%s' exceeded for namespace '%s': %d of %d addresses in use",
+		e.Quota, e.Namespace, e.InUse, e.MaxIPs)
+}
+
 // invalidSizeError indicates that the requested IP network size is not valid.
 type invalidSizeError string
 
@@ -0,0 +1,325 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+const (
+	// Namespace labels are read from the profile that backs each Kubernetes namespace.  These
+	// mirror the names used by the Kubernetes conversion code, which we don't import here to
+	// keep the IPAM client free of Kubernetes dependencies.
+	namespaceProfilePrefix = "kns."
+	namespaceLabelPrefix   = "pcns."
+	namespaceNameLabel     = "projectcalico.org/name"
+)
+
+// namespaceLabels returns the labels of the given namespace, as used by IP quota namespace
+// selectors.  If the namespace has no profile, only its name label is returned.
+func (c ipamClient) namespaceLabels(ctx context.Context, namespace string) (map[string]string, error) {
+	labels := map[string]string{namespaceNameLabel: namespace}
+	kvp, err := c.client.Get(ctx, model.ResourceKey{Kind: v3.KindProfile, Name: namespaceProfilePrefix + namespace}, "")
+	if err != nil {
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			return labels, nil
+		}
+		return nil, err
+	}
+	for k, v := range kvp.Value.(*v3.Profile).Spec.LabelsToApply {
+		if strings.HasPrefix(k, namespaceLabelPrefix) {
+			labels[strings.TrimPrefix(k, namespaceLabelPrefix)] = v
+		}
+	}
+	return labels, nil
+}
+
+// quotaUsage counts the addresses allocated against IP quotas from a snapshot of the pools
+// and allocation blocks.  Namespace labels are looked up as needed and cached.
+type quotaUsage struct {
+	pools       []v3.IPPool
+	blocks      []*model.AllocationBlock
+	labelsFor   func(namespace string) (map[string]string, error)
+	labelsCache map[string]map[string]string
+}
+
+func newQuotaUsage(pools []v3.IPPool, blocks []*model.AllocationBlock, labelsFor func(string) (map[string]string, error)) *quotaUsage {
+	return &quotaUsage{
+		pools:       pools,
+		blocks:      blocks,
+		labelsFor:   labelsFor,
+		labelsCache: map[string]map[string]string{},
+	}
+}
+
+func (u *quotaUsage) labels(namespace string) (map[string]string, error) {
+	if l, ok := u.labelsCache[namespace]; ok {
+		return l, nil
+	}
+	l, err := u.labelsFor(namespace)
+	if err != nil {
+		return nil, err
+	}
+	u.labelsCache[namespace] = l
+	return l, nil
+}
+
+// selects returns true if the quota applies to the given namespace.
+func (u *quotaUsage) selects(q v3.IPQuota, namespace string) (bool, error) {
+	if q.NamespaceSelector == "" {
+		return true, nil
+	}
+	sel, err := selector.Parse(q.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	labels, err := u.labels(namespace)
+	if err != nil {
+		return false, err
+	}
+	return sel.Evaluate(labels), nil
+}
+
+// covers returns true if addresses from the given pool count towards the quota.
+func covers(q v3.IPQuota, pool v3.IPPool) bool {
+	if len(q.IPPools) == 0 {
+		return true
+	}
+	for _, name := range q.IPPools {
+		if name == pool.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// coversAny returns true if addresses from any of the given pools count towards the quota.
+func coversAny(q v3.IPQuota, pools []v3.IPPool) bool {
+	for _, p := range pools {
+		if covers(q, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// byNamespace returns the number of addresses allocated from the quota's pools to each of the
+// namespaces that it selects.  If only is set, just that namespace, which the quota must select,
+// is counted; the selector isn't evaluated for any others.
+func (u *quotaUsage) byNamespace(q v3.IPQuota, only string) (map[string]int, error) {
+	var pools []v3.IPPool
+	for _, p := range u.pools {
+		if covers(q, p) {
+			pools = append(pools, p)
+		}
+	}
+
+	counts := map[string]int{}
+	selected := map[string]bool{}
+	if only != "" {
+		selected[only] = true
+	}
+	for _, b := range u.blocks {
+		pool, err := findContainingPool(pools, b.CIDR.IP)
+		if err != nil {
+			return nil, err
+		}
+		if pool == nil {
+			continue
+		}
+		for _, idx := range b.Allocations {
+			if idx == nil || *idx >= len(b.Attributes) {
+				continue
+			}
+			ns := b.Attributes[*idx].AttrSecondary[AttributeNamespace]
+			if ns == "" || (only != "" && ns != only) {
+				continue
+			}
+			sel, ok := selected[ns]
+			if !ok {
+				if sel, err = u.selects(q, ns); err != nil {
+					return nil, err
+				}
+				selected[ns] = sel
+			}
+			if sel {
+				counts[ns]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// inUse returns the number of addresses allocated against the quota by the given namespace,
+// which the quota must select.  For a Shared quota this includes the addresses of all of the
+// namespaces that it selects.
+func (u *quotaUsage) inUse(q v3.IPQuota, namespace string) (int, error) {
+	if q.Scope != v3.IPQuotaScopeShared {
+		counts, err := u.byNamespace(q, namespace)
+		if err != nil {
+			return 0, err
+		}
+		return counts[namespace], nil
+	}
+	counts, err := u.byNamespace(q, "")
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total, nil
+}
+
+// utilization reports the usage of the quota, with one entry per namespace for a PerNamespace
+// quota, sorted by namespace.
+func (u *quotaUsage) utilization(q v3.IPQuota) ([]QuotaUtilization, error) {
+	counts, err := u.byNamespace(q, "")
+	if err != nil {
+		return nil, err
+	}
+	if q.Scope == v3.IPQuotaScopeShared {
+		total := 0
+		for _, n := range counts {
+			total += n
+		}
+		return []QuotaUtilization{{Name: q.Name, MaxIPs: q.MaxIPs, InUse: total}}, nil
+	}
+	var usage []QuotaUtilization
+	for ns, n := range counts {
+		usage = append(usage, QuotaUtilization{Name: q.Name, Namespace: ns, MaxIPs: q.MaxIPs, InUse: n})
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].Namespace < usage[j].Namespace })
+	return usage, nil
+}
+
+// poolsWithinQuota returns the subset of the given pools from which num more addresses can be
+// assigned to the namespace without taking it over any of its IP quotas.  If the quotas rule
+// out all of the pools, it returns a QuotaExceededError for the first quota that was hit.
+//
+// Usage is counted from the allocation blocks at the time of the request, so concurrent
+// assignments on different hosts may take a namespace slightly over its quota.  Since this is
+// on the path of every assignment, we only read the blocks if a quota that applies to the
+// namespace covers one of the given pools, and we only count the blocks in the pools that such
+// quotas cover.
+func (c ipamClient) poolsWithinQuota(ctx context.Context, quotas []v3.IPQuota, namespace string, pools []v3.IPPool, num int) ([]v3.IPPool, error) {
+	if len(quotas) == 0 || namespace == "" {
+		return pools, nil
+	}
+
+	labelsFor := func(ns string) (map[string]string, error) {
+		return c.namespaceLabels(ctx, ns)
+	}
+	u := newQuotaUsage(nil, nil, labelsFor)
+
+	var applicable []v3.IPQuota
+	for _, q := range quotas {
+		if !coversAny(q, pools) {
+			continue
+		}
+		sel, err := u.selects(q, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if sel {
+			applicable = append(applicable, q)
+		}
+	}
+	if len(applicable) == 0 {
+		return pools, nil
+	}
+
+	allPools, err := c.pools.GetAllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ipVersions := map[int]bool{}
+	for _, p := range allPools {
+		for _, q := range applicable {
+			if covers(q, p) {
+				u.pools = append(u.pools, p)
+				cidr := net.MustParseCIDR(p.Spec.CIDR)
+				ipVersions[cidr.Version()] = true
+				break
+			}
+		}
+	}
+	listOpts := model.BlockListOptions{}
+	if len(ipVersions) == 1 {
+		for v := range ipVersions {
+			listOpts.IPVersion = v
+		}
+	}
+	blocks, err := c.client.List(ctx, listOpts, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, kvp := range blocks.KVPairs {
+		b := kvp.Value.(*model.AllocationBlock)
+		pool, err := findContainingPool(u.pools, b.CIDR.IP)
+		if err != nil {
+			return nil, err
+		}
+		if pool != nil {
+			u.blocks = append(u.blocks, b)
+		}
+	}
+
+	exceeded := map[string]bool{}
+	var firstErr error
+	for _, q := range applicable {
+		inUse, err := u.inUse(q, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if inUse+num > q.MaxIPs {
+			log.WithFields(log.Fields{"quota": q.Name, "namespace": namespace, "inUse": inUse, "maxIPs": q.MaxIPs}).Info(
+				"IP quota reached, excluding its pools from assignment")
+			exceeded[q.Name] = true
+			if firstErr == nil {
+				firstErr = QuotaExceededError{Quota: q.Name, Namespace: namespace, MaxIPs: q.MaxIPs, InUse: inUse}
+			}
+		}
+	}
+
+	var allowed []v3.IPPool
+	for _, p := range pools {
+		ok := true
+		for _, q := range applicable {
+			if exceeded[q.Name] && covers(q, p) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			allowed = append(allowed, p)
+		}
+	}
+	if len(allowed) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	return allowed, nil
+}
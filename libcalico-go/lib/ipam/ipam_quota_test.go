@@ -0,0 +1,401 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+func quotaTestUsage() *quotaUsage {
+	pool := func(name, cidr string) v3.IPPool {
+		p := v3.NewIPPool()
+		p.Name = name
+		p.Spec.CIDR = cidr
+		return *p
+	}
+	block := func(cidr string, namespaces ...string) *model.AllocationBlock {
+		b := &model.AllocationBlock{CIDR: net.MustParseCIDR(cidr)}
+		for i, ns := range namespaces {
+			idx := i
+			b.Allocations = append(b.Allocations, &idx)
+			b.Attributes = append(b.Attributes, model.AllocationAttribute{
+				AttrSecondary: map[string]string{AttributeNamespace: ns},
+			})
+		}
+		// A free address and an address that isn't held by a pod.
+		tunnelIdx := len(b.Attributes)
+		b.Allocations = append(b.Allocations, nil, &tunnelIdx)
+		b.Attributes = append(b.Attributes, model.AllocationAttribute{
+			AttrSecondary: map[string]string{AttributeNode: "node1", AttributeType: AttributeTypeVXLAN},
+		})
+		return b
+	}
+	tenants := map[string]string{"a1": "a", "a2": "a", "b1": "b"}
+	return newQuotaUsage(
+		[]v3.IPPool{pool("pool-1", "10.0.0.0/16"), pool("pool-2", "10.1.0.0/16")},
+		[]*model.AllocationBlock{
+			block("10.0.0.0/26", "a1", "a1", "a2", "b1"),
+			block("10.1.0.0/26", "a1", "b1"),
+			// A block that no longer belongs to a pool.
+			block("10.2.0.0/26", "a1"),
+		},
+		func(ns string) (map[string]string, error) {
+			return map[string]string{namespaceNameLabel: ns, "tenant": tenants[ns]}, nil
+		},
+	)
+}
+
+func TestQuotaUsage_InUse(t *testing.T) {
+	RegisterTestingT(t)
+	u := quotaTestUsage()
+
+	perNamespace := v3.IPQuota{Name: "per-ns", MaxIPs: 10}
+	Expect(u.inUse(perNamespace, "a1")).To(Equal(3))
+	Expect(u.inUse(perNamespace, "b1")).To(Equal(2))
+
+	onePool := v3.IPQuota{Name: "pool-1", IPPools: []string{"pool-1"}, MaxIPs: 10}
+	Expect(u.inUse(onePool, "a1")).To(Equal(2))
+
+	tenant := v3.IPQuota{Name: "tenant-a", NamespaceSelector: "tenant == 'a'", Scope: v3.IPQuotaScopeShared, MaxIPs: 10}
+	Expect(u.selects(tenant, "a2")).To(BeTrue())
+	Expect(u.selects(tenant, "b1")).To(BeFalse())
+	Expect(u.inUse(tenant, "a2")).To(Equal(4))
+}
+
+func TestQuotaUsage_Utilization(t *testing.T) {
+	RegisterTestingT(t)
+	u := quotaTestUsage()
+
+	Expect(u.utilization(v3.IPQuota{Name: "per-ns", IPPools: []string{"pool-1"}, MaxIPs: 10})).To(Equal([]QuotaUtilization{
+		{Name: "per-ns", Namespace: "a1", MaxIPs: 10, InUse: 2},
+		{Name: "per-ns", Namespace: "a2", MaxIPs: 10, InUse: 1},
+		{Name: "per-ns", Namespace: "b1", MaxIPs: 10, InUse: 1},
+	}))
+	Expect(u.utilization(v3.IPQuota{Name: "all", Scope: v3.IPQuotaScopeShared, MaxIPs: 10})).To(Equal([]QuotaUtilization{
+		{Name: "all", MaxIPs: 10, InUse: 6},
+	}))
+}
+
+// memClient is an in-memory backend client that records the reads that the IPAM client makes, so
+// that we can check how much datastore traffic quota enforcement causes.
+type memClient struct {
+	bapi.Client
+
+	lock     sync.Mutex
+	kvps     map[string]*model.KVPair
+	revision int
+	gets     []model.Key
+	lists    []model.ListInterface
+}
+
+func newMemClient() *memClient {
+	return &memClient{kvps: map[string]*model.KVPair{}}
+}
+
+func (c *memClient) path(key model.Key) string {
+	p, err := model.KeyToDefaultPath(key)
+	Expect(err).NotTo(HaveOccurred())
+	return p
+}
+
+func (c *memClient) Create(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p := c.path(kvp.Key)
+	if _, ok := c.kvps[p]; ok {
+		return nil, cerrors.ErrorResourceAlreadyExists{Identifier: kvp.Key}
+	}
+	return c.store(p, kvp), nil
+}
+
+func (c *memClient) Update(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p := c.path(kvp.Key)
+	existing, ok := c.kvps[p]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: kvp.Key}
+	}
+	if kvp.Revision != "" && kvp.Revision != existing.Revision {
+		return nil, cerrors.ErrorResourceUpdateConflict{Identifier: kvp.Key}
+	}
+	return c.store(p, kvp), nil
+}
+
+func (c *memClient) store(p string, kvp *model.KVPair) *model.KVPair {
+	c.revision++
+	stored := *kvp
+	stored.Revision = fmt.Sprint(c.revision)
+	c.kvps[p] = &stored
+	copied := stored
+	return &copied
+}
+
+func (c *memClient) DeleteKVP(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	return c.Delete(ctx, kvp.Key, kvp.Revision)
+}
+
+func (c *memClient) Delete(ctx context.Context, key model.Key, revision string) (*model.KVPair, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	p := c.path(key)
+	existing, ok := c.kvps[p]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: key}
+	}
+	delete(c.kvps, p)
+	return existing, nil
+}
+
+func (c *memClient) Get(ctx context.Context, key model.Key, revision string) (*model.KVPair, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.gets = append(c.gets, key)
+	kvp, ok := c.kvps[c.path(key)]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: key}
+	}
+	copied := *kvp
+	return &copied, nil
+}
+
+func (c *memClient) List(ctx context.Context, list model.ListInterface, revision string) (*model.KVPairList, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.lists = append(c.lists, list)
+	root := model.ListOptionsToDefaultPathRoot(list)
+	kvps := &model.KVPairList{Revision: fmt.Sprint(c.revision)}
+	for p, kvp := range c.kvps {
+		if strings.HasPrefix(p, root) && list.KeyFromDefaultPath(p) != nil {
+			copied := *kvp
+			kvps.KVPairs = append(kvps.KVPairs, &copied)
+		}
+	}
+	return kvps, nil
+}
+
+// profileGets returns the names of the profiles that have been read.
+func (c *memClient) profileGets() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var names []string
+	for _, k := range c.gets {
+		if rk, ok := k.(model.ResourceKey); ok && rk.Kind == v3.KindProfile {
+			names = append(names, rk.Name)
+		}
+	}
+	return names
+}
+
+// blockLists returns the block list options that have been used.
+func (c *memClient) blockLists() []model.BlockListOptions {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var lists []model.BlockListOptions
+	for _, l := range c.lists {
+		if bl, ok := l.(model.BlockListOptions); ok {
+			lists = append(lists, bl)
+		}
+	}
+	return lists
+}
+
+func (c *memClient) resetReads() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.gets = nil
+	c.lists = nil
+}
+
+// namedPools is a pool accessor for pools that have names, which IP quotas refer to.
+type namedPools []v3.IPPool
+
+func (p namedPools) GetEnabledPools(ctx context.Context, ipVersion int) ([]v3.IPPool, error) {
+	var pools []v3.IPPool
+	for _, pool := range p {
+		cidr := net.MustParseCIDR(pool.Spec.CIDR)
+		if ipVersion == 0 || cidr.Version() == ipVersion {
+			pools = append(pools, pool)
+		}
+	}
+	return pools, nil
+}
+
+func (p namedPools) GetAllPools(ctx context.Context) ([]v3.IPPool, error) {
+	return p, nil
+}
+
+// quotaTestClient returns an IPAM client with two IPv4 pools and an IPv6 pool, the given quotas,
+// and namespaces a1 and a2 of tenant a and b1 of tenant b.
+func quotaTestClient(quotas ...v3.IPQuota) (*memClient, Interface) {
+	pool := func(name, cidr string, blockSize int) v3.IPPool {
+		p := v3.NewIPPool()
+		p.Name = name
+		p.Spec.CIDR = cidr
+		p.Spec.BlockSize = blockSize
+		p.Spec.AllowedUses = []v3.IPPoolAllowedUse{v3.IPPoolAllowedUseWorkload}
+		automatic := v3.Automatic
+		p.Spec.AssignmentMode = &automatic
+		return *p
+	}
+	pools := namedPools{
+		pool("pool-1", "10.0.0.0/24", 26),
+		pool("pool-2", "10.1.0.0/24", 26),
+		pool("pool-v6", "fd00::/120", 122),
+	}
+
+	mc := newMemClient()
+	ctx := context.Background()
+	node := libapiv3.NewNode()
+	node.Name = "node1"
+	_, err := mc.Create(ctx, &model.KVPair{Key: model.ResourceKey{Kind: libapiv3.KindNode, Name: "node1"}, Value: node})
+	Expect(err).NotTo(HaveOccurred())
+	for ns, tenant := range map[string]string{"a1": "a", "a2": "a", "b1": "b"} {
+		profile := v3.NewProfile()
+		profile.Name = namespaceProfilePrefix + ns
+		profile.Spec.LabelsToApply = map[string]string{namespaceLabelPrefix + "tenant": tenant}
+		_, err := mc.Create(ctx, &model.KVPair{Key: model.ResourceKey{Kind: v3.KindProfile, Name: profile.Name}, Value: profile})
+		Expect(err).NotTo(HaveOccurred())
+	}
+	_, err = mc.Create(ctx, &model.KVPair{Key: model.IPAMConfigKey{}, Value: &model.IPAMConfig{
+		AutoAllocateBlocks: true,
+		MaxBlocksPerHost:   0,
+		IPQuotas:           quotas,
+	}})
+	Expect(err).NotTo(HaveOccurred())
+
+	return mc, NewIPAMClient(mc, pools, &fakeReservations{})
+}
+
+func autoAssignTo(c Interface, namespace string, num int, pools ...string) (*IPAMAssignments, error) {
+	args := AutoAssignArgs{
+		Num4:        num,
+		Attrs:       map[string]string{AttributeNamespace: namespace},
+		Hostname:    "node1",
+		IntendedUse: v3.IPPoolAllowedUseWorkload,
+	}
+	for _, p := range pools {
+		args.IPv4Pools = append(args.IPv4Pools, net.MustParseCIDR(p))
+	}
+	v4, _, err := c.AutoAssign(context.Background(), args)
+	return v4, err
+}
+
+func TestQuota_AutoAssign(t *testing.T) {
+	RegisterTestingT(t)
+	mc, c := quotaTestClient(v3.IPQuota{
+		Name:              "tenant-a",
+		NamespaceSelector: "tenant == 'a'",
+		IPPools:           []string{"pool-1"},
+		MaxIPs:            2,
+	})
+
+	// Namespaces that the quota doesn't select aren't limited.
+	_, err := autoAssignTo(c, "b1", 3, "10.0.0.0/24")
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = autoAssignTo(c, "a1", 2, "10.0.0.0/24")
+	Expect(err).NotTo(HaveOccurred())
+	_, err = autoAssignTo(c, "a2", 2, "10.0.0.0/24")
+	Expect(err).NotTo(HaveOccurred())
+
+	mc.resetReads()
+	_, err = autoAssignTo(c, "a1", 1, "10.0.0.0/24")
+	var quotaErr QuotaExceededError
+	Expect(errors.As(err, &quotaErr)).To(BeTrue(), fmt.Sprintf("unexpected error: %v", err))
+	Expect(quotaErr).To(Equal(QuotaExceededError{Quota: "tenant-a", Namespace: "a1", MaxIPs: 2, InUse: 2}))
+
+	// The quota is per namespace so only the requesting namespace's labels are needed, even
+	// though the pool has addresses from other namespaces.
+	Expect(mc.profileGets()).To(Equal([]string{"kns.a1"}))
+	Expect(mc.blockLists()).To(ContainElement(model.BlockListOptions{IPVersion: 4}))
+
+	// The quota doesn't cover pool-2 so the namespace can still get addresses from there.
+	v4, err := autoAssignTo(c, "a1", 1)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(v4.IPs).To(HaveLen(1))
+	pool2 := net.MustParseCIDR("10.1.0.0/24")
+	Expect(pool2.Contains(v4.IPs[0].IP)).To(BeTrue())
+}
+
+func TestQuota_AssignIP(t *testing.T) {
+	RegisterTestingT(t)
+	mc, c := quotaTestClient(v3.IPQuota{Name: "per-ns", IPPools: []string{"pool-1"}, MaxIPs: 1})
+	ctx := context.Background()
+	assign := func(namespace, ip string) error {
+		return c.AssignIP(ctx, AssignIPArgs{
+			IP:       net.MustParseIP(ip),
+			Attrs:    map[string]string{AttributeNamespace: namespace},
+			Hostname: "node1",
+		})
+	}
+
+	Expect(assign("a1", "10.0.0.1")).To(Succeed())
+	Expect(assign("b1", "10.0.0.2")).To(Succeed())
+
+	err := assign("a1", "10.0.0.3")
+	var quotaErr QuotaExceededError
+	Expect(errors.As(err, &quotaErr)).To(BeTrue(), fmt.Sprintf("unexpected error: %v", err))
+	Expect(quotaErr.InUse).To(Equal(1))
+
+	// Assigning from a pool that no quota covers doesn't read the blocks to count usage.
+	mc.resetReads()
+	Expect(assign("a1", "10.1.0.1")).To(Succeed())
+	Expect(mc.blockLists()).To(BeEmpty())
+	Expect(mc.profileGets()).To(BeEmpty())
+}
+
+func TestQuota_GetUtilization(t *testing.T) {
+	RegisterTestingT(t)
+	_, c := quotaTestClient(
+		v3.IPQuota{Name: "per-ns", IPPools: []string{"pool-1"}, MaxIPs: 10},
+		v3.IPQuota{Name: "tenant-a", NamespaceSelector: "tenant == 'a'", Scope: v3.IPQuotaScopeShared, MaxIPs: 10},
+	)
+	for ns, num := range map[string]int{"a1": 2, "a2": 1, "b1": 3} {
+		_, err := autoAssignTo(c, ns, num, "10.0.0.0/24")
+		Expect(err).NotTo(HaveOccurred())
+	}
+	_, err := autoAssignTo(c, "a1", 1, "10.1.0.0/24")
+	Expect(err).NotTo(HaveOccurred())
+
+	usage, err := c.GetUtilization(context.Background(), GetUtilizationArgs{Pools: []string{"pool-1", "pool-2"}})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(usage).To(HaveLen(2))
+	Expect(usage[0].Name).To(Equal("pool-1"))
+	Expect(usage[0].Quotas).To(Equal([]QuotaUtilization{
+		{Name: "per-ns", Namespace: "a1", MaxIPs: 10, InUse: 2},
+		{Name: "per-ns", Namespace: "a2", MaxIPs: 10, InUse: 1},
+		{Name: "per-ns", Namespace: "b1", MaxIPs: 10, InUse: 3},
+		{Name: "tenant-a", MaxIPs: 10, InUse: 4},
+	}))
+	Expect(usage[1].Name).To(Equal("pool-2"))
+	Expect(usage[1].Quotas).To(Equal([]QuotaUtilization{
+		{Name: "tenant-a", MaxIPs: 10, InUse: 4},
+	}))
+}
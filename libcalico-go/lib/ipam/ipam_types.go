@@ -108,6 +108,10 @@ type IPAMConfig struct {
 	// If non-zero, MaxBlocksPerHost specifies the max number of blocks that may
 	// be affine to a node.
 	MaxBlocksPerHost int
+
+	// IPQuotas limits the number of addresses that may be assigned to pods in the
+	// selected namespaces.
+	IPQuotas []v3.IPQuota
 }
 
 // GetUtilizationArgs defines the set of arguments for requesting IP utilization.
@@ -139,6 +143,26 @@ type PoolUtilization struct {
 
 	// Utilization for each of this pool's blocks.
 	Blocks []BlockUtilization
+
+	// Usage of the IP quotas that cover this pool.  A quota that covers several pools
+	// reports its usage across all of them.
+	Quotas []QuotaUtilization
+}
+
+// QuotaUtilization reports the usage of an IP quota.
+type QuotaUtilization struct {
+	// The quota's name.
+	Name string
+
+	// For a PerNamespace quota, the namespace that this usage is for.  Empty for a
+	// Shared quota, whose usage covers all of its selected namespaces.
+	Namespace string
+
+	// The maximum number of addresses that the quota allows.
+	MaxIPs int
+
+	// Number of addresses allocated against the quota.
+	InUse int
 }
 
 type HostReservedAttr struct {
@@ -17,8 +17,10 @@ import (
 	"context"
 
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
 
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 )
 
@@ -45,3 +47,39 @@ func SelectsNode(pool v3.IPPool, n libapiv3.Node) (bool, error) {
 	// Return whether or not the selector matches.
 	return sel.Evaluate(n.Labels), nil
 }
+
+// topologyRanges returns the part of a topology-aware pool that a node may claim blocks from. If the
+// node's topology domain has a range, include is set to that range. Otherwise, include is nil and
+// exclude lists the ranges of all of the domains, which the node must not claim blocks from.
+func topologyRanges(pool v3.IPPool, nodeLabels map[string]string) (include *cnet.IPNet, exclude []cnet.IPNet) {
+	if pool.Spec.Topology == nil {
+		return nil, nil
+	}
+	value, hasLabel := nodeLabels[pool.Spec.Topology.Key]
+	for _, r := range pool.Spec.Topology.Ranges {
+		_, rangeNet, err := cnet.ParseCIDR(r.CIDR)
+		if err != nil {
+			log.WithError(err).WithField("pool", pool.Name).Warn("Ignoring invalid topology range")
+			continue
+		}
+		if hasLabel && r.Value == value {
+			return rangeNet, nil
+		}
+		exclude = append(exclude, *rangeNet)
+	}
+	return nil, exclude
+}
+
+// inTopologyRange returns true if the node may claim the given block from the pool.
+func inTopologyRange(pool v3.IPPool, nodeLabels map[string]string, block cnet.IPNet) bool {
+	include, exclude := topologyRanges(pool, nodeLabels)
+	if include != nil {
+		return include.Covers(block.IPNet)
+	}
+	for _, r := range exclude {
+		if r.IsNetOverlap(block.IPNet) {
+			return false
+		}
+	}
+	return true
+}
@@ -22,6 +22,8 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 )
 
 var _ = Describe("Random Block Generator", func() {
@@ -40,6 +42,61 @@ var _ = Describe("Random Block Generator", func() {
 	)
 })
 
+var _ = Describe("Topology-aware block generator", func() {
+	pool := v3.IPPool{Spec: v3.IPPoolSpec{
+		CIDR:      "10.0.0.0/24",
+		BlockSize: 26,
+		Topology: &v3.IPPoolTopology{
+			Key: "rack",
+			Ranges: []v3.IPPoolTopologyRange{
+				{Value: "r1", CIDR: "10.0.0.0/25"},
+				{Value: "r2", CIDR: "10.0.0.128/26"},
+			},
+		},
+	}}
+
+	allBlocks := func(labels map[string]string) []string {
+		var cidrs []string
+		blocks := nodeBlockGenerator(pool, "testHost", labels)
+		for blk := blocks(); blk != nil; blk = blocks() {
+			cidrs = append(cidrs, blk.String())
+		}
+		return cidrs
+	}
+
+	It("should only return blocks from the node's range", func() {
+		Expect(allBlocks(map[string]string{"rack": "r1"})).To(ConsistOf("10.0.0.0/26", "10.0.0.64/26"))
+		Expect(allBlocks(map[string]string{"rack": "r2"})).To(ConsistOf("10.0.0.128/26"))
+	})
+
+	It("should return blocks outside of all ranges for other nodes", func() {
+		Expect(allBlocks(map[string]string{"rack": "r3"})).To(ConsistOf("10.0.0.192/26"))
+		Expect(allBlocks(nil)).To(ConsistOf("10.0.0.192/26"))
+	})
+
+	It("should return all blocks for a pool without topology", func() {
+		plain := v3.IPPool{Spec: v3.IPPoolSpec{CIDR: "10.0.0.0/24", BlockSize: 26}}
+		blocks := nodeBlockGenerator(plain, "testHost", map[string]string{"rack": "r1"})
+		count := 0
+		for blk := blocks(); blk != nil; blk = blocks() {
+			count++
+		}
+		Expect(count).To(Equal(4))
+	})
+
+	It("should split affine blocks by topology range", func() {
+		blocks := []cnet.IPNet{
+			cnet.MustParseCIDR("10.0.0.0/26"),
+			cnet.MustParseCIDR("10.0.0.128/26"),
+			cnet.MustParseCIDR("10.1.0.0/26"),
+		}
+		inRange, outOfRange, err := filterBlocksByTopology(blocks, []v3.IPPool{pool}, map[string]string{"rack": "r1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inRange).To(Equal([]cnet.IPNet{blocks[0], blocks[2]}))
+		Expect(outOfRange).To(Equal([]cnet.IPNet{blocks[1]}))
+	})
+})
+
 func poolTest(cidr string, blockSize int) {
 	pools := []v3.IPPool{{Spec: v3.IPPoolSpec{CIDR: cidr, BlockSize: blockSize}}}
 	host := "testHost"
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day-of-month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day-of-week accepts 7 as well as 0 for Sunday; parseField folds 7 onto 0.
+	dowField = field{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Cron is a parsed five-field cron expression: "minute hour day-of-month month day-of-week".
+// Each field may be "*", a value, a range "a-b" or a comma-separated list of those, each
+// optionally followed by a step "/n".  Months and days of the week may also be given by their
+// three-letter English names.  As with the traditional cron, if both day-of-month and day-of-week
+// are restricted then a time matches if either of them matches.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// ParseCron parses a five-field cron expression.
+func ParseCron(expr string) (*Cron, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("cron expression %q should have 5 fields, found %d", expr, len(parts))
+	}
+	c := &Cron{
+		domStar: strings.HasPrefix(parts[2], "*"),
+		dowStar: strings.HasPrefix(parts[4], "*"),
+	}
+	var err error
+	for i, f := range []struct {
+		field
+		out *uint64
+	}{
+		{minuteField, &c.minute},
+		{hourField, &c.hour},
+		{domField, &c.dom},
+		{monthField, &c.month},
+		{dowField, &c.dow},
+	} {
+		if *f.out, err = parseField(parts[i], f.field); err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	return c, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(s, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+			}
+			step = n
+		}
+		var lo, hi int
+		switch {
+		case rangePart == "*":
+			lo, hi = f.min, f.max
+			if f.max == 7 {
+				// Don't double-count Sunday when stepping over the whole week.
+				hi = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			loStr, hiStr, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(loStr, f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(hiStr, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rangePart, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if hasStep {
+				// "a/n" means every n starting at a.
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, should be in range %d-%d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (c *Cron) hourMatches(t time.Time) bool {
+	return c.hour&(1<<uint(t.Hour())) != 0
+}
+
+func (c *Cron) minuteMatches(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0
+}
+
+// Next returns the first time strictly after t that matches the expression, or the zero time
+// if there is no match before limit.  The expression is interpreted in t's location.
+func (c *Cron) Next(t, limit time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for !t.After(limit) {
+		y, mon, d := t.Date()
+		var candidate time.Time
+		switch {
+		case !c.dayMatches(t):
+			candidate = time.Date(y, mon, d+1, 0, 0, 0, 0, loc)
+		case !c.hourMatches(t):
+			candidate = time.Date(y, mon, d, t.Hour()+1, 0, 0, 0, loc)
+		case !c.minuteMatches(t):
+			candidate = t.Add(time.Minute)
+		default:
+			return t
+		}
+		if !candidate.After(t) {
+			// Wall-clock arithmetic can go backwards around daylight saving changes; fall back
+			// to stepping so that we always make progress.
+			candidate = t.Add(time.Minute)
+		}
+		t = candidate
+	}
+	return time.Time{}
+}
+
+// Prev returns the latest time at or before t that matches the expression, or the zero time if
+// there is no match at or after limit.  The expression is interpreted in t's location.
+func (c *Cron) Prev(t, limit time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute)
+	for !t.Before(limit) {
+		y, mon, d := t.Date()
+		var candidate time.Time
+		switch {
+		case !c.dayMatches(t):
+			candidate = time.Date(y, mon, d, 0, 0, 0, 0, loc).Add(-time.Minute)
+		case !c.hourMatches(t):
+			candidate = time.Date(y, mon, d, t.Hour(), 0, 0, 0, loc).Add(-time.Minute)
+		case !c.minuteMatches(t):
+			candidate = t.Add(-time.Minute)
+		default:
+			return t
+		}
+		if !candidate.Before(t) {
+			candidate = t.Add(-time.Minute)
+		}
+		t = candidate
+	}
+	return time.Time{}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
+)
+
+func mustParseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	Expect(err).NotTo(HaveOccurred())
+	return t
+}
+
+var _ = Describe("Cron", func() {
+	DescribeTable("rejecting invalid expressions",
+		func(expr string) {
+			_, err := schedule.ParseCron(expr)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("too few fields", "0 2 * *"),
+		Entry("too many fields", "0 2 * * * *"),
+		Entry("minute out of range", "60 2 * * *"),
+		Entry("hour out of range", "0 24 * * *"),
+		Entry("zero day-of-month", "0 2 0 * *"),
+		Entry("month out of range", "0 2 * 13 *"),
+		Entry("day-of-week out of range", "0 2 * * 8"),
+		Entry("backwards range", "0 5-2 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("bad name", "0 2 * foo *"),
+	)
+
+	DescribeTable("finding the next match",
+		func(expr, from, expected string) {
+			c, err := schedule.ParseCron(expr)
+			Expect(err).NotTo(HaveOccurred())
+			t := mustParseTime(from)
+			Expect(c.Next(t, t.Add(5*366*24*time.Hour))).To(Equal(mustParseTime(expected)))
+		},
+		Entry("every minute", "* * * * *", "2025-03-01T10:15:30Z", "2025-03-01T10:16:00Z"),
+		Entry("strictly after", "15 10 * * *", "2025-03-01T10:15:00Z", "2025-03-02T10:15:00Z"),
+		Entry("step", "*/20 * * * *", "2025-03-01T10:41:00Z", "2025-03-01T11:00:00Z"),
+		Entry("list and range", "0 9-17/4,22 * * *", "2025-03-01T17:30:00Z", "2025-03-01T22:00:00Z"),
+		Entry("day of week", "0 2 * * sat", "2025-03-03T00:00:00Z", "2025-03-08T02:00:00Z"),
+		Entry("Sunday as 7", "0 2 * * 7", "2025-03-03T00:00:00Z", "2025-03-09T02:00:00Z"),
+		Entry("month name", "0 0 1 jun *", "2025-03-03T00:00:00Z", "2025-06-01T00:00:00Z"),
+		Entry("day-of-month or day-of-week", "0 0 15 * mon", "2025-03-11T00:00:00Z", "2025-03-15T00:00:00Z"),
+		Entry("leap day", "0 0 29 2 *", "2025-03-01T00:00:00Z", "2028-02-29T00:00:00Z"),
+		Entry("time zone", "0 2 * * *", "2025-03-01T03:00:00+01:00", "2025-03-02T02:00:00+01:00"),
+	)
+
+	It("should return zero if there is no match within the limit", func() {
+		c, err := schedule.ParseCron("0 0 30 2 *")
+		Expect(err).NotTo(HaveOccurred())
+		t := mustParseTime("2025-03-01T00:00:00Z")
+		Expect(c.Next(t, t.Add(5*366*24*time.Hour)).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("finding the previous match",
+		func(expr, from, expected string) {
+			c, err := schedule.ParseCron(expr)
+			Expect(err).NotTo(HaveOccurred())
+			t := mustParseTime(from)
+			Expect(c.Prev(t, t.Add(-366*24*time.Hour))).To(Equal(mustParseTime(expected)))
+		},
+		Entry("at the time itself", "15 10 * * *", "2025-03-01T10:15:30Z", "2025-03-01T10:15:00Z"),
+		Entry("earlier in the day", "15 10 * * *", "2025-03-01T11:00:00Z", "2025-03-01T10:15:00Z"),
+		Entry("previous day", "15 10 * * *", "2025-03-01T10:00:00Z", "2025-02-28T10:15:00Z"),
+		Entry("day of week", "0 2 * * sat", "2025-03-07T00:00:00Z", "2025-03-01T02:00:00Z"),
+		Entry("last minute of the hour", "59 * * * *", "2025-03-01T10:30:00Z", "2025-03-01T09:59:00Z"),
+	)
+
+	It("should handle daylight saving changes", func() {
+		london, err := time.LoadLocation("Europe/London")
+		Expect(err).NotTo(HaveOccurred())
+		c, err := schedule.ParseCron("30 1 * * *")
+		Expect(err).NotTo(HaveOccurred())
+
+		// 01:30 doesn't exist on the day the clocks go forward.
+		t := time.Date(2025, 3, 29, 12, 0, 0, 0, london)
+		Expect(c.Next(t, t.Add(72*time.Hour))).To(BeTemporally("==", time.Date(2025, 3, 31, 1, 30, 0, 0, london)))
+
+		// Searching backwards across the change should make progress too.
+		t = time.Date(2025, 3, 30, 12, 0, 0, 0, london)
+		Expect(c.Prev(t, t.Add(-72*time.Hour))).To(BeTemporally("==", time.Date(2025, 3, 29, 1, 30, 0, 0, london)))
+	})
+})
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule evaluates the time-based schedules that may be attached to network policies.
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the time zone database so that window time zones can be resolved in minimal
+	// container images that don't ship one.
+	_ "time/tzdata"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+)
+
+const (
+	MinWindowDuration = time.Minute
+	MaxWindowDuration = 31 * 24 * time.Hour
+
+	// searchHorizon bounds the search for the next opening of a window.  It is long enough to
+	// cover expressions that only match on 29th February.
+	searchHorizon = 5 * 366 * 24 * time.Hour
+)
+
+// LoadLocation returns the location for a window's time zone, defaulting to UTC.
+func LoadLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+// Evaluate reports whether a policy with the given schedule is in effect at the given time.  It
+// also returns the time at which the schedule should next be re-evaluated because the result may
+// change; that is zero if the result will never change.  A nil schedule is always in effect.
+func Evaluate(s *apiv3.PolicySchedule, now time.Time) (active bool, next time.Time, err error) {
+	if s == nil {
+		return true, time.Time{}, nil
+	}
+	if s.NotAfter != nil && !now.Before(s.NotAfter.Time) {
+		return false, time.Time{}, nil
+	}
+	if s.NotBefore != nil && now.Before(s.NotBefore.Time) {
+		return false, s.NotBefore.Time, nil
+	}
+
+	updateNext := func(t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	if s.NotAfter != nil {
+		updateNext(s.NotAfter.Time)
+	}
+	if len(s.Windows) == 0 {
+		return true, next, nil
+	}
+
+	for _, w := range s.Windows {
+		open, change, err := evaluateWindow(w, now)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		active = active || open
+		updateNext(change)
+	}
+	return active, next, nil
+}
+
+// evaluateWindow reports whether the window is open at the given time along with the time at
+// which it next closes (if open) or opens (if closed).
+func evaluateWindow(w apiv3.PolicyScheduleWindow, now time.Time) (open bool, change time.Time, err error) {
+	cron, err := ParseCron(w.Start)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	loc, err := LoadLocation(w.TimeZone)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid time zone %q: %w", w.TimeZone, err)
+	}
+	dur := w.Duration.Duration
+	if dur < MinWindowDuration || dur > MaxWindowDuration {
+		return false, time.Time{}, fmt.Errorf("window duration %v should be between %v and %v",
+			dur, MinWindowDuration, MaxWindowDuration)
+	}
+
+	now = now.In(loc)
+	if start := cron.Prev(now, now.Add(-dur)); !start.IsZero() {
+		end := start.Add(dur)
+		if now.Before(end) {
+			return true, end, nil
+		}
+	}
+	return false, cron.Next(now, now.Add(searchHorizon)), nil
+}
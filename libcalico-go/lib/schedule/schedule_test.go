@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
+)
+
+func metaTime(s string) *metav1.Time {
+	return &metav1.Time{Time: mustParseTime(s)}
+}
+
+func window(start string, dur time.Duration, tz string) apiv3.PolicyScheduleWindow {
+	return apiv3.PolicyScheduleWindow{
+		Start:    start,
+		Duration: metav1.Duration{Duration: dur},
+		TimeZone: tz,
+	}
+}
+
+var _ = Describe("Evaluate", func() {
+	evaluate := func(s *apiv3.PolicySchedule, now string) (bool, time.Time) {
+		active, next, err := schedule.Evaluate(s, mustParseTime(now))
+		Expect(err).NotTo(HaveOccurred())
+		return active, next
+	}
+
+	It("should treat a nil schedule as always in effect", func() {
+		active, next := evaluate(nil, "2025-03-01T00:00:00Z")
+		Expect(active).To(BeTrue())
+		Expect(next.IsZero()).To(BeTrue())
+	})
+
+	Describe("with absolute bounds", func() {
+		s := &apiv3.PolicySchedule{
+			NotBefore: metaTime("2025-03-01T09:00:00Z"),
+			NotAfter:  metaTime("2025-03-01T17:00:00Z"),
+		}
+
+		It("should be inactive before the start", func() {
+			active, next := evaluate(s, "2025-03-01T08:00:00Z")
+			Expect(active).To(BeFalse())
+			Expect(next).To(Equal(mustParseTime("2025-03-01T09:00:00Z")))
+		})
+		It("should be active between the bounds", func() {
+			active, next := evaluate(s, "2025-03-01T09:00:00Z")
+			Expect(active).To(BeTrue())
+			Expect(next).To(Equal(mustParseTime("2025-03-01T17:00:00Z")))
+		})
+		It("should be inactive for good once expired", func() {
+			active, next := evaluate(s, "2025-03-01T17:00:00Z")
+			Expect(active).To(BeFalse())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+	})
+
+	Describe("with windows", func() {
+		s := &apiv3.PolicySchedule{
+			Windows: []apiv3.PolicyScheduleWindow{
+				window("0 2 * * sat", 4*time.Hour, ""),
+				window("0 9 * * 1-5", time.Hour, "America/New_York"),
+			},
+		}
+
+		It("should be active inside a window and change when it closes", func() {
+			// Saturday.
+			active, next := evaluate(s, "2025-03-01T03:00:00Z")
+			Expect(active).To(BeTrue())
+			Expect(next).To(Equal(mustParseTime("2025-03-01T06:00:00Z")))
+		})
+		It("should be inactive outside the windows and change when the next opens", func() {
+			// Saturday after the window, the next is Monday morning in New York.
+			active, next := evaluate(s, "2025-03-01T06:00:00Z")
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", mustParseTime("2025-03-03T14:00:00Z")))
+		})
+		It("should honour the window's time zone", func() {
+			active, _ := evaluate(s, "2025-03-03T14:30:00Z")
+			Expect(active).To(BeTrue())
+			active, _ = evaluate(s, "2025-03-03T09:30:00Z")
+			Expect(active).To(BeFalse())
+		})
+		It("should stop at NotAfter even if a window is open", func() {
+			bounded := s.DeepCopy()
+			bounded.NotAfter = metaTime("2025-03-01T04:00:00Z")
+			active, next := evaluate(bounded, "2025-03-01T03:00:00Z")
+			Expect(active).To(BeTrue())
+			Expect(next).To(Equal(mustParseTime("2025-03-01T04:00:00Z")))
+		})
+	})
+
+	It("should return an error for an invalid window", func() {
+		_, _, err := schedule.Evaluate(&apiv3.PolicySchedule{
+			Windows: []apiv3.PolicyScheduleWindow{window("0 2 * *", time.Hour, "")},
+		}, time.Now())
+		Expect(err).To(HaveOccurred())
+		_, _, err = schedule.Evaluate(&apiv3.PolicySchedule{
+			Windows: []apiv3.PolicyScheduleWindow{window("0 2 * * *", time.Hour, "Mars/Olympus")},
+		}, time.Now())
+		Expect(err).To(HaveOccurred())
+	})
+})
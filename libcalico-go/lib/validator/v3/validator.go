@@ -35,6 +35,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/errors"
 	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector/tokenizer"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
@@ -181,6 +182,7 @@ func init() {
 	registerFieldValidator("containerID", validateContainerID)
 	registerFieldValidator("selector", validateSelector)
 	registerFieldValidator("labels", validateLabels)
+	registerFieldValidator("labelName", validateLabelName)
 	registerFieldValidator("ipVersion", validateIPVersion)
 	registerFieldValidator("ipIpMode", validateIPIPMode)
 	registerFieldValidator("stagedAction", validateStagedAction)
@@ -198,6 +200,9 @@ func init() {
 	registerFieldValidator("acceptReturn", validateAcceptReturn)
 	registerFieldValidator("dropReject", validateDropReject)
 	registerFieldValidator("portName", validatePortName)
+	registerFieldValidator("domain", validateDomain)
+	registerFieldValidator("cron", validateCron)
+	registerFieldValidator("timeZone", validateTimeZone)
 	registerFieldValidator("mustBeNil", validateMustBeNil)
 	registerFieldValidator("mustBeFalse", validateMustBeFalse)
 	registerFieldValidator("ifaceFilter", validateIfaceFilter)
@@ -250,6 +255,7 @@ func init() {
 	registerStructValidator(validate, validateIPPoolSpec, api.IPPoolSpec{})
 	registerStructValidator(validate, validateNodeSpec, libapi.NodeSpec{})
 	registerStructValidator(validate, validateIPAMConfigSpec, libapi.IPAMConfigSpec{})
+	registerStructValidator(validate, validateIPAMConfigurationSpec, api.IPAMConfigurationSpec{})
 	registerStructValidator(validate, validateObjectMeta, metav1.ObjectMeta{})
 	registerStructValidator(validate, validateTier, api.Tier{})
 	registerStructValidator(validate, validateHTTPRule, api.HTTPMatch{})
@@ -258,6 +264,9 @@ func init() {
 	registerStructValidator(validate, validateHostEndpointSpec, api.HostEndpointSpec{})
 	registerStructValidator(validate, validateRule, api.Rule{})
 	registerStructValidator(validate, validateEntityRule, api.EntityRule{})
+	registerStructValidator(validate, validateRuleRateLimit, api.RuleRateLimit{})
+	registerStructValidator(validate, validatePolicySchedule, api.PolicySchedule{})
+	registerStructValidator(validate, validatePolicyScheduleWindow, api.PolicyScheduleWindow{})
 	registerStructValidator(validate, validateBGPPeerSpec, api.BGPPeerSpec{})
 	registerStructValidator(validate, validateBGPFilterRuleV4, api.BGPFilterRuleV4{})
 	registerStructValidator(validate, validateBGPFilterRuleV6, api.BGPFilterRuleV6{})
@@ -429,6 +438,29 @@ func validatePortName(fl validator.FieldLevel) bool {
 	return len(s) != 0 && len(k8svalidation.IsValidPortName(s)) == 0
 }
 
+func validateDomain(fl validator.FieldLevel) bool {
+	s := strings.ToLower(fl.Field().String())
+	log.Debugf("Validate domain: %s", s)
+	if strings.HasPrefix(s, "*.") {
+		return len(k8svalidation.IsWildcardDNS1123Subdomain(s)) == 0
+	}
+	return len(k8svalidation.IsDNS1123Subdomain(s)) == 0
+}
+
+func validateCron(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate cron expression: %s", s)
+	_, err := schedule.ParseCron(s)
+	return err == nil
+}
+
+func validateTimeZone(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate time zone: %s", s)
+	_, err := schedule.LoadLocation(s)
+	return err == nil
+}
+
 func validateMustBeNil(fl validator.FieldLevel) bool {
 	log.WithField("field", fl.Field().String()).Debugf("Validate field must be nil")
 	return fl.Field().IsNil()
@@ -612,6 +644,12 @@ func validateLabels(fl validator.FieldLevel) bool {
 	return true
 }
 
+func validateLabelName(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate label name: %s", s)
+	return len(k8svalidation.IsQualifiedName(s)) == 0
+}
+
 func validatePolicyType(fl validator.FieldLevel) bool {
 	s := fl.Field().String()
 	log.Debugf("Validate policy type: %s", s)
@@ -887,6 +925,47 @@ func validateHTTPPaths(paths []api.HTTPPath) error {
 	return nil
 }
 
+// validateHTTPHeaders checks if the HTTP header match clauses are valid.
+func validateHTTPHeaders(headers []api.HTTPHeaderMatch) error {
+	for _, header := range headers {
+		if errs := k8svalidation.IsHTTPHeaderName(header.Name); len(errs) != 0 {
+			return fmt.Errorf("Invalid header name %q: %s", header.Name, strings.Join(errs, "; "))
+		}
+		numMatches := 0
+		for _, v := range []string{header.Exact, header.Prefix, header.Regex} {
+			if v != "" {
+				numMatches++
+			}
+		}
+		if header.Present != nil {
+			numMatches++
+		}
+		if numMatches != 1 {
+			return fmt.Errorf("Invalid header match for %s. Exactly one of 'exact', 'prefix', 'regex' or 'present' must be set", header.Name)
+		}
+		if header.Regex != "" {
+			if _, err := regexp.Compile(header.Regex); err != nil {
+				return fmt.Errorf("Invalid header regex %q: %v", header.Regex, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateGRPCMethods checks if the gRPC method match clauses are valid.
+func validateGRPCMethods(methods []api.GRPCMethodMatch) error {
+	for _, m := range methods {
+		if m.Service == "" {
+			return fmt.Errorf("Invalid gRPC method match. 'service' must be set")
+		}
+		// gRPC requests use the path /<service>/<method>, so neither name may contain a '/'.
+		if strings.ContainsAny(m.Service, "/ ") || strings.ContainsAny(m.Method, "/ ") {
+			return fmt.Errorf("Invalid gRPC method %s/%s. (service and method must not contain `/` or spaces)", m.Service, m.Method)
+		}
+	}
+	return nil
+}
+
 func validateHTTPRule(structLevel validator.StructLevel) {
 	h := structLevel.Current().Interface().(api.HTTPMatch)
 	log.Debugf("Validate HTTP Rule: %v", h)
@@ -896,6 +975,12 @@ func validateHTTPRule(structLevel validator.StructLevel) {
 	if err := validateHTTPPaths(h.Paths); err != nil {
 		structLevel.ReportError(reflect.ValueOf(h.Paths), "Paths", "", reason(err.Error()), "")
 	}
+	if err := validateHTTPHeaders(h.Headers); err != nil {
+		structLevel.ReportError(reflect.ValueOf(h.Headers), "Headers", "", reason(err.Error()), "")
+	}
+	if err := validateGRPCMethods(h.GRPCMethods); err != nil {
+		structLevel.ReportError(reflect.ValueOf(h.GRPCMethods), "GRPCMethods", "", reason(err.Error()), "")
+	}
 }
 
 func validatePort(structLevel validator.StructLevel) {
@@ -1244,6 +1329,57 @@ func validateIPPoolSpec(structLevel validator.StructLevel) {
 		structLevel.ReportError(reflect.ValueOf(pool.CIDR),
 			"IPpool.NodeSelector", "", reason("IP Pool with AllowedUse LoadBalancer must have node selector set to all()"), "")
 	}
+
+	// A pool can only be drained once it is disabled, otherwise evicted pods could be given new addresses from it.
+	if pool.Drain != nil {
+		if !pool.Disabled {
+			structLevel.ReportError(reflect.ValueOf(pool.Drain),
+				"IPpool.Drain", "", reason("IP Pool must be disabled to be drained"), "")
+		}
+		if pool.Drain.EvictionInterval != nil && pool.Drain.EvictionInterval.Duration < 0 {
+			structLevel.ReportError(reflect.ValueOf(pool.Drain.EvictionInterval),
+				"IPpool.Drain.EvictionInterval", "", reason("eviction interval must not be negative"), "")
+		}
+	}
+
+	if pool.Topology != nil {
+		validateIPPoolTopology(structLevel, pool.Topology, cidr, pool.BlockSize)
+	}
+}
+
+// validateIPPoolTopology checks that the topology ranges are distinct sub-ranges of the pool that
+// can each hold at least one block.
+func validateIPPoolTopology(structLevel validator.StructLevel, topology *api.IPPoolTopology, pool *cnet.IPNet, blockSize int) {
+	values := set.New[string]()
+	var ranges []*cnet.IPNet
+	for _, r := range topology.Ranges {
+		if values.Contains(r.Value) {
+			structLevel.ReportError(reflect.ValueOf(r.Value),
+				"IPpool.Topology.Ranges", "", reason("topology values must be unique"), "")
+		}
+		values.Add(r.Value)
+
+		_, rangeNet, err := cnet.ParseCIDR(r.CIDR)
+		if err != nil {
+			// Already reported by the field validator.
+			continue
+		}
+		ones, _ := rangeNet.Mask.Size()
+		if !pool.Covers(rangeNet.IPNet) {
+			structLevel.ReportError(reflect.ValueOf(r.CIDR),
+				"IPpool.Topology.Ranges", "", reason("topology range must be within the pool CIDR"), "")
+		} else if ones > blockSize {
+			structLevel.ReportError(reflect.ValueOf(r.CIDR),
+				"IPpool.Topology.Ranges", "", reason("topology range must be at least as large as a block"), "")
+		}
+		for _, other := range ranges {
+			if other.IsNetOverlap(rangeNet.IPNet) {
+				structLevel.ReportError(reflect.ValueOf(r.CIDR),
+					"IPpool.Topology.Ranges", "", reason("topology ranges must not overlap"), "")
+			}
+		}
+		ranges = append(ranges, rangeNet)
+	}
 }
 
 func vxLanModeEnabled(mode api.VXLANMode) bool {
@@ -1353,6 +1489,13 @@ func validateRule(structLevel validator.StructLevel) {
 			"", reason("only valid for Allow rules"), "")
 	}
 
+	// Traffic over the limit is dropped and the rest is given the rule's action, so a limit
+	// only makes sense on rules that let traffic through.
+	if rule.RateLimit != nil && rule.Action != api.Allow && rule.Action != api.Pass {
+		structLevel.ReportError(reflect.ValueOf(rule.RateLimit), "RateLimit",
+			"", reason("only valid for Allow and Pass rules"), "")
+	}
+
 	// Check that destination service rules do not use ports.
 	// Destination service rules use ports specified on the endpoints.
 	if rule.Destination.Services != nil && len(rule.Destination.Ports) != 0 {
@@ -1411,6 +1554,45 @@ func validateEntityRule(structLevel validator.StructLevel) {
 				"Services field", "", reason("cannot specify Nets/NotNets and Services on the same rule"), "")
 		}
 	}
+
+	if len(rule.Domains) != 0 {
+		// Domain rules match the IPs learned from DNS so they can't be narrowed down by
+		// the other entity matches.
+		if rule.NamespaceSelector != "" || rule.Selector != "" || rule.NotSelector != "" {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify selectors and Domains on the same rule"), "")
+		}
+		if len(rule.Nets) != 0 || len(rule.NotNets) != 0 {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify Nets/NotNets and Domains on the same rule"), "")
+		}
+		if rule.Services != nil || rule.ServiceAccounts != nil {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify Services/ServiceAccounts and Domains on the same rule"), "")
+		}
+	}
+}
+
+// validateRuleDomains checks that Domains are only used in the destination of egress rules.
+func validateRuleDomains(ingress, egress []api.Rule, structLevel validator.StructLevel) {
+	for _, r := range egress {
+		if len(r.Source.Domains) != 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(r.Source.Domains), "Domains", "",
+				reason("not allowed in egress rule source"), "",
+			)
+		}
+	}
+	for _, r := range ingress {
+		for _, domains := range [][]string{r.Source.Domains, r.Destination.Domains} {
+			if len(domains) != 0 {
+				structLevel.ReportError(
+					reflect.ValueOf(domains), "Domains", "",
+					reason("not allowed in ingress rules"), "",
+				)
+			}
+		}
+	}
 }
 
 func validateIPAMConfigSpec(structLevel validator.StructLevel) {
@@ -1420,6 +1602,50 @@ func validateIPAMConfigSpec(structLevel validator.StructLevel) {
 		structLevel.ReportError(reflect.ValueOf(ics.MaxBlocksPerHost), "MaxBlocksPerHost", "",
 			reason("must be greater than or equal to 0"), "")
 	}
+	validateIPQuotas(structLevel, ics.IPQuotas)
+}
+
+func validateIPAMConfigurationSpec(structLevel validator.StructLevel) {
+	ics := structLevel.Current().Interface().(api.IPAMConfigurationSpec)
+	validateIPQuotas(structLevel, ics.IPQuotas)
+}
+
+func validateIPQuotas(structLevel validator.StructLevel, quotas []api.IPQuota) {
+	names := set.New[string]()
+	for _, q := range quotas {
+		if names.Contains(q.Name) {
+			structLevel.ReportError(reflect.ValueOf(q.Name), "IPQuotas", "",
+				reason("quota names must be unique"), "")
+		}
+		names.Add(q.Name)
+	}
+}
+
+func validateRuleRateLimit(structLevel validator.StructLevel) {
+	rl := structLevel.Current().Interface().(api.RuleRateLimit)
+
+	if rl.ConnectionsPerSecond == nil && rl.PacketsPerSecond == nil {
+		structLevel.ReportError(reflect.ValueOf(rl), "RateLimit", "",
+			reason("must specify ConnectionsPerSecond or PacketsPerSecond"), "")
+	}
+}
+
+func validatePolicySchedule(structLevel validator.StructLevel) {
+	ps := structLevel.Current().Interface().(api.PolicySchedule)
+
+	if ps.NotBefore != nil && ps.NotAfter != nil && !ps.NotBefore.Before(ps.NotAfter) {
+		structLevel.ReportError(reflect.ValueOf(ps.NotAfter), "NotAfter", "",
+			reason("must be later than NotBefore"), "")
+	}
+}
+
+func validatePolicyScheduleWindow(structLevel validator.StructLevel) {
+	w := structLevel.Current().Interface().(api.PolicyScheduleWindow)
+
+	if w.Duration.Duration < schedule.MinWindowDuration || w.Duration.Duration > schedule.MaxWindowDuration {
+		structLevel.ReportError(reflect.ValueOf(w.Duration), "Duration", "",
+			reason(fmt.Sprintf("must be between %v and %v", schedule.MinWindowDuration, schedule.MaxWindowDuration)), "")
+	}
 }
 
 func validateNodeSpec(structLevel validator.StructLevel) {
@@ -1510,11 +1736,13 @@ func validateReachableByField(fl validator.FieldLevel) bool {
 func validateBGPFilterRuleV4(structLevel validator.StructLevel) {
 	fs := structLevel.Current().Interface().(api.BGPFilterRuleV4)
 	validateBGPFilterRule(structLevel, fs.CIDR, fs.MatchOperator, fs.PrefixLength, nil)
+	validateBGPFilterRuleAttributes(structLevel, fs.Communities, fs.ASPath, fs.Action, fs.Operations)
 }
 
 func validateBGPFilterRuleV6(structLevel validator.StructLevel) {
 	fs := structLevel.Current().Interface().(api.BGPFilterRuleV6)
 	validateBGPFilterRule(structLevel, fs.CIDR, fs.MatchOperator, nil, fs.PrefixLength)
+	validateBGPFilterRuleAttributes(structLevel, fs.Communities, fs.ASPath, fs.Action, fs.Operations)
 }
 
 func validateBGPFilterRule(
@@ -1542,6 +1770,71 @@ func validateBGPFilterRule(
 	}
 }
 
+// validateBGPFilterRuleAttributes validates the BGP route attribute matches and operations of a BGPFilter rule.
+func validateBGPFilterRuleAttributes(
+	structLevel validator.StructLevel,
+	communities *api.BGPFilterCommunityMatch,
+	asPath *api.BGPFilterASPathMatch,
+	action api.BGPFilterAction,
+	operations []api.BGPFilterOperation,
+) {
+	if communities != nil {
+		if len(communities.Values) == 0 {
+			structLevel.ReportError(communities, "Communities", "",
+				reason("at least one community value must be specified"), "")
+		}
+		for _, c := range communities.Values {
+			validateBGPFilterCommunity(structLevel, c, "Communities.Values[]")
+		}
+	}
+	if asPath != nil && len(asPath.Prefix) == 0 && asPath.Contains == nil {
+		structLevel.ReportError(asPath, "ASPath", "",
+			reason("one of Prefix or Contains must be specified"), "")
+	}
+
+	if len(operations) > 0 && action != api.Accept {
+		structLevel.ReportError(operations, "Operations", "",
+			reason("operations can only be used with the Accept action"), "")
+	}
+	for _, op := range operations {
+		numSet := 0
+		if op.AddCommunity != nil {
+			numSet++
+			validateBGPFilterCommunity(structLevel, op.AddCommunity.Value, "Operations[].AddCommunity.Value")
+		}
+		if op.SetCommunities != nil {
+			numSet++
+			for _, c := range op.SetCommunities.Values {
+				validateBGPFilterCommunity(structLevel, c, "Operations[].SetCommunities.Values[]")
+			}
+		}
+		if op.SetLocalPreference != nil {
+			numSet++
+		}
+		if op.SetMED != nil {
+			numSet++
+		}
+		if op.PrependASPath != nil {
+			numSet++
+			if len(op.PrependASPath.Prefix) == 0 {
+				structLevel.ReportError(op.PrependASPath, "Operations[].PrependASPath.Prefix", "",
+					reason("at least one AS number must be specified"), "")
+			}
+		}
+		if numSet != 1 {
+			structLevel.ReportError(op, "Operations[]", "",
+				reason("exactly one operation must be specified per entry"), "")
+		}
+	}
+}
+
+func validateBGPFilterCommunity(structLevel validator.StructLevel, c api.BGPCommunityValue, fieldName string) {
+	if !isValidCommunity(string(c), fieldName, structLevel) {
+		structLevel.ReportError(reflect.ValueOf(c), fieldName, "",
+			reason("invalid community value or format used."), "")
+	}
+}
+
 func validateEndpointPort(structLevel validator.StructLevel) {
 	port := structLevel.Current().Interface().(api.EndpointPort)
 
@@ -1729,6 +2022,8 @@ func validateNetworkPolicySpec(spec *api.NetworkPolicySpec, structLevel validato
 		}
 	}
 
+	validateRuleDomains(spec.Ingress, spec.Egress, structLevel)
+
 	// Check that the selector doesn't have the global() selector which is only
 	// valid as an EntityRule namespaceSelector.
 	if strings.Contains(spec.Selector, globalSelector) {
@@ -1885,6 +2180,18 @@ func validateGlobalNetworkPolicySpec(spec *api.GlobalNetworkPolicySpec, structLe
 			"PolicySpec.ApplyOnForward", "", reason("ApplyOnForward must be true if either PreDNAT or DoNotTrack is true, for a given PolicySpec"), "")
 	}
 
+	if spec.DoNotTrack {
+		// Connection rate limits rely on conntrack, which untracked policy bypasses.
+		for _, rules := range [][]api.Rule{spec.Ingress, spec.Egress} {
+			for _, r := range rules {
+				if r.RateLimit != nil {
+					structLevel.ReportError(reflect.ValueOf(r.RateLimit), "RateLimit", "",
+						reason("not allowed in DoNotTrack policy"), "")
+				}
+			}
+		}
+	}
+
 	// Check (and disallow) any repeats in Types field.
 	mp := map[api.PolicyType]bool{}
 	for _, t := range spec.Types {
@@ -1922,6 +2229,8 @@ func validateGlobalNetworkPolicySpec(spec *api.GlobalNetworkPolicySpec, structLe
 		}
 	}
 
+	validateRuleDomains(spec.Ingress, spec.Egress, structLevel)
+
 	// If a ServiceSelector is specified by name, we also need a namespace. At a global scope,
 	// service names are not fully qualified and so need a namespace.
 	for _, r := range spec.Egress {
@@ -2256,6 +2565,16 @@ func validateBGPConfigurationSpec(structLevel validator.StructLevel) {
 	if spec.NodeMeshMaxRestartTime != nil && spec.NodeToNodeMeshEnabled != nil && !*spec.NodeToNodeMeshEnabled {
 		structLevel.ReportError(reflect.ValueOf(spec), "Spec.NodeMeshMaxRestartTime", "", reason("spec.NodeMeshMaxRestartTime cannot be set if spec.NodeToNodeMesh is disabled"), "")
 	}
+
+	// Check that the BFD intervals are positive.
+	if spec.BFD != nil {
+		if spec.BFD.MinRxInterval != nil && spec.BFD.MinRxInterval.Duration <= 0 {
+			structLevel.ReportError(reflect.ValueOf(spec.BFD.MinRxInterval), "Spec.BFD.MinRxInterval", "", reason("spec.BFD.MinRxInterval must be positive"), "")
+		}
+		if spec.BFD.MinTxInterval != nil && spec.BFD.MinTxInterval.Duration <= 0 {
+			structLevel.ReportError(reflect.ValueOf(spec.BFD.MinTxInterval), "Spec.BFD.MinTxInterval", "", reason("spec.BFD.MinTxInterval must be positive"), "")
+		}
+	}
 }
 
 func validateBlockAffinitySpec(structLevel validator.StructLevel) {
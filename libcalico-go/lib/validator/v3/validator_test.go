@@ -53,6 +53,10 @@ func init() {
 	var Vtrue = true
 	var Vfalse = false
 
+	// Rate limits are uint32 pointers.
+	var Vu0 = uint32(0)
+	var Vu100 = uint32(100)
+
 	// Set up some values we use in various tests.
 	ipv4_1 := "1.2.3.4"
 	ipv4_2 := "100.200.0.0"
@@ -533,6 +537,31 @@ func init() {
 				NodeMeshMaxRestartTime: &v1.Duration{Duration: 200 * time.Second},
 			}, false,
 		),
+		Entry("should accept BFD settings",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{
+					Enabled:       &Vtrue,
+					MinRxInterval: &v1.Duration{Duration: 100 * time.Millisecond},
+					MinTxInterval: &v1.Duration{Duration: 100 * time.Millisecond},
+					Multiplier:    intHelper(3),
+				},
+			}, true,
+		),
+		Entry("should reject a negative BFD interval",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{MinTxInterval: &v1.Duration{Duration: -100 * time.Millisecond}},
+			}, false,
+		),
+		Entry("should reject a BFD multiplier of zero",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{Multiplier: intHelper(0)},
+			}, false,
+		),
+		Entry("should reject a BFD multiplier above 255",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{Multiplier: intHelper(256)},
+			}, false,
+		),
 		Entry("should accept valid interface names",
 			api.BGPConfigurationSpec{
 				IgnoredInterfaces: []string{"valid_iface*", "interface_name"},
@@ -545,6 +574,24 @@ func init() {
 		Entry("should accept IP version 6", api.Rule{Action: "Allow", IPVersion: &V6}, true),
 		Entry("should reject IP version 0", api.Rule{Action: "Allow", IPVersion: &V0}, false),
 
+		// (API) Rule rate limits.
+		Entry("should accept a connection rate limit on an Allow rule",
+			api.Rule{Action: "Allow", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100}}, true),
+		Entry("should accept a per-source packet rate limit on a Pass rule",
+			api.Rule{Action: "Pass", RateLimit: &api.RuleRateLimit{PacketsPerSecond: &Vu100, Burst: &Vu100, Per: api.RuleRateLimitScopeSourceIP}}, true),
+		Entry("should reject a rate limit on a Deny rule",
+			api.Rule{Action: "Deny", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100}}, false),
+		Entry("should reject a rate limit on a Log rule",
+			api.Rule{Action: "Log", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100}}, false),
+		Entry("should reject a rate limit without a rate",
+			api.Rule{Action: "Allow", RateLimit: &api.RuleRateLimit{Burst: &Vu100}}, false),
+		Entry("should reject a zero connection rate",
+			api.Rule{Action: "Allow", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu0}}, false),
+		Entry("should reject a zero burst",
+			api.Rule{Action: "Allow", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100, Burst: &Vu0}}, false),
+		Entry("should reject an unknown rate limit scope",
+			api.Rule{Action: "Allow", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100, Per: "Namespace"}}, false),
+
 		// (API) ProtoPort.
 		Entry("should accept ProtoPort.Protocol: UDP", api.ProtoPort{Protocol: "UDP", Port: 0}, true),
 		Entry("should accept ProtoPort.Protocol: TCP", api.ProtoPort{Protocol: "TCP", Port: 20}, true),
@@ -1199,6 +1246,49 @@ func init() {
 		Entry("should accept it when the NATOutgoingV1 field is not specified", api.IPPoolSpec{CIDR: "1.2.3.0/24", IPIPMode: "Never"}, true),
 		Entry("should reject NATOutgoingV1 field set to true", api.IPPoolSpec{CIDR: "1.2.3.0/24", IPIPMode: "Never", NATOutgoingV1: true}, false),
 
+		// (API) IPPoolSpec Drain
+		Entry("should accept draining a disabled pool", api.IPPoolSpec{CIDR: "1.2.3.0/24", Disabled: true, Drain: &api.IPPoolDrain{}}, true),
+		Entry("should accept drain with eviction settings", api.IPPoolSpec{CIDR: "1.2.3.0/24", Disabled: true, Drain: &api.IPPoolDrain{EvictPods: true, EvictionBatchSize: intHelper(5), EvictionInterval: &metav1.Duration{Duration: 30 * time.Second}}}, true),
+		Entry("should reject draining an enabled pool", api.IPPoolSpec{CIDR: "1.2.3.0/24", Drain: &api.IPPoolDrain{}}, false),
+		Entry("should reject drain with zero batch size", api.IPPoolSpec{CIDR: "1.2.3.0/24", Disabled: true, Drain: &api.IPPoolDrain{EvictionBatchSize: intHelper(0)}}, false),
+		Entry("should reject drain with negative interval", api.IPPoolSpec{CIDR: "1.2.3.0/24", Disabled: true, Drain: &api.IPPoolDrain{EvictionInterval: &metav1.Duration{Duration: -time.Second}}}, false),
+		Entry("should accept topology ranges", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key:    "topology.kubernetes.io/zone",
+			Ranges: []api.IPPoolTopologyRange{{Value: "zone-a", CIDR: "10.0.0.0/20"}, {Value: "zone-b", CIDR: "10.0.16.0/20"}},
+		}}, true),
+		Entry("should reject a topology range outside the pool", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key: "rack", Ranges: []api.IPPoolTopologyRange{{Value: "r1", CIDR: "10.1.0.0/20"}},
+		}}, false),
+		Entry("should reject a topology range smaller than a block", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key: "rack", Ranges: []api.IPPoolTopologyRange{{Value: "r1", CIDR: "10.0.0.0/28"}},
+		}}, false),
+		Entry("should reject overlapping topology ranges", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key: "rack", Ranges: []api.IPPoolTopologyRange{{Value: "r1", CIDR: "10.0.0.0/20"}, {Value: "r2", CIDR: "10.0.8.0/21"}},
+		}}, false),
+		Entry("should reject duplicate topology values", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key: "rack", Ranges: []api.IPPoolTopologyRange{{Value: "r1", CIDR: "10.0.0.0/20"}, {Value: "r1", CIDR: "10.0.16.0/20"}},
+		}}, false),
+		Entry("should reject an invalid topology key", api.IPPoolSpec{CIDR: "10.0.0.0/16", Topology: &api.IPPoolTopology{
+			Key: "bad key", Ranges: []api.IPPoolTopologyRange{{Value: "r1", CIDR: "10.0.0.0/20"}},
+		}}, false),
+
+		// (API) IPAMConfigSpec IP quotas.
+		Entry("should accept IP quotas", libapiv3.IPAMConfigSpec{StrictAffinity: true, AutoAllocateBlocks: true, IPQuotas: []api.IPQuota{
+			{Name: "per-ns", MaxIPs: 100},
+			{Name: "tenant-a", NamespaceSelector: "tenant == 'a'", IPPools: []string{"pool-1"}, Scope: api.IPQuotaScopeShared, MaxIPs: 1000},
+		}}, true),
+		Entry("should reject duplicate IP quota names", libapiv3.IPAMConfigSpec{AutoAllocateBlocks: true, IPQuotas: []api.IPQuota{
+			{Name: "quota", MaxIPs: 100},
+			{Name: "quota", MaxIPs: 10},
+		}}, false),
+		Entry("should reject an IP quota with a bad selector", libapiv3.IPAMConfigSpec{AutoAllocateBlocks: true, IPQuotas: []api.IPQuota{{Name: "quota", NamespaceSelector: "tenant ==", MaxIPs: 10}}}, false),
+		Entry("should reject an IP quota with an unknown scope", libapiv3.IPAMConfigSpec{AutoAllocateBlocks: true, IPQuotas: []api.IPQuota{{Name: "quota", Scope: "Cluster", MaxIPs: 10}}}, false),
+		Entry("should reject an IP quota with a negative limit", libapiv3.IPAMConfigSpec{AutoAllocateBlocks: true, IPQuotas: []api.IPQuota{{Name: "quota", MaxIPs: -1}}}, false),
+		Entry("should reject duplicate IP quota names in an IPAMConfiguration", api.IPAMConfigurationSpec{StrictAffinity: true, IPQuotas: []api.IPQuota{
+			{Name: "quota", MaxIPs: 100},
+			{Name: "quota", MaxIPs: 10},
+		}}, false),
+
 		// (API) ICMPFields
 		Entry("should accept ICMP with no config", api.ICMPFields{}, true),
 		Entry("should accept ICMP with type with min value", api.ICMPFields{Type: &V0}, true),
@@ -1931,6 +2021,64 @@ func init() {
 				Min: int32Helper(120),
 			},
 		}, false),
+		Entry("should accept BGPFilter rule with community and AS path matches", api.BGPFilterRuleV4{
+			Communities: &api.BGPFilterCommunityMatch{Values: []api.BGPCommunityValue{"65000:100", "65000:100:200"}},
+			ASPath:      &api.BGPFilterASPathMatch{Prefix: []numorstring.ASNumber{65001, 65002}},
+			Action:      "Accept",
+		}, true),
+		Entry("should reject BGPFilter rule with an invalid community match", api.BGPFilterRuleV4{
+			Communities: &api.BGPFilterCommunityMatch{Values: []api.BGPCommunityValue{"65536:100"}},
+			Action:      "Accept",
+		}, false),
+		Entry("should reject BGPFilter rule with an empty community match", api.BGPFilterRuleV6{
+			Communities: &api.BGPFilterCommunityMatch{},
+			Action:      "Accept",
+		}, false),
+		Entry("should reject BGPFilter rule with an empty AS path match", api.BGPFilterRuleV6{
+			ASPath: &api.BGPFilterASPathMatch{},
+			Action: "Accept",
+		}, false),
+		Entry("should accept BGPFilter rule with operations", api.BGPFilterRuleV6{
+			CIDR:          "ffee::/64",
+			MatchOperator: "In",
+			Action:        "Accept",
+			Operations: []api.BGPFilterOperation{
+				{SetCommunities: &api.BGPFilterSetCommunities{Values: []api.BGPCommunityValue{"65000:1"}}},
+				{AddCommunity: &api.BGPFilterAddCommunity{Value: "4200000000:1:2"}},
+				{SetLocalPreference: &api.BGPFilterSetLocalPreference{Value: 200}},
+				{SetMED: &api.BGPFilterSetMED{Value: 50}},
+				{PrependASPath: &api.BGPFilterPrependASPath{Prefix: []numorstring.ASNumber{65000, 65000}}},
+			},
+		}, true),
+		Entry("should reject BGPFilter rule with operations and the Reject action", api.BGPFilterRuleV4{
+			Action: "Reject",
+			Operations: []api.BGPFilterOperation{
+				{SetMED: &api.BGPFilterSetMED{Value: 50}},
+			},
+		}, false),
+		Entry("should reject BGPFilter operation with more than one field set", api.BGPFilterRuleV4{
+			Action: "Accept",
+			Operations: []api.BGPFilterOperation{{
+				SetMED:             &api.BGPFilterSetMED{Value: 50},
+				SetLocalPreference: &api.BGPFilterSetLocalPreference{Value: 200},
+			}},
+		}, false),
+		Entry("should reject BGPFilter operation with no field set", api.BGPFilterRuleV4{
+			Action:     "Accept",
+			Operations: []api.BGPFilterOperation{{}},
+		}, false),
+		Entry("should reject BGPFilter operation adding an invalid community", api.BGPFilterRuleV4{
+			Action: "Accept",
+			Operations: []api.BGPFilterOperation{
+				{AddCommunity: &api.BGPFilterAddCommunity{Value: "no-export"}},
+			},
+		}, false),
+		Entry("should reject BGPFilter operation prepending an empty AS path", api.BGPFilterRuleV4{
+			Action: "Accept",
+			Operations: []api.BGPFilterOperation{
+				{PrependASPath: &api.BGPFilterPrependASPath{}},
+			},
+		}, false),
 
 		// (API) BGPPeerSpec
 		Entry("should accept valid BGPPeerSpec", api.BGPPeerSpec{PeerIP: ipv4_1}, true),
@@ -2142,6 +2290,18 @@ func init() {
 				},
 			}, false,
 		),
+		Entry("should reject GlobalNetworkPolicy DoNotTrack with a rate limited rule",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					DoNotTrack:     true,
+					ApplyOnForward: true,
+					Ingress: []api.Rule{
+						{Action: "Allow", RateLimit: &api.RuleRateLimit{ConnectionsPerSecond: &Vu100}},
+					},
+				},
+			}, false,
+		),
 		Entry("should accept GlobalNetworkPolicy PreDNAT but not DoNotTrack",
 			&api.GlobalNetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -3000,6 +3160,215 @@ func init() {
 				},
 			}, true,
 		),
+		Entry("allow Domains in an egress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"api.example.com", "*.example.com"},
+							},
+						},
+					},
+				},
+			}, true,
+		),
+		Entry("allow Domains in a GlobalNetworkPolicy egress rule destination",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"*.example.com"},
+							},
+						},
+					},
+				},
+			}, true,
+		),
+		Entry("disallow Domains in an egress rule source",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Source: api.EntityRule{
+								Domains: []string{"api.example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in an ingress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Ingress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"api.example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains AND a Nets match",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"api.example.com"},
+								Nets:    []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains AND a Selector match",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains:  []string{"api.example.com"},
+								Selector: "all()",
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow a wildcard that isn't the first label in Domains",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"api.*.example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow an invalid name in Domains",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"not_a.domain"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("allow a schedule with a valid window",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 2 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/London"}}},
+				},
+			}, true,
+		),
+		Entry("allow a window with lists, ranges and steps",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "*/15 9-17 1,15 jan-jun mon-fri", Duration: metav1.Duration{Duration: time.Minute}}}},
+				},
+			}, true,
+		),
+		Entry("allow a schedule with absolute bounds",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{
+						NotBefore: &metav1.Time{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+						NotAfter:  &metav1.Time{Time: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			}, true,
+		),
+		Entry("disallow a schedule that ends before it starts",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{
+						NotBefore: &metav1.Time{Time: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)},
+						NotAfter:  &metav1.Time{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow a window with a malformed cron expression",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 2 * *", Duration: metav1.Duration{Duration: time.Hour}}}},
+				},
+			}, false,
+		),
+		Entry("disallow a window with an out-of-range cron field",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}}}},
+				},
+			}, false,
+		),
+		Entry("disallow a window with an unknown time zone",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"}}},
+				},
+			}, false,
+		),
+		Entry("disallow a window shorter than a minute",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 2 * * *", Duration: metav1.Duration{Duration: 30 * time.Second}}}},
+				},
+			}, false,
+		),
+		Entry("disallow a window longer than 31 days",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Selector: "all()",
+					Schedule: &api.PolicySchedule{Windows: []api.PolicyScheduleWindow{api.PolicyScheduleWindow{Start: "0 2 * * *", Duration: metav1.Duration{Duration: 32 * 24 * time.Hour}}}},
+				},
+			}, false,
+		),
 		Entry("disallow a Service match AND a ServiceAccount match",
 			&api.NetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -3316,6 +3685,55 @@ func init() {
 			&api.HTTPMatch{Methods: []string{"GET", "GET", "Foo"}},
 			false,
 		),
+		Entry("allow HTTP Header with permitted match clauses",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{
+				{Name: "X-Tenant", Exact: "a"},
+				{Name: "X-Version", Prefix: "v1."},
+				{Name: "X-Request-Id", Regex: "^[0-9a-f-]+$"},
+				{Name: "Authorization", Present: &Vtrue},
+				{Name: "X-Debug", Present: &Vfalse},
+			}},
+			true,
+		),
+		Entry("disallow HTTP Header with multiple match clauses",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "X-Tenant", Exact: "a", Prefix: "a"}}},
+			false,
+		),
+		Entry("disallow HTTP Header with no match clause",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "X-Tenant"}}},
+			false,
+		),
+		Entry("disallow HTTP Header with an invalid name",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "X Tenant", Exact: "a"}}},
+			false,
+		),
+		Entry("disallow HTTP Header with an invalid regex",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "X-Tenant", Regex: "a("}}},
+			false,
+		),
+		Entry("allow HTTP Hosts with valid names",
+			&api.HTTPMatch{Hosts: []string{"api.example.com", "*.example.com"}},
+			true,
+		),
+		Entry("disallow HTTP Hosts with an invalid name",
+			&api.HTTPMatch{Hosts: []string{"api.example.com:80"}},
+			false,
+		),
+		Entry("allow gRPC methods with and without a method name",
+			&api.HTTPMatch{GRPCMethods: []api.GRPCMethodMatch{
+				{Service: "helloworld.Greeter", Method: "SayHello"},
+				{Service: "grpc.health.v1.Health"},
+			}},
+			true,
+		),
+		Entry("disallow gRPC method without a service",
+			&api.HTTPMatch{GRPCMethods: []api.GRPCMethodMatch{{Method: "SayHello"}}},
+			false,
+		),
+		Entry("disallow gRPC method containing a slash",
+			&api.HTTPMatch{GRPCMethods: []api.GRPCMethodMatch{{Service: "helloworld.Greeter/SayHello"}}},
+			false,
+		),
 		Entry("should not accept an invalid IP address",
 			api.FelixConfigurationSpec{NATOutgoingAddress: bad_ipv4_1}, false,
 		),
@@ -3411,6 +3829,7 @@ func init() {
 				ServiceAccount:   &api.ServiceAccountControllerConfig{},
 				Namespace:        &api.NamespaceControllerConfig{},
 				LoadBalancer:     &api.LoadBalancerControllerConfig{},
+				RouteReflector:   &api.RouteReflectorControllerConfig{},
 			}}, true,
 		),
 		Entry("should accept valid reconciliation period on node",
@@ -3458,6 +3877,22 @@ func init() {
 		Entry("should not accept invalid assignIPs value for LoadBalancer config",
 			api.LoadBalancerControllerConfig{AssignIPs: "incorrect-value"}, false,
 		),
+		Entry("should accept valid route reflector config",
+			api.RouteReflectorControllerConfig{
+				NodeSelector:      "has(rr-capable)",
+				ZoneLabel:         "topology.kubernetes.io/zone",
+				ReflectorsPerZone: intHelper(2),
+			}, true,
+		),
+		Entry("should not accept invalid route reflector node selector",
+			api.RouteReflectorControllerConfig{NodeSelector: "has(rr-capable"}, false,
+		),
+		Entry("should not accept invalid route reflector zone label",
+			api.RouteReflectorControllerConfig{ZoneLabel: "not a label"}, false,
+		),
+		Entry("should not accept zero route reflectors per zone",
+			api.RouteReflectorControllerConfig{ReflectorsPerZone: intHelper(0)}, false,
+		),
 		Entry("should not accept template with incorrect name",
 			api.Template{
 				GenerateName: "test$set",
@@ -3618,3 +4053,7 @@ func mustParsePortRange(min, max uint16) numorstring.Port {
 func int32Helper(i int32) *int32 {
 	return &i
 }
+
+func intHelper(i int) *int {
+	return &i
+}
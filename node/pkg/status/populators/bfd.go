@@ -0,0 +1,277 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+)
+
+// Expected BIRD BFD session table columns
+var birdBFDExpectedHeadings = []string{"IP", "address", "Interface", "State", "Since", "Interval", "Timeout"}
+
+// bfdSession is a structure containing details about a BFD session.
+type bfdSession struct {
+	peerIP   string
+	iface    string
+	state    string
+	since    string
+	interval string
+	timeout  string
+}
+
+var birdStateToBFDState map[string]apiv3.BFDSessionState = map[string]apiv3.BFDSessionState{
+	"AdminDown": apiv3.BFDSessionStateAdminDown,
+	"Down":      apiv3.BFDSessionStateDown,
+	"Init":      apiv3.BFDSessionStateInit,
+	"Up":        apiv3.BFDSessionStateUp,
+}
+
+func (s *bfdSession) toNodeStatusAPI() apiv3.CalicoNodeBFDSession {
+	return apiv3.CalicoNodeBFDSession{
+		PeerIP:    s.peerIP,
+		Interface: s.iface,
+		State:     birdStateToBFDState[s.state],
+		Since:     s.since,
+	}
+}
+
+// Unmarshal a session from a line in the BIRD BFD session output.  Returns
+// true if successful, false otherwise.
+func (s *bfdSession) unmarshalBIRD(line string) bool {
+	// Split into fields.  We expect at least 6 columns:
+	// 	IP address, interface, state, since, interval and timeout.
+	// The since column may itself contain spaces depending on the BIRD
+	// time format, so piece it back together from the middle columns.
+	log.Debugf("Parsing line: %s", line)
+
+	columns := strings.Fields(line)
+	if len(columns) < 6 {
+		log.Debug("Not a valid line: fewer than 6 columns.")
+		return false
+	}
+	if net.ParseIP(columns[0]) == nil {
+		log.Debugf("Not a valid line(%s): first column is not an IP address", line)
+		return false
+	}
+
+	s.peerIP = columns[0]
+	// Multihop sessions are not bound to an interface.
+	if columns[1] != "---" {
+		s.iface = columns[1]
+	}
+	s.state = columns[2]
+	s.since = strings.Join(columns[3:len(columns)-2], " ")
+	s.interval = columns[len(columns)-2]
+	s.timeout = columns[len(columns)-1]
+
+	return true
+}
+
+// readBIRDBFDSessions queries BIRD and return BFD session info.
+func readBIRDBFDSessions(bc *birdConn) ([]*bfdSession, error) {
+	c := bc.conn
+	ipv := bc.ipv
+	log.Debugf("Getting BFD sessions for IPv%s", ipv)
+
+	// Send the request.
+	_, err := c.Write([]byte("show bfd sessions\n"))
+	if err != nil {
+		return nil, fmt.Errorf("Error executing command: unable to write to BIRD socket: %s", err)
+	}
+
+	// Scan the output and collect parsed BFD sessions
+	log.Debugln("Reading output from BIRD for BFD sessions")
+	sessions, err := scanBIRDBFDSessions(c)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing command: %v", err)
+	}
+
+	if len(sessions) == 0 {
+		log.Debugf("No IPv%s BFD sessions found.\n", ipv)
+	}
+
+	return sessions, nil
+}
+
+// scanBIRDBFDSessions scans through BIRD output to return a slice of
+// bfdSession structs.
+func scanBIRDBFDSessions(conn net.Conn) ([]*bfdSession, error) {
+	// The following is sample output from BIRD
+	//
+	// 	0001 BIRD v0.3.3+birdv1.6.8 ready.
+	// 	1020-bfd1:
+	// 	 IP address                Interface  State      Since       Interval  Timeout
+	// 	 172.17.8.102              eth0       Up         20:10:57      0.100    0.500
+	// 	 172.17.8.103              ---        Down       20:10:57      1.000    0.000
+	// 	0000
+	//
+	// If BFD is not in use then there is no BFD protocol and BIRD returns an
+	// error code (8xxx or 9xxx) in place of the table.
+	scanner := bufio.NewScanner(conn)
+	sessions := []*bfdSession{}
+
+	// Set a time-out for reading from the socket connection.
+	err := conn.SetReadDeadline(time.Now().Add(birdTimeOut))
+	if err != nil {
+		return nil, errors.New("failed to set time-out")
+	}
+
+	for scanner.Scan() {
+		// Process the next line that has been read by the scanner.
+		str := scanner.Text()
+		log.Debugf("Read: %s\n", str)
+
+		if strings.HasPrefix(str, "0000") {
+			// "0000" means end of data
+			break
+		} else if strings.HasPrefix(str, "0001") {
+			// "0001" code means BIRD is ready.
+		} else if strings.HasPrefix(str, "8") || strings.HasPrefix(str, "9") {
+			// "8xxx" and "9xxx" codes are run-time and parse errors, which
+			// BIRD returns when no BFD protocol is configured.
+			log.Debugf("No BFD protocol available: %s", str)
+			break
+		} else if strings.HasPrefix(str, "1020") {
+			// "1020" code means start of the BFD protocol output, which is
+			// just the protocol name.
+		} else if strings.HasPrefix(str, " ") {
+			// Row starting with a " " is either the headings or another
+			// row of data.
+			f := strings.Fields(str)
+			if len(f) > 0 && f[0] == birdBFDExpectedHeadings[0] {
+				if !reflect.DeepEqual(f, birdBFDExpectedHeadings) {
+					return nil, errors.New("unknown BIRD BFD table output format")
+				}
+			} else {
+				session := bfdSession{}
+				if session.unmarshalBIRD(str[1:]) {
+					sessions = append(sessions, &session)
+				}
+			}
+		} else {
+			// Format of row is unexpected.
+			return nil, fmt.Errorf("unexpected output line from BIRD: %s", str)
+		}
+
+		// Before reading the next line, adjust the time-out for
+		// reading from the socket connection.
+		err = conn.SetReadDeadline(time.Now().Add(birdTimeOut))
+		if err != nil {
+			return nil, errors.New("failed to adjust time-out")
+		}
+	}
+
+	return sessions, scanner.Err()
+}
+
+func getBFDSessions(ipv IPFamily) ([]*bfdSession, error) {
+	bc, err := getBirdConn(ipv)
+	if err != nil {
+		return nil, err
+	}
+	defer bc.Close()
+
+	sessions, err := readBIRDBFDSessions(bc)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get bird BFD sessions")
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// BirdBFDSessions implement populator interface.
+type BirdBFDSessions struct {
+	ipv IPFamily
+}
+
+func NewBirdBFDSessions(ipv IPFamily) BirdBFDSessions {
+	return BirdBFDSessions{ipv: ipv}
+}
+
+func (b BirdBFDSessions) Populate(status *apiv3.CalicoNodeStatus) error {
+	sessions, err := getBFDSessions(b.ipv)
+	if err != nil {
+		// If it is a connection error, e.g. BGP is not enabled,
+		// set empty status.
+		if _, ok := err.(ErrorSocketConnection); ok {
+			if b.ipv == IPFamilyV4 {
+				status.Status.BFD.SessionsV4 = nil
+			} else {
+				status.Status.BFD.SessionsV6 = nil
+			}
+			return nil
+		}
+		log.WithError(err).Errorf("failed to get bird BFD sessions")
+		return err
+	}
+
+	result := []apiv3.CalicoNodeBFDSession{}
+	for _, s := range sessions {
+		result = append(result, s.toNodeStatusAPI())
+	}
+
+	if b.ipv == IPFamilyV4 {
+		status.Status.BFD.SessionsV4 = result
+	} else {
+		status.Status.BFD.SessionsV6 = result
+	}
+
+	return nil
+}
+
+// Show displays BFD sessions.
+func (b BirdBFDSessions) Show() {
+	sessions, err := getBFDSessions(b.ipv)
+	if err != nil {
+		fmt.Printf("Error getting bird BFD sessions: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nbird v%s BFD sessions\n", b.ipv.String())
+	printBFDSessions(sessions, os.Stdout)
+}
+
+// printBFDSessions prints out the slice of BFD sessions in table format.
+func printBFDSessions(sessions []*bfdSession, out io.Writer) {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Peer address", "Interface", "State", "Since", "Interval", "Timeout"})
+
+	for _, s := range sessions {
+		row := []string{
+			s.peerIP,
+			s.iface,
+			s.state,
+			s.since,
+			s.interval,
+			s.timeout,
+		}
+		table.Append(row)
+	}
+
+	table.Render()
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+)
+
+var _ = Describe("Test BIRD BFD session Scanner", func() {
+
+	It("should be able to scan a table with multiple sessions", func() {
+		table := `0001 BIRD v0.3.3+birdv1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Since       Interval  Timeout
+ 172.17.8.102              eth0       Up         20:10:57      0.100    0.500
+ 172.17.8.103              ---        Down       2021-09-19 20:48:43      1.000    0.000
+0000
+We never get here
+`
+		expectedSessions := []*bfdSession{
+			{
+				peerIP:   "172.17.8.102",
+				iface:    "eth0",
+				state:    "Up",
+				since:    "20:10:57",
+				interval: "0.100",
+				timeout:  "0.500",
+			},
+			{
+				peerIP:   "172.17.8.103",
+				iface:    "",
+				state:    "Down",
+				since:    "2021-09-19 20:48:43",
+				interval: "1.000",
+				timeout:  "0.000",
+			},
+		}
+		sessions, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(Equal(expectedSessions))
+
+		// Check we can print sessions.
+		printBFDSessions(sessions, GinkgoWriter)
+	})
+
+	It("should be able to scan an ipv6 table", func() {
+		table := `0001 BIRD v0.3.3+birdv1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Since       Interval  Timeout
+ 2001:20::8                eth0       Init       20:10:57      1.000    0.000
+0000
+`
+		sessions, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV6, table))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(Equal([]*bfdSession{
+			{
+				peerIP:   "2001:20::8",
+				iface:    "eth0",
+				state:    "Init",
+				since:    "20:10:57",
+				interval: "1.000",
+				timeout:  "0.000",
+			},
+		}))
+	})
+
+	It("should return no sessions when BFD is not configured", func() {
+		table := `0001 BIRD v0.3.3+birdv1.6.8 ready.
+9001 There is no BFD protocol running
+`
+		sessions, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(BeEmpty())
+	})
+
+	It("should not allow a table with invalid headings", func() {
+		table := `0001 BIRD v0.3.3+birdv1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Foo       Interval  Timeout
+0000
+`
+		_, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not allow a table with a rogue entry", func() {
+		table := `0001 BIRD v0.3.3+birdv1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Since       Interval  Timeout
+2000 rogue
+0000
+`
+		_, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("Convert to v3 object",
+		func(s *bfdSession, v3Session v3.CalicoNodeBFDSession) {
+			Expect(s.toNodeStatusAPI()).To(Equal(v3Session))
+		},
+		Entry(
+			"session up",
+			&bfdSession{peerIP: "172.17.8.102", iface: "eth0", state: "Up", since: "20:10:57"},
+			v3.CalicoNodeBFDSession{PeerIP: "172.17.8.102", Interface: "eth0", State: v3.BFDSessionStateUp, Since: "20:10:57"},
+		),
+		Entry(
+			"multihop session admin down",
+			&bfdSession{peerIP: "172.17.8.103", state: "AdminDown", since: "20:10:57"},
+			v3.CalicoNodeBFDSession{PeerIP: "172.17.8.103", State: v3.BFDSessionStateAdminDown, Since: "20:10:57"},
+		),
+	)
+})
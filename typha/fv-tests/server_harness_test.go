@@ -32,6 +32,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
 	. "github.com/projectcalico/calico/typha/fv-tests"
 	"github.com/projectcalico/calico/typha/pkg/calc"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
@@ -54,6 +55,7 @@ type ServerHarness struct {
 	serverCxt               context.Context
 	ServerCancel            context.CancelFunc
 	Config                  syncserver.Config
+	CacheConfig             snapcache.Config
 
 	ClientStates     []*ClientState
 	NoOpClientStates []*ClientState
@@ -69,21 +71,14 @@ func NewHarness() *ServerHarness {
 	//
 	h := &ServerHarness{}
 	h.Decoupler = calc.NewSyncerCallbacksDecoupler()
-	h.FelixCache = snapcache.New(snapcache.Config{
-		// Set the batch size small, so we can force new Breadcrumbs easily.
-		MaxBatchSize: 10,
-		// Reduce the wake-up interval from the default to give us faster tear down.
-		WakeUpInterval: 50 * time.Millisecond,
-	})
 	h.BGPDecoupler = calc.NewSyncerCallbacksDecoupler()
-	h.BGPCache = snapcache.New(snapcache.Config{
+	h.CacheConfig = snapcache.Config{
 		// Set the batch size small, so we can force new Breadcrumbs easily.
 		MaxBatchSize: 10,
 		// Reduce the wake-up interval from the default to give us faster tear down.
 		WakeUpInterval: 50 * time.Millisecond,
-	})
+	}
 	h.cacheCxt, h.cacheCancel = context.WithCancel(context.Background())
-	h.ValFilter = calc.NewValidationFilter(h.FelixCache)
 	h.Config = syncserver.Config{
 		PingInterval: 10 * time.Second,
 		Port:         syncserver.PortRandom,
@@ -103,6 +98,9 @@ type ClientState struct {
 }
 
 func (h *ServerHarness) Start() {
+	h.FelixCache = snapcache.New(h.CacheConfig)
+	h.BGPCache = snapcache.New(h.CacheConfig)
+	h.ValFilter = calc.NewValidationFilter(h.FelixCache)
 	h.Server = syncserver.New(
 		map[syncproto.SyncerType]syncserver.BreadcrumbProvider{
 			syncproto.SyncerTypeFelix: h.FelixCache,
@@ -161,6 +159,24 @@ func (h *ServerHarness) CreateClient(id interface{}, syncType syncproto.SyncerTy
 	return c
 }
 
+func (h *ServerHarness) CreateReconnectingClient(id interface{}, syncType syncproto.SyncerType) *ClientState {
+	recorder := NewRecorder()
+	c := h.createClient(id, syncclient.Options{SyncerType: syncType, Reconnect: true}, recorder)
+	c.recorder = recorder
+	go recorder.Loop(c.recorderCtx)
+	h.ClientStates = append(h.ClientStates, c)
+	return c
+}
+
+func (h *ServerHarness) CreateNodeScopedClient(hostname string, syncType syncproto.SyncerType) *ClientState {
+	recorder := NewRecorder()
+	c := h.createClientWithHostname(hostname, syncclient.Options{SyncerType: syncType, NodeScoped: true}, recorder)
+	c.recorder = recorder
+	go recorder.Loop(c.recorderCtx)
+	h.ClientStates = append(h.ClientStates, c)
+	return c
+}
+
 func (h *ServerHarness) CreateClientNoDecodeRestart(id interface{}, syncType syncproto.SyncerType) *ClientState {
 	recorder := NewRecorder()
 	c := h.createClient(id, syncclient.Options{SyncerType: syncType, DisableDecoderRestart: true}, recorder)
@@ -192,11 +208,15 @@ func (h *ServerHarness) ExpectAllClientsToReachState(status api.SyncStatus, kvs
 }
 
 func (h *ServerHarness) createClient(id interface{}, options syncclient.Options, callbacks api.SyncerCallbacks) *ClientState {
+	return h.createClientWithHostname(fmt.Sprintf("test-host-%v", id), options, callbacks)
+}
+
+func (h *ServerHarness) createClientWithHostname(hostname string, options syncclient.Options, callbacks api.SyncerCallbacks) *ClientState {
 	serverAddr := fmt.Sprintf("127.0.0.1:%d", h.Server.Port())
 	client := syncclient.New(
 		discovery.New(discovery.WithAddrOverride(serverAddr)),
 		"test-version",
-		fmt.Sprintf("test-host-%v", id),
+		hostname,
 		"test-info",
 		callbacks,
 		&options,
@@ -272,6 +292,30 @@ func (h *ServerHarness) SendPodUpdates(numPods int) map[string]api.Update {
 	return expectedEndState
 }
 
+// SendWorkloadEndpointUpdates sends generated pods as v1 WorkloadEndpoints, as the Felix syncer would.
+func (h *ServerHarness) SendWorkloadEndpointUpdates(numPods int) map[string]api.Update {
+	expectedEndState := map[string]api.Update{}
+	conv := conversion.NewConverter()
+	proc := updateprocessors.NewWorkloadEndpointUpdateProcessor()
+	for i := 0; i < numPods; i++ {
+		pod := generatePod(h.updIdx)
+		h.updIdx++
+		weps, err := conv.PodToWorkloadEndpoints(pod)
+		Expect(err).NotTo(HaveOccurred())
+		kvs, err := proc.Process(weps[0])
+		Expect(err).NotTo(HaveOccurred())
+		update := api.Update{
+			KVPair:     *kvs[0],
+			UpdateType: api.UpdateTypeKVNew,
+		}
+		path, err := model.KeyToDefaultPath(update.Key)
+		Expect(err).NotTo(HaveOccurred())
+		expectedEndState[path] = update
+		h.Decoupler.OnUpdates([]api.Update{update})
+	}
+	return expectedEndState
+}
+
 func (h *ServerHarness) SendInitialSnapshotConfigs(numConfigs int) map[string]api.Update {
 	expState := h.SendInitialSnapshotConfigsNoInSync(numConfigs)
 	h.SendStatus(api.InSync)
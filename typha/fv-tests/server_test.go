@@ -24,6 +24,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,16 +35,20 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/encap"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/health"
 	calinet "github.com/projectcalico/calico/libcalico-go/lib/net"
 	. "github.com/projectcalico/calico/typha/fv-tests"
 	"github.com/projectcalico/calico/typha/pkg/calc"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/relay"
 	"github.com/projectcalico/calico/typha/pkg/snapcache"
 	"github.com/projectcalico/calico/typha/pkg/syncclient"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
@@ -403,6 +408,83 @@ var _ = Describe("With an in-process Server", func() {
 		})
 	})
 
+	Describe("with a reconnecting client", func() {
+		BeforeEach(func() {
+			h.CreateReconnectingClient("reconnecting", syncproto.SyncerTypeFelix)
+		})
+
+		It("should resume its session after its connection is dropped", func() {
+			expState := h.SendInitialSnapshotPods(10)
+			h.ExpectAllClientsToReachState(api.InSync, expState)
+			resumesBefore, err := getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_connections_resumed")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(h.Server.TerminateRandomConnection(log.WithField("test", "resume"), "test")).To(BeTrue())
+			for k, v := range h.SendPodUpdates(10) {
+				expState[k] = v
+			}
+
+			Eventually(func() (float64, error) {
+				return getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_connections_resumed")
+			}).Should(Equal(resumesBefore + 1))
+			h.ExpectAllClientsToReachState(api.InSync, expState)
+			expectGlobalGaugeValue("typha_connections_active", 1.0)
+		})
+	})
+
+	Describe("with a node-scoped client", func() {
+		var client *ClientState
+
+		BeforeEach(func() {
+			client = h.CreateNodeScopedClient("hostname0", syncproto.SyncerTypeFelix)
+		})
+
+		// scopeToHostname0 converts the expected state to what a node-scoped client on hostname0
+		// should decode.
+		scopeToHostname0 := func(kvs map[string]api.Update) map[string]api.Update {
+			scoped := map[string]api.Update{}
+			for k, upd := range kvs {
+				su, err := syncproto.SerializeUpdate(upd)
+				Expect(err).NotTo(HaveOccurred())
+				scoped[k], err = su.ScopedToNode("hostname0").ToUpdate()
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return scoped
+		}
+		expectFullEndpointsOnlyForHostname0 := func(scopedState map[string]api.Update) {
+			for k, upd := range client.recorder.KVs() {
+				wep := upd.Value.(*model.WorkloadEndpoint)
+				// Felix's validation filter drops endpoints without a name so even slimmed
+				// endpoints must keep it.
+				Expect(wep.Name).NotTo(BeEmpty(), "Expected named endpoint for "+k)
+				if upd.Key.(model.WorkloadEndpointKey).Hostname == "hostname0" {
+					Expect(wep).To(Equal(scopedState[k].Value), "Expected full endpoint for "+k)
+				} else {
+					Expect(wep).To(Equal(syncproto.SlimWorkloadEndpoint(wep)), "Expected slimmed endpoint for "+k)
+					Expect(wep.IPv4Nets).NotTo(BeEmpty())
+					Expect(wep.Labels).NotTo(BeEmpty())
+				}
+			}
+		}
+
+		It("should only receive full endpoints for its own node", func() {
+			// The generated pods are spread over nodes in groups of 20 so this gives us some pods
+			// on hostname0 and some on hostname1 in the snapshot.
+			h.SendStatus(api.ResyncInProgress)
+			expState := h.SendWorkloadEndpointUpdates(40)
+			h.SendStatus(api.InSync)
+			h.ExpectAllClientsToReachState(api.InSync, scopeToHostname0(expState))
+			expectFullEndpointsOnlyForHostname0(scopeToHostname0(expState))
+
+			// And some on other nodes in the deltas.
+			for k, v := range h.SendWorkloadEndpointUpdates(40) {
+				expState[k] = v
+			}
+			h.ExpectAllClientsToReachState(api.InSync, scopeToHostname0(expState))
+			expectFullEndpointsOnlyForHostname0(scopeToHostname0(expState))
+		})
+	})
+
 	// Simulate an old client.
 	Describe("with a client that doesn't support connection restart", func() {
 		BeforeEach(func() {
@@ -531,6 +613,435 @@ var _ = Describe("With an in-process Server", func() {
 	})
 })
 
+var _ = Describe("With a reconnecting client and a server that can't resume the session", func() {
+	var listener net.Listener
+	var recorder *StateRecorder
+	var client *syncclient.SyncerClient
+	var clientCancel, recorderCancel context.CancelFunc
+	var stopServer chan struct{}
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		stopServer = make(chan struct{})
+
+		recorder = NewRecorder()
+		var recorderCtx context.Context
+		recorderCtx, recorderCancel = context.WithCancel(context.Background())
+		go recorder.Loop(recorderCtx)
+	})
+
+	AfterEach(func() {
+		close(stopServer)
+		if client != nil {
+			clientCancel()
+			client.Finished.Wait()
+		}
+		recorderCancel()
+		_ = listener.Close()
+	})
+
+	// serveSession accepts a connection and plays the part of a Typha server with the given cache
+	// generation, sending a snapshot of the given KVs.  If keepOpen is false, it then closes the connection.
+	serveSession := func(hellos chan<- syncproto.MsgClientHello, generationID string, seqNo uint64, keepOpen bool, kvs ...api.Update) {
+		conn, err := listener.Accept()
+		Expect(err).NotTo(HaveOccurred())
+		defer func() {
+			_ = conn.Close()
+		}()
+		r := gob.NewDecoder(conn)
+		w := gob.NewEncoder(conn)
+		send := func(msg any) {
+			Expect(w.Encode(syncproto.Envelope{Message: msg})).To(Succeed())
+		}
+
+		var envelope syncproto.Envelope
+		Expect(r.Decode(&envelope)).To(Succeed())
+		hellos <- envelope.Message.(syncproto.MsgClientHello)
+
+		send(syncproto.MsgServerHello{
+			SyncerType:                  syncproto.SyncerTypeFelix,
+			SupportsNodeResourceUpdates: true,
+			CacheGenerationID:           generationID,
+		})
+		var sus []syncproto.SerializedUpdate
+		for _, kv := range kvs {
+			su, err := syncproto.SerializeUpdate(kv)
+			Expect(err).NotTo(HaveOccurred())
+			sus = append(sus, su)
+		}
+		send(syncproto.MsgKVs{KVs: sus})
+		send(syncproto.MsgSyncStatus{SyncStatus: api.InSync, SequenceNumber: seqNo})
+		if keepOpen {
+			<-stopServer
+		}
+	}
+
+	It("should delete keys that are missing from the new snapshot", func() {
+		hellos := make(chan syncproto.MsgClientHello, 2)
+		go func() {
+			defer GinkgoRecover()
+			serveSession(hellos, "generation-1", 10, false, configFoobarBazzBiff, configFoobar2BazzBiff)
+			serveSession(hellos, "generation-2", 3, true, configFoobarBazzBiff)
+		}()
+
+		client = syncclient.New(
+			discovery.New(discovery.WithAddrOverride(listener.Addr().String())),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			&syncclient.Options{Reconnect: true},
+		)
+		var clientCxt context.Context
+		clientCxt, clientCancel = context.WithCancel(context.Background())
+		Expect(client.Start(clientCxt)).To(Succeed())
+
+		var hello syncproto.MsgClientHello
+		Eventually(hellos).Should(Receive(&hello))
+		Expect(hello.SupportsResume).To(BeTrue())
+		Expect(hello.CacheGenerationID).To(BeEmpty())
+
+		Eventually(hellos).Should(Receive(&hello))
+		Expect(hello.CacheGenerationID).To(Equal("generation-1"))
+		Expect(hello.LastBreadcrumbSeqNo).To(BeNumerically("==", 10))
+
+		Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foobar": configFoobarBazzBiff,
+		}))
+		Expect(recorder.Status()).To(Equal(api.InSync))
+	})
+})
+
+var _ = Describe("With a reconnecting client and two servers", func() {
+	var listeners []net.Listener
+	var listenersLock sync.Mutex
+	var ports []int
+	var recorder *StateRecorder
+	var client *syncclient.SyncerClient
+	var clientCancel, recorderCancel context.CancelFunc
+	var dropFirstConn, stopServers chan struct{}
+	var numConns int
+
+	type helloAtServer struct {
+		server int
+		hello  syncproto.MsgClientHello
+	}
+	var hellos chan helloAtServer
+
+	// serve plays the part of a Typha server with its own cache generation.  It sends a snapshot
+	// on each connection, or nothing if the client resumes.  The first connection that either
+	// server accepts is closed when dropFirstConn is closed.
+	serve := func(idx int) {
+		defer GinkgoRecover()
+		l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", ports[idx]))
+		Expect(err).NotTo(HaveOccurred())
+		listenersLock.Lock()
+		listeners = append(listeners, l)
+		listenersLock.Unlock()
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer GinkgoRecover()
+				defer func() {
+					_ = conn.Close()
+				}()
+				listenersLock.Lock()
+				numConns++
+				first := numConns == 1
+				listenersLock.Unlock()
+
+				r := gob.NewDecoder(conn)
+				w := gob.NewEncoder(conn)
+				send := func(msg any) {
+					Expect(w.Encode(syncproto.Envelope{Message: msg})).To(Succeed())
+				}
+				var envelope syncproto.Envelope
+				Expect(r.Decode(&envelope)).To(Succeed())
+				hello := envelope.Message.(syncproto.MsgClientHello)
+				hellos <- helloAtServer{server: idx, hello: hello}
+
+				generationID := fmt.Sprintf("generation-%d", idx)
+				resumed := hello.CacheGenerationID == generationID
+				send(syncproto.MsgServerHello{
+					SyncerType:                  syncproto.SyncerTypeFelix,
+					SupportsNodeResourceUpdates: true,
+					CacheGenerationID:           generationID,
+					Resumed:                     resumed,
+				})
+				if !resumed {
+					su, err := syncproto.SerializeUpdate(configFoobarBazzBiff)
+					Expect(err).NotTo(HaveOccurred())
+					send(syncproto.MsgKVs{KVs: []syncproto.SerializedUpdate{su}})
+					send(syncproto.MsgSyncStatus{SyncStatus: api.InSync, SequenceNumber: 1})
+				}
+				if first {
+					select {
+					case <-dropFirstConn:
+					case <-stopServers:
+					}
+				} else {
+					<-stopServers
+				}
+			}()
+		}
+	}
+
+	BeforeEach(func() {
+		numConns = 0
+		dropFirstConn = make(chan struct{})
+		stopServers = make(chan struct{})
+		hellos = make(chan helloAtServer, 10)
+		listeners = nil
+
+		// Reserve a port for each server.  Only server 1 is running to start with so that the
+		// client has to skip server 0, which is first in its list.
+		ports = nil
+		var subsets []v1.EndpointSubset
+		for i := 0; i < 2; i++ {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			port := l.Addr().(*net.TCPAddr).Port
+			Expect(l.Close()).To(Succeed())
+			ports = append(ports, port)
+			subsets = append(subsets, v1.EndpointSubset{
+				Addresses: []v1.EndpointAddress{{IP: "127.0.0.1"}},
+				Ports:     []v1.EndpointPort{{Name: "calico-typha", Port: int32(port), Protocol: v1.ProtocolTCP}},
+			})
+		}
+		go serve(1)
+		Eventually(func() int {
+			listenersLock.Lock()
+			defer listenersLock.Unlock()
+			return len(listeners)
+		}).Should(Equal(1))
+
+		k8sClient := fake.NewSimpleClientset(&v1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "calico-typha-service", Namespace: "kube-system"},
+			Subsets:    subsets,
+		})
+		server0First := func(typhas []discovery.Typha) ([]discovery.Typha, error) {
+			sort.Slice(typhas, func(i, j int) bool {
+				return typhas[i].Addr == fmt.Sprintf("127.0.0.1:%d", ports[0])
+			})
+			return typhas, nil
+		}
+
+		recorder = NewRecorder()
+		var recorderCtx context.Context
+		recorderCtx, recorderCancel = context.WithCancel(context.Background())
+		go recorder.Loop(recorderCtx)
+
+		client = syncclient.New(
+			discovery.New(
+				discovery.WithKubeClient(k8sClient),
+				discovery.WithKubeService("kube-system", "calico-typha-service"),
+				discovery.WithPostDiscoveryFilter(server0First),
+			),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			&syncclient.Options{Reconnect: true},
+		)
+		var clientCxt context.Context
+		clientCxt, clientCancel = context.WithCancel(context.Background())
+		Expect(client.Start(clientCxt)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		close(stopServers)
+		clientCancel()
+		client.Finished.Wait()
+		recorderCancel()
+		listenersLock.Lock()
+		defer listenersLock.Unlock()
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	})
+
+	It("should reconnect to the same server to resume its session", func() {
+		var hello helloAtServer
+		Eventually(hellos).Should(Receive(&hello))
+		Expect(hello.server).To(Equal(1))
+		Expect(hello.hello.CacheGenerationID).To(BeEmpty())
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+
+		// Bring up server 0, which is first in the client's list, then drop the connection.
+		go serve(0)
+		Eventually(func() int {
+			listenersLock.Lock()
+			defer listenersLock.Unlock()
+			return len(listeners)
+		}).Should(Equal(2))
+		close(dropFirstConn)
+
+		Eventually(hellos).Should(Receive(&hello))
+		Expect(hello.server).To(Equal(1))
+		Expect(hello.hello.CacheGenerationID).To(Equal("generation-1"))
+		Expect(hello.hello.LastBreadcrumbSeqNo).To(BeNumerically("==", 1))
+
+		Expect(recorder.KVs()).To(Equal(map[string]api.Update{
+			"/calico/v1/config/foobar": configFoobarBazzBiff,
+		}))
+		Consistently(hellos).ShouldNot(Receive())
+	})
+})
+
+var _ = Describe("With an in-process Server that prefers zstd compression", func() {
+	var h *ServerHarness
+
+	BeforeEach(func() {
+		log.SetLevel(log.DebugLevel)
+		h = NewHarness()
+		h.Config.PreferredCompression = syncproto.CompressionZstd
+		h.Start()
+	})
+
+	AfterEach(func() {
+		h.Stop()
+	})
+
+	It("should pass through the snapshot and deltas", func() {
+		expState := h.SendInitialSnapshotPods(100)
+		h.CreateClients(2)
+		h.ExpectAllClientsToReachState(api.InSync, expState)
+		for k, v := range h.SendPodUpdates(100) {
+			expState[k] = v
+		}
+		h.ExpectAllClientsToReachState(api.InSync, expState)
+	})
+
+	Describe("with a raw connection", func() {
+		var rawConn net.Conn
+		var w *gob.Encoder
+		var r *gob.Decoder
+
+		BeforeEach(func() {
+			var err error
+			rawConn, err = net.DialTimeout("tcp", h.Addr(), 10*time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			w = gob.NewEncoder(rawConn)
+			r = gob.NewDecoder(rawConn)
+		})
+
+		AfterEach(func() {
+			err := rawConn.Close()
+			if err != nil {
+				log.WithError(err).Info("Error recorded while closing conn.")
+			}
+		})
+
+		expectCompression := func(clientAlgs []syncproto.CompressionAlgorithm, expectedAlg syncproto.CompressionAlgorithm) {
+			err := w.Encode(syncproto.Envelope{
+				Message: syncproto.MsgClientHello{
+					Hostname:                       "me",
+					Version:                        "test",
+					Info:                           "test info",
+					SupportsDecoderRestart:         true,
+					SupportedCompressionAlgorithms: clientAlgs,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var envelope syncproto.Envelope
+			Expect(r.Decode(&envelope)).To(Succeed())
+			Expect(envelope.Message).To(BeAssignableToTypeOf(syncproto.MsgServerHello{}))
+			Expect(r.Decode(&envelope)).To(Succeed())
+			Expect(envelope.Message).To(BeAssignableToTypeOf(syncproto.MsgDecoderRestart{}))
+			Expect(envelope.Message.(syncproto.MsgDecoderRestart).CompressionAlgorithm).To(Equal(expectedAlg))
+		}
+
+		It("should choose zstd if the client supports it", func() {
+			expectCompression([]syncproto.CompressionAlgorithm{
+				syncproto.CompressionSnappy,
+				syncproto.CompressionZstd,
+			}, syncproto.CompressionZstd)
+		})
+
+		It("should fall back to snappy for a client that doesn't support zstd", func() {
+			expectCompression([]syncproto.CompressionAlgorithm{
+				syncproto.CompressionSnappy,
+			}, syncproto.CompressionSnappy)
+		})
+	})
+})
+
+var _ = Describe("With a relay Typha", func() {
+	var h, relayH *ServerHarness
+	var relaySyncer *relay.Syncer
+	var healthAgg *health.HealthAggregator
+
+	BeforeEach(func() {
+		h = NewHarness()
+		h.Start()
+		upstreamAddr := h.Addr()
+
+		// The relay feeds the pipeline of a second harness, which serves our clients.
+		relayH = NewHarness()
+		relayH.Start()
+		healthAgg = health.NewHealthAggregator()
+		relaySyncer = relay.New(relay.Config{
+			NewDiscoverer: func() *discovery.Discoverer {
+				return discovery.New(discovery.WithAddrOverride(upstreamAddr))
+			},
+			Hostname:         "relay",
+			ClientOptions:    syncclient.Options{SyncerType: syncproto.SyncerTypeFelix},
+			RetryInterval:    100 * time.Millisecond,
+			HealthAggregator: healthAgg,
+		}, relayH.Decoupler)
+		relaySyncer.Start()
+	})
+
+	AfterEach(func() {
+		relayH.Stop()
+		relaySyncer.Stop()
+		h.Stop()
+	})
+
+	upstreamReady := func() bool {
+		return healthAgg.Summary().Ready
+	}
+
+	It("should pass through the snapshot and deltas", func() {
+		Expect(upstreamReady()).To(BeFalse())
+		expState := h.SendInitialSnapshotPods(100)
+		relayH.CreateClients(3)
+		relayH.ExpectAllClientsToReachState(api.InSync, expState)
+		Expect(upstreamReady()).To(BeTrue())
+
+		for k, v := range h.SendPodUpdates(100) {
+			expState[k] = v
+		}
+		relayH.ExpectAllClientsToReachState(api.InSync, expState)
+	})
+
+	It("should stay in sync and delete stale keys when the upstream is replaced", func() {
+		h.SendInitialSnapshotConfigs(10)
+		relayH.CreateClients(3)
+		Eventually(upstreamReady, 10*time.Second, 50*time.Millisecond).Should(BeTrue())
+
+		// Replace the upstream Typha with one that has different data.  The relay can't resume
+		// its session with the new upstream so it gets a new snapshot.
+		port := h.Server.Port()
+		h.Stop()
+		h = NewHarness()
+		h.Config.Port = port
+		h.Start()
+		h.updIdx = 5
+		expState := h.SendInitialSnapshotConfigs(10)
+
+		relayH.ExpectAllClientsToReachState(api.InSync, expState)
+		Expect(upstreamReady()).To(BeTrue())
+	})
+})
+
 var _ = Describe("with no client connections", func() {
 	var h *ServerHarness
 
@@ -813,6 +1324,52 @@ var _ = Describe("With an in-process Server with short ping timeout", func() {
 			})
 		})
 
+		It("should prefer snappy compression by default", func() {
+			err := w.Encode(syncproto.Envelope{
+				Message: syncproto.MsgClientHello{
+					Hostname:               "me",
+					Version:                "test",
+					Info:                   "test info",
+					SupportsDecoderRestart: true,
+					SupportedCompressionAlgorithms: []syncproto.CompressionAlgorithm{
+						syncproto.CompressionZstd,
+						syncproto.CompressionSnappy,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var envelope syncproto.Envelope
+			Expect(r.Decode(&envelope)).To(Succeed())
+			Expect(envelope.Message).To(BeAssignableToTypeOf(syncproto.MsgServerHello{}))
+			Expect(r.Decode(&envelope)).To(Succeed())
+			Expect(envelope.Message).To(Equal(syncproto.MsgDecoderRestart{
+				Message:              "enable compression: snappy;send binary snapshot",
+				CompressionAlgorithm: syncproto.CompressionSnappy,
+			}))
+		})
+
+		It("should send a snapshot to a client resuming from a different cache generation", func() {
+			err := w.Encode(syncproto.Envelope{
+				Message: syncproto.MsgClientHello{
+					Hostname:            "me",
+					Version:             "test",
+					Info:                "test info",
+					SupportsResume:      true,
+					CacheGenerationID:   "some-other-generation",
+					LastBreadcrumbSeqNo: 1,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var envelope syncproto.Envelope
+			Expect(r.Decode(&envelope)).To(Succeed())
+			Expect(envelope.Message).To(BeAssignableToTypeOf(syncproto.MsgServerHello{}))
+			serverHello := envelope.Message.(syncproto.MsgServerHello)
+			Expect(serverHello.CacheGenerationID).To(Equal(h.FelixCache.GenerationID()))
+			Expect(serverHello.Resumed).To(BeFalse())
+		})
+
 		Describe("After sending Hello", func() {
 			BeforeEach(func() {
 				err := w.Encode(syncproto.Envelope{
@@ -984,6 +1541,9 @@ var _ = Describe("With an in-process Server with short grace period", func() {
 		// Since we rely on back-pressure, it's really handy to have a log of exactly what writes
 		// happen and when.
 		h.Config.DebugLogWrites = true
+		// Don't retain old breadcrumbs for resuming sessions; holding on to them changes the
+		// memory profile of the server enough to make the back-pressure timing unreliable.
+		h.CacheConfig.MaxRetainedBreadcrumbs = 1
 
 		h.Start()
 	})
@@ -1197,6 +1757,9 @@ var _ = Describe("With an in-process Server with short write timeout", func() {
 		// Since we rely on back-pressure, it's really handy to have a log of exactly what writes
 		// happen and when.
 		h.Config.DebugLogWrites = true
+		// Don't retain old breadcrumbs for resuming sessions; holding on to them changes the
+		// memory profile of the server enough to make the back-pressure timing unreliable.
+		h.CacheConfig.MaxRetainedBreadcrumbs = 1
 
 		h.Start()
 	})
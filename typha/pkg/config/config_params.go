@@ -112,7 +112,8 @@ type Config struct {
 	PrometheusGoMetricsEnabled      bool   `config:"bool;true"`
 	PrometheusProcessMetricsEnabled bool   `config:"bool;true"`
 
-	SnapshotCacheMaxBatchSize int `config:"int(1,);100"`
+	SnapshotCacheMaxBatchSize           int `config:"int(1,);100"`
+	SnapshotCacheMaxRetainedBreadcrumbs int `config:"int(1,);100"`
 
 	ServerMaxMessageSize                 int           `config:"int(1,);100"`
 	ServerMaxFallBehindSecs              time.Duration `config:"seconds;300"`
@@ -123,6 +124,12 @@ type Config struct {
 	ServerHandshakeTimeoutSecs           time.Duration `config:"seconds;10"`
 	ServerPort                           int           `config:"port;0"`
 
+	// ServerCompressionAlgorithm is the compression algorithm to use with clients that support it; other
+	// clients fall back to an algorithm that they do support.  ServerZstdCompressionLevel is the standard
+	// zstd compression level (1-22) to use when zstd is chosen.
+	ServerCompressionAlgorithm string `config:"oneof(snappy,zstd);snappy"`
+	ServerZstdCompressionLevel int    `config:"int(1,22);3"`
+
 	// Server-side TLS config for Typha's communication with Felix.  If any of these are
 	// specified, they _all_ must be - except that either ClientCN or ClientURISAN may be left
 	// unset - and Typha will then only accept secure (TLS) connections.  Each connecting client
@@ -153,6 +160,26 @@ type Config struct {
 	K8sServiceName                        string        `config:"string;calico-typha"`
 	K8sPortName                           string        `config:"string;calico-typha"`
 
+	// UpstreamTyphaAddr or UpstreamTyphaK8sServiceName put Typha into relay mode: rather than
+	// watching the datastore, Typha consumes from an upstream Typha, either at the given address
+	// or discovered via the given Kubernetes service, and serves the same data to its own clients.
+	UpstreamTyphaAddr             string        `config:"authority;;local"`
+	UpstreamTyphaK8sServiceName   string        `config:"string;;local"`
+	UpstreamTyphaK8sNamespace     string        `config:"string;kube-system;local"`
+	UpstreamTyphaReadTimeoutSecs  time.Duration `config:"seconds;30"`
+	UpstreamTyphaWriteTimeoutSecs time.Duration `config:"seconds;10"`
+
+	// Client-side TLS config for relay mode's connection to the upstream Typha.  If any of these
+	// are specified, they _all_ must be - except that either UpstreamTyphaCN or UpstreamTyphaURISAN
+	// may be left unset.  The upstream Typha must present a certificate signed by a CA in
+	// UpstreamTyphaCAFile, and with CN matching UpstreamTyphaCN or URI SAN matching
+	// UpstreamTyphaURISAN.
+	UpstreamTyphaKeyFile  string `config:"file(must-exist);;local"`
+	UpstreamTyphaCertFile string `config:"file(must-exist);;local"`
+	UpstreamTyphaCAFile   string `config:"file(must-exist);;local"`
+	UpstreamTyphaCN       string `config:"string;;local"`
+	UpstreamTyphaURISAN   string `config:"string;;local"`
+
 	// State tracking.
 
 	// nameToSource tracks where we loaded each config param from.
@@ -311,6 +338,17 @@ func (config *Config) requiringTLS() bool {
 	return config.ServerKeyFile+config.ServerCertFile+config.CAFile+config.ClientCN+config.ClientURISAN != ""
 }
 
+// RelayMode returns true if Typha should consume from an upstream Typha rather than the datastore.
+func (config *Config) RelayMode() bool {
+	return config.UpstreamTyphaAddr != "" || config.UpstreamTyphaK8sServiceName != ""
+}
+
+func (config *Config) requiringUpstreamTLS() bool {
+	// True if any of the upstream TLS parameters are set.
+	return config.UpstreamTyphaKeyFile+config.UpstreamTyphaCertFile+config.UpstreamTyphaCAFile+
+		config.UpstreamTyphaCN+config.UpstreamTyphaURISAN != ""
+}
+
 // Validate() performs cross-field validation.
 func (config *Config) Validate() (err error) {
 	if config.DatastoreType == "etcdv3" && len(config.EtcdEndpoints) == 0 {
@@ -335,6 +373,18 @@ func (config *Config) Validate() (err error) {
 				" - except that either ClientCN or ClientURISAN may be left unset.")
 		}
 	}
+
+	// Similarly for the client-side TLS config for the upstream Typha.
+	if config.requiringUpstreamTLS() {
+		if config.UpstreamTyphaKeyFile == "" ||
+			config.UpstreamTyphaCertFile == "" ||
+			config.UpstreamTyphaCAFile == "" ||
+			(config.UpstreamTyphaCN == "" && config.UpstreamTyphaURISAN == "") {
+			err = errors.New("If any upstream Typha TLS config parameters are specified," +
+				" they _all_ must be" +
+				" - except that either UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.")
+		}
+	}
 	return
 }
 
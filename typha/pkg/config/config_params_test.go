@@ -16,6 +16,7 @@ package config_test
 
 import (
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -81,6 +82,12 @@ var _ = DescribeTable("Config parsing",
 	Entry("PrometheusMetricsPort", "PrometheusMetricsPort", "1234", int(1234)),
 	Entry("PrometheusGoMetricsEnabled", "PrometheusGoMetricsEnabled", "false", false),
 	Entry("PrometheusProcessMetricsEnabled", "PrometheusProcessMetricsEnabled", "false", false),
+
+	Entry("UpstreamTyphaAddr", "UpstreamTyphaAddr", "10.0.0.1:5473", "10.0.0.1:5473"),
+	Entry("UpstreamTyphaAddr Empty", "UpstreamTyphaAddr", "", ""),
+	Entry("UpstreamTyphaK8sServiceName", "UpstreamTyphaK8sServiceName", "calico-typha", "calico-typha"),
+	Entry("UpstreamTyphaK8sNamespace Empty", "UpstreamTyphaK8sNamespace", "", "kube-system"),
+	Entry("UpstreamTyphaReadTimeoutSecs", "UpstreamTyphaReadTimeoutSecs", "60", 60*time.Second),
 )
 
 var _ = DescribeTable("Config validation",
@@ -125,4 +132,15 @@ var _ = DescribeTable("Config validation",
 		"ClientCN":       "typha-peer",
 		"ClientURISAN":   "spiffe://k8s.example.com/typha-peer",
 	}, true),
+	Entry("just one upstream TLS setting", map[string]string{
+		"UpstreamTyphaAddr":    "10.0.0.1:5473",
+		"UpstreamTyphaKeyFile": "/usr",
+	}, false),
+	Entry("upstream TLS certs and key and CN", map[string]string{
+		"UpstreamTyphaAddr":     "10.0.0.1:5473",
+		"UpstreamTyphaKeyFile":  "/usr",
+		"UpstreamTyphaCertFile": "/usr",
+		"UpstreamTyphaCAFile":   "/usr",
+		"UpstreamTyphaCN":       "typha-server",
+	}, true),
 )
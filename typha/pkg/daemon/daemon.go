@@ -45,10 +45,13 @@ import (
 	"github.com/projectcalico/calico/typha/pkg/buildinfo"
 	"github.com/projectcalico/calico/typha/pkg/calc"
 	"github.com/projectcalico/calico/typha/pkg/config"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/jitter"
 	"github.com/projectcalico/calico/typha/pkg/k8s"
 	"github.com/projectcalico/calico/typha/pkg/logutils"
+	"github.com/projectcalico/calico/typha/pkg/relay"
 	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
 )
@@ -228,6 +231,12 @@ configRetry:
 			continue configRetry
 		}
 
+		if configParams.RelayMode() {
+			// In relay mode, all our data comes from the upstream Typha so we never talk to the
+			// datastore.
+			break configRetry
+		}
+
 		// We should now have enough config to connect to the datastore.
 		datastoreConfig = configParams.DatastoreConfig()
 		t.DatastoreClient, err = t.NewClientV3(datastoreConfig)
@@ -247,6 +256,15 @@ configRetry:
 	t.BuildInfoLogCxt.WithField("config", configParams).Info(
 		"Successfully loaded configuration.")
 
+	if configParams.RelayMode() {
+		log.WithFields(log.Fields{
+			"addr":    configParams.UpstreamTyphaAddr,
+			"service": configParams.UpstreamTyphaK8sServiceName,
+		}).Info("Relay mode enabled, skipping datastore initialization.")
+		t.ConfigParams = configParams
+		return nil
+	}
+
 	if datastoreConfig.Spec.DatastoreType == apiconfig.Kubernetes {
 		// Special case: for KDD v1 datamodel to v3 datamodel upgrade, we need to ensure that the datastore migration
 		// has completed before we start serving requests.  Otherwise, we might serve partially-migrated data to
@@ -350,9 +368,10 @@ func (t *TyphaDaemon) addSyncerPipeline(
 
 	// Create our snapshot cache, which stores point-in-time copies of the datastore contents.
 	cache := snapcache.New(snapcache.Config{
-		MaxBatchSize:     t.ConfigParams.SnapshotCacheMaxBatchSize,
-		HealthAggregator: t.healthAggregator,
-		Name:             string(syncerType),
+		MaxBatchSize:           t.ConfigParams.SnapshotCacheMaxBatchSize,
+		MaxRetainedBreadcrumbs: t.ConfigParams.SnapshotCacheMaxRetainedBreadcrumbs,
+		HealthAggregator:       t.healthAggregator,
+		Name:                   string(syncerType),
 	})
 
 	pipeline := &syncerPipeline{
@@ -367,16 +386,55 @@ func (t *TyphaDaemon) addSyncerPipeline(
 	t.CachesBySyncerType[syncerType] = cache
 }
 
+// newRelaySyncerFunc returns a function that creates a Syncer that consumes from the upstream Typha, for
+// use with addSyncerPipeline.
+func (t *TyphaDaemon) newRelaySyncerFunc(syncerType syncproto.SyncerType) func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.WithError(err).Warn("Failed to get hostname, upstream Typha will see an empty hostname.")
+	}
+	return func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+		return relay.New(relay.Config{
+			NewDiscoverer: func() *discovery.Discoverer {
+				return discovery.New(
+					discovery.WithAddrOverride(t.ConfigParams.UpstreamTyphaAddr),
+					discovery.WithInClusterKubeClient(),
+					discovery.WithKubeService(t.ConfigParams.UpstreamTyphaK8sNamespace, t.ConfigParams.UpstreamTyphaK8sServiceName),
+				)
+			},
+			Hostname: hostname,
+			ClientOptions: syncclient.Options{
+				SyncerType:   syncerType,
+				ReadTimeout:  t.ConfigParams.UpstreamTyphaReadTimeoutSecs,
+				WriteTimeout: t.ConfigParams.UpstreamTyphaWriteTimeoutSecs,
+				KeyFile:      t.ConfigParams.UpstreamTyphaKeyFile,
+				CertFile:     t.ConfigParams.UpstreamTyphaCertFile,
+				CAFile:       t.ConfigParams.UpstreamTyphaCAFile,
+				ServerCN:     t.ConfigParams.UpstreamTyphaCN,
+				ServerURISAN: t.ConfigParams.UpstreamTyphaURISAN,
+			},
+			HealthAggregator: t.healthAggregator,
+		}, callbacks)
+	}
+}
+
 // CreateServer creates and configures (but does not start) the server components.
 func (t *TyphaDaemon) CreateServer() {
 	// Health monitoring, for liveness and readiness endpoints.
 	t.healthAggregator = health.NewHealthAggregator()
 
 	// Now create the Syncer and caching layer (one pipeline for each syncer we support).
-	t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	if t.ConfigParams.RelayMode() {
+		// In relay mode, each pipeline is fed by a client of the upstream Typha.
+		for _, st := range syncproto.AllSyncerTypes {
+			t.addSyncerPipeline(st, t.newRelaySyncerFunc(st))
+		}
+	} else {
+		t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	}
 
 	// Create the server, which listens for connections from Felix.
 	t.Server = syncserver.New(
@@ -394,6 +452,8 @@ func (t *TyphaDaemon) CreateServer() {
 			ShutdownMaxDropInterval:        t.ConfigParams.ShutdownConnectionDropIntervalMaxSecs,
 			MaxConns:                       t.ConfigParams.MaxConnectionsUpperLimit,
 			Port:                           t.ConfigParams.ServerPort,
+			PreferredCompression:           syncproto.CompressionAlgorithm(t.ConfigParams.ServerCompressionAlgorithm),
+			ZstdLevel:                      t.ConfigParams.ServerZstdCompressionLevel,
 			HealthAggregator:               t.healthAggregator,
 			KeyFile:                        t.ConfigParams.ServerKeyFile,
 			CertFile:                       t.ConfigParams.ServerCertFile,
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relay implements Typha's relay mode, in which Typha consumes from an upstream Typha
+// instead of watching the datastore.  A relay serves the same data to its own clients, so a
+// site with many nodes needs only a handful of connections to the central Typha instances.
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/health"
+	"github.com/projectcalico/calico/typha/pkg/buildinfo"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+)
+
+const defaultRetryInterval = 1 * time.Second
+
+type healthAggregator interface {
+	RegisterReporter(name string, reports *health.HealthReport, timeout time.Duration)
+	Report(name string, report *health.HealthReport)
+}
+
+type Config struct {
+	// NewDiscoverer returns a discoverer for the upstream Typha instances.  It is called
+	// each time we try to start the upstream client.
+	NewDiscoverer func() *discovery.Discoverer
+	// Hostname is the hostname that we report to the upstream Typha.
+	Hostname string
+	// ClientOptions are the options used for the upstream client.  The SyncerType must be set;
+	// Reconnect is always enabled and ReconnectRetryInterval defaults to RetryInterval.
+	ClientOptions syncclient.Options
+	// RetryInterval is the time to wait between attempts to connect to the upstream Typha.
+	RetryInterval time.Duration
+
+	HealthAggregator healthAggregator
+	// HealthName is the name that we report the health of the upstream link under; defaults to
+	// "<syncer type>-upstream".
+	HealthName string
+}
+
+func (config *Config) ApplyDefaults() {
+	if config.RetryInterval <= 0 {
+		log.WithFields(log.Fields{
+			"value":   config.RetryInterval,
+			"default": defaultRetryInterval,
+		}).Info("Defaulting RetryInterval.")
+		config.RetryInterval = defaultRetryInterval
+	}
+	if config.HealthName == "" {
+		config.HealthName = string(config.ClientOptions.SyncerType) + "-upstream"
+	}
+	config.ClientOptions.Reconnect = true
+	if config.ClientOptions.ReconnectRetryInterval <= 0 {
+		config.ClientOptions.ReconnectRetryInterval = config.RetryInterval
+	}
+}
+
+// Syncer is an api.Syncer that receives its updates from an upstream Typha.  It keeps trying
+// to start the upstream client until it connects.  After that, the client reconnects for as
+// long as the upstream is unavailable; it resumes its session where it can and, where it can't,
+// deletes the keys that are missing from the new snapshot so our state stays consistent.
+//
+// Once we've been in sync, we don't pass on the upstream's non-in-sync statuses; our clients
+// keep the last good state while the upstream resyncs.  We report ready once the upstream
+// first reaches in sync, and not ready if it later falls out of sync.
+type Syncer struct {
+	config    Config
+	callbacks api.SyncerCallbacks
+	logCxt    *log.Entry
+
+	// Only accessed from the goroutine of the upstream client's callbacks.
+	inSync bool
+
+	cancel   context.CancelFunc
+	finished sync.WaitGroup
+}
+
+func New(config Config, callbacks api.SyncerCallbacks) *Syncer {
+	config.ApplyDefaults()
+	if config.HealthAggregator != nil {
+		// Reporters start out not ready; we report ready once the upstream is in sync.
+		config.HealthAggregator.RegisterReporter(config.HealthName, &health.HealthReport{Ready: true}, 0)
+	}
+	return &Syncer{
+		config:    config,
+		callbacks: callbacks,
+		logCxt:    log.WithField("syncerType", config.ClientOptions.SyncerType),
+	}
+}
+
+func (s *Syncer) Start() {
+	s.logCxt.Info("Starting upstream Typha relay.")
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.finished.Add(1)
+	go s.loop(ctx)
+}
+
+func (s *Syncer) Stop() {
+	s.logCxt.Info("Stopping upstream Typha relay.")
+	s.cancel()
+	s.finished.Wait()
+}
+
+func (s *Syncer) loop(ctx context.Context) {
+	defer s.finished.Done()
+	for {
+		client, err := s.startClient(ctx)
+		if err == nil {
+			client.Finished.Wait()
+			if ctx.Err() == nil {
+				// The client only gives up if the upstream can't resume or resync our session.
+				// We've lost track of what our clients have seen so the only safe option is to
+				// restart and let them resync from scratch.
+				s.reportHealth(false)
+				s.logCxt.Fatal("Upstream Typha client stopped unexpectedly.")
+			}
+			return
+		}
+		s.logCxt.WithError(err).Error("Failed to connect to upstream Typha, will retry.")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.RetryInterval):
+		}
+	}
+}
+
+func (s *Syncer) startClient(ctx context.Context) (*syncclient.SyncerClient, error) {
+	options := s.config.ClientOptions
+	client := syncclient.New(
+		s.config.NewDiscoverer(),
+		buildinfo.GitVersion,
+		s.config.Hostname,
+		"typha relay",
+		s,
+		&options,
+	)
+	if err := client.Start(ctx); err != nil {
+		return nil, err
+	}
+	s.logCxt.Info("Connected to upstream Typha.")
+	return client, nil
+}
+
+func (s *Syncer) reportHealth(ready bool) {
+	if s.config.HealthAggregator != nil {
+		s.config.HealthAggregator.Report(s.config.HealthName, &health.HealthReport{Ready: ready})
+	}
+}
+
+func (s *Syncer) OnStatusUpdated(status api.SyncStatus) {
+	s.reportHealth(status == api.InSync)
+	if status == api.InSync {
+		s.inSync = true
+	} else if s.inSync {
+		s.logCxt.WithField("status", status).Debug("Upstream Typha is resyncing, not passing on status.")
+		return
+	}
+	s.callbacks.OnStatusUpdated(status)
+}
+
+func (s *Syncer) OnUpdates(updates []api.Update) {
+	s.callbacks.OnUpdates(updates)
+}
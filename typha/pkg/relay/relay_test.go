@@ -0,0 +1,285 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/health"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/relay"
+	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+	"github.com/projectcalico/calico/typha/pkg/syncserver"
+)
+
+var _ = Describe("Relay Syncer", func() {
+	var (
+		port      int
+		upstream  *upstreamTypha
+		recorder  *recorder
+		healthAgg *mockHealthAggregator
+		syncer    *relay.Syncer
+	)
+
+	BeforeEach(func() {
+		// Reserve a port for the upstream so that we can start (and replace) it whenever we like.
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		port = l.Addr().(*net.TCPAddr).Port
+		Expect(l.Close()).To(Succeed())
+
+		recorder = newRecorder()
+		healthAgg = &mockHealthAggregator{}
+		syncer = relay.New(relay.Config{
+			NewDiscoverer: func() *discovery.Discoverer {
+				return discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", port)))
+			},
+			Hostname:         "relay",
+			ClientOptions:    syncclient.Options{SyncerType: syncproto.SyncerTypeFelix},
+			RetryInterval:    50 * time.Millisecond,
+			HealthAggregator: healthAgg,
+		}, recorder)
+	})
+
+	AfterEach(func() {
+		syncer.Stop()
+		if upstream != nil {
+			upstream.Stop()
+			upstream = nil
+		}
+	})
+
+	It("should register a health reporter that isn't ready", func() {
+		syncer.Start()
+		Expect(healthAgg.Registered()).To(Equal("felix-upstream"))
+		Expect(healthAgg.Ready()).To(BeFalse())
+	})
+
+	Describe("with an upstream Typha", func() {
+		BeforeEach(func() {
+			upstream = startUpstream(port)
+			syncer.Start()
+		})
+
+		It("should only report ready once the upstream is in sync", func() {
+			upstream.cache.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "1")})
+			Eventually(recorder.KVs).Should(Equal(map[string]string{"a": "1", "b": "1"}))
+			Consistently(healthAgg.Ready, "200ms").Should(BeFalse())
+
+			upstream.cache.OnStatusUpdated(api.InSync)
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+			Eventually(healthAgg.Ready).Should(BeTrue())
+		})
+
+		It("should resume its session after its connection is dropped", func() {
+			upstream.cache.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "1")})
+			upstream.cache.OnStatusUpdated(api.InSync)
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+			Eventually(recorder.KVs).Should(Equal(map[string]string{"a": "1", "b": "1"}))
+
+			Expect(upstream.server.TerminateRandomConnection(log.WithField("test", "relay"), "test")).To(BeTrue())
+			upstream.cache.OnUpdates([]api.Update{configUpdate("b", "2"), configUpdate("c", "1")})
+
+			Eventually(recorder.KVs).Should(Equal(map[string]string{"a": "1", "b": "2", "c": "1"}))
+			// Resuming means that we don't get a second snapshot.
+			Expect(recorder.NumUpdates("a")).To(Equal(1))
+			Expect(healthAgg.Ready()).To(BeTrue())
+		})
+
+		It("should delete keys that are missing from a new upstream's snapshot", func() {
+			upstream.cache.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "1")})
+			upstream.cache.OnStatusUpdated(api.InSync)
+			Eventually(recorder.KVs).Should(Equal(map[string]string{"a": "1", "b": "1"}))
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+
+			// Replace the upstream with one that has different data.  It can't resume our
+			// session so the relay gets a new snapshot.
+			upstream.Stop()
+			upstream = startUpstream(port)
+			upstream.cache.OnStatusUpdated(api.ResyncInProgress)
+			upstream.cache.OnUpdates([]api.Update{configUpdate("b", "2"), configUpdate("c", "1")})
+			upstream.cache.OnStatusUpdated(api.InSync)
+
+			Eventually(recorder.KVs).Should(Equal(map[string]string{"b": "2", "c": "1"}))
+			// Our clients keep the last good state while we resync so we only pass on InSync.
+			Expect(recorder.Statuses()).To(Equal([]api.SyncStatus{api.InSync, api.InSync}))
+		})
+	})
+
+	It("should keep trying to connect until the upstream is available", func() {
+		syncer.Start()
+		time.Sleep(200 * time.Millisecond)
+
+		upstream = startUpstream(port)
+		upstream.cache.OnUpdates([]api.Update{configUpdate("a", "1")})
+		upstream.cache.OnStatusUpdated(api.InSync)
+
+		Eventually(recorder.Status, "5s").Should(Equal(api.InSync))
+		Expect(recorder.KVs()).To(Equal(map[string]string{"a": "1"}))
+		Expect(healthAgg.Ready()).To(BeTrue())
+	})
+})
+
+func configUpdate(name, value string) api.Update {
+	return api.Update{
+		KVPair: model.KVPair{
+			Key:      model.GlobalConfigKey{Name: name},
+			Value:    value,
+			Revision: "1234",
+		},
+		UpdateType: api.UpdateTypeKVNew,
+	}
+}
+
+// upstreamTypha runs an in-process Typha server for the felix syncer, fed directly through its cache.
+type upstreamTypha struct {
+	cache                     *snapcache.Cache
+	server                    *syncserver.Server
+	cacheCancel, serverCancel context.CancelFunc
+}
+
+func startUpstream(port int) *upstreamTypha {
+	u := &upstreamTypha{}
+	var cacheCtx, serverCtx context.Context
+	cacheCtx, u.cacheCancel = context.WithCancel(context.Background())
+	serverCtx, u.serverCancel = context.WithCancel(context.Background())
+	u.cache = snapcache.New(snapcache.Config{
+		MaxBatchSize:   10,
+		WakeUpInterval: 50 * time.Millisecond,
+	})
+	u.cache.Start(cacheCtx)
+	u.server = syncserver.New(
+		map[syncproto.SyncerType]syncserver.BreadcrumbProvider{syncproto.SyncerTypeFelix: u.cache},
+		syncserver.Config{Port: port, DropInterval: 50 * time.Millisecond},
+	)
+	u.server.Start(serverCtx)
+	return u
+}
+
+// Stop stops the server before the cache; the server's connections rely on the cache to wake
+// them up when they shut down.
+func (u *upstreamTypha) Stop() {
+	u.serverCancel()
+	u.server.Finished.Wait()
+	u.cacheCancel()
+}
+
+// recorder records what the relay sends to its callbacks.
+type recorder struct {
+	lock       sync.Mutex
+	statuses   []api.SyncStatus
+	kvs        map[string]string
+	numUpdates map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		kvs:        map[string]string{},
+		numUpdates: map[string]int{},
+	}
+}
+
+func (r *recorder) OnStatusUpdated(status api.SyncStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.statuses = append(r.statuses, status)
+}
+
+func (r *recorder) OnUpdates(updates []api.Update) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, upd := range updates {
+		name := upd.Key.(model.GlobalConfigKey).Name
+		if upd.Value == nil {
+			delete(r.kvs, name)
+		} else {
+			r.kvs[name] = upd.Value.(string)
+		}
+		r.numUpdates[name]++
+	}
+}
+
+func (r *recorder) Status() api.SyncStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if len(r.statuses) == 0 {
+		return api.WaitForDatastore
+	}
+	return r.statuses[len(r.statuses)-1]
+}
+
+func (r *recorder) Statuses() []api.SyncStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]api.SyncStatus(nil), r.statuses...)
+}
+
+func (r *recorder) KVs() map[string]string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	kvs := map[string]string{}
+	for k, v := range r.kvs {
+		kvs[k] = v
+	}
+	return kvs
+}
+
+func (r *recorder) NumUpdates(name string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.numUpdates[name]
+}
+
+type mockHealthAggregator struct {
+	lock       sync.Mutex
+	registered string
+	ready      bool
+}
+
+func (m *mockHealthAggregator) RegisterReporter(name string, reports *health.HealthReport, timeout time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.registered = name
+}
+
+func (m *mockHealthAggregator) Report(name string, report *health.HealthReport) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.ready = report.Ready
+}
+
+func (m *mockHealthAggregator) Registered() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.registered
+}
+
+func (m *mockHealthAggregator) Ready() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.ready
+}
@@ -23,6 +23,7 @@ import (
 	"unsafe"
 
 	"github.com/google/btree"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -35,8 +36,9 @@ import (
 )
 
 const (
-	defaultMaxBatchSize   = 100
-	defaultWakeUpInterval = time.Second
+	defaultMaxBatchSize           = 100
+	defaultWakeUpInterval         = time.Second
+	defaultMaxRetainedBreadcrumbs = 100
 )
 
 var (
@@ -137,6 +139,13 @@ type Cache struct {
 	// As described above, we use an unsafe.Pointer so we can do opportunistic atomic reads of the value to avoid
 	// blocking.
 	currentBreadcrumb unsafe.Pointer
+	// oldestBreadcrumb points to the oldest Breadcrumb that we retain so that clients can resume their session
+	// after reconnecting.  Since each Breadcrumb links to the next, this keeps the chain from there to
+	// currentBreadcrumb alive.
+	oldestBreadcrumb unsafe.Pointer
+	// generationID identifies this instance of the cache.  Sequence numbers are only meaningful within one
+	// generation.
+	generationID string
 
 	wakeUpTicker *jitter.Ticker
 	healthTicks  <-chan time.Time
@@ -160,11 +169,13 @@ type healthAggregator interface {
 }
 
 type Config struct {
-	MaxBatchSize     int
-	WakeUpInterval   time.Duration
-	HealthAggregator healthAggregator
-	Name             string
-	HealthName       string
+	MaxBatchSize   int
+	WakeUpInterval time.Duration
+	// MaxRetainedBreadcrumbs is the number of recent Breadcrumbs to keep for resuming client sessions.
+	MaxRetainedBreadcrumbs int
+	HealthAggregator       healthAggregator
+	Name                   string
+	HealthName             string
 }
 
 func (config *Config) ApplyDefaults() {
@@ -182,6 +193,13 @@ func (config *Config) ApplyDefaults() {
 		}).Info("Defaulting WakeUpInterval.")
 		config.WakeUpInterval = defaultWakeUpInterval
 	}
+	if config.MaxRetainedBreadcrumbs <= 0 {
+		log.WithFields(log.Fields{
+			"value":   config.MaxRetainedBreadcrumbs,
+			"default": defaultMaxRetainedBreadcrumbs,
+		}).Info("Defaulting MaxRetainedBreadcrumbs.")
+		config.MaxRetainedBreadcrumbs = defaultMaxRetainedBreadcrumbs
+	}
 	if config.HealthName == "" {
 		if config.Name == "" {
 			config.HealthName = "cache"
@@ -208,6 +226,7 @@ func New(config Config) *Cache {
 		kvs:            kvs,
 		wakeUpTicker:   jitter.NewTicker(config.WakeUpInterval, config.WakeUpInterval/10),
 		healthTicks:    time.NewTicker(healthInterval).C,
+		generationID:   uuid.NewString(),
 	}
 
 	var err error
@@ -238,6 +257,7 @@ func New(config Config) *Cache {
 		counterBreadcrumbNonBlock: c.counterBreadcrumbNonBlock,
 	}
 	c.currentBreadcrumb = (unsafe.Pointer)(snap)
+	c.oldestBreadcrumb = (unsafe.Pointer)(snap)
 
 	if config.HealthAggregator != nil {
 		config.HealthAggregator.RegisterReporter(config.HealthName, &health.HealthReport{Live: true, Ready: true}, healthInterval*2)
@@ -253,6 +273,25 @@ func (c *Cache) CurrentBreadcrumb() *Breadcrumb {
 	return (*Breadcrumb)(atomic.LoadPointer(&c.currentBreadcrumb))
 }
 
+// GenerationID returns the ID of this instance of the cache.  It is safe to call from any goroutine.
+func (c *Cache) GenerationID() string {
+	return c.generationID
+}
+
+// RetainedBreadcrumb returns the Breadcrumb with the given sequence number if it is still retained, or nil
+// otherwise.  It is safe to call from any goroutine.
+func (c *Cache) RetainedBreadcrumb(seqNo uint64) *Breadcrumb {
+	for b := (*Breadcrumb)(atomic.LoadPointer(&c.oldestBreadcrumb)); b != nil; b = b.loadNext() {
+		if b.SequenceNumber == seqNo {
+			return b
+		}
+		if b.SequenceNumber > seqNo {
+			break
+		}
+	}
+	return nil
+}
+
 // OnStatusUpdated implements the SyncerCallbacks API.  It shouldn't be called directly.
 func (c *Cache) OnStatusUpdated(status api.SyncStatus) {
 	c.inputC <- status
@@ -467,6 +506,12 @@ func (c *Cache) publishBreadcrumb() {
 	atomic.StorePointer(&(oldCrumb.next), (unsafe.Pointer)(newCrumb))
 	atomic.StorePointer(&c.currentBreadcrumb, (unsafe.Pointer)(newCrumb))
 	c.breadcrumbCond.L.Unlock()
+	// Drop our reference to any Breadcrumbs that have fallen out of the retention window.
+	oldest := (*Breadcrumb)(atomic.LoadPointer(&c.oldestBreadcrumb))
+	for newCrumb.SequenceNumber-oldest.SequenceNumber >= uint64(c.config.MaxRetainedBreadcrumbs) {
+		oldest = oldest.loadNext()
+	}
+	atomic.StorePointer(&c.oldestBreadcrumb, (unsafe.Pointer)(oldest))
 	// Then wake up any watching clients.  Note: Go's Cond doesn't require us to hold the lock
 	// while calling Broadcast.
 	log.WithField("seqNo", newCrumb.SequenceNumber).Debug("Broadcasting new Breadcrumb")
@@ -506,6 +506,61 @@ var _ = Describe("With a short wake-up interval", func() {
 	})
 })
 
+var _ = Describe("With a small breadcrumb retention window", func() {
+	var cache *snapcache.Cache
+	var cxt context.Context
+	var cancel context.CancelFunc
+
+	BeforeEach(func() {
+		log.SetLevel(log.InfoLevel)
+		cache = snapcache.New(snapcache.Config{
+			MaxBatchSize:           10,
+			WakeUpInterval:         10 * time.Second,
+			MaxRetainedBreadcrumbs: 3,
+		})
+		cxt, cancel = context.WithCancel(context.Background())
+		cache.Start(cxt)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	sendConfigUpdate := func(n int) {
+		cache.OnUpdates([]api.Update{{
+			KVPair: model.KVPair{
+				Key:      model.GlobalConfigKey{Name: fmt.Sprintf("foo%d", n)},
+				Value:    "bar",
+				Revision: fmt.Sprint(n),
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}})
+		Eventually(func() uint64 { return cache.CurrentBreadcrumb().SequenceNumber }).Should(BeNumerically("==", n))
+	}
+
+	It("should have a stable generation ID", func() {
+		Expect(cache.GenerationID()).NotTo(BeEmpty())
+		Expect(cache.GenerationID()).To(Equal(cache.GenerationID()))
+		Expect(snapcache.New(snapcache.Config{}).GenerationID()).NotTo(Equal(cache.GenerationID()))
+	})
+
+	It("should only retain the most recent breadcrumbs", func() {
+		Expect(cache.RetainedBreadcrumb(0)).To(Equal(cache.CurrentBreadcrumb()))
+		for i := 1; i <= 5; i++ {
+			sendConfigUpdate(i)
+		}
+		for _, seqNo := range []uint64{0, 1, 2, 6} {
+			Expect(cache.RetainedBreadcrumb(seqNo)).To(BeNil(), fmt.Sprintf("seqNo %d", seqNo))
+		}
+		for _, seqNo := range []uint64{3, 4, 5} {
+			crumb := cache.RetainedBreadcrumb(seqNo)
+			Expect(crumb).NotTo(BeNil(), fmt.Sprintf("seqNo %d", seqNo))
+			Expect(crumb.SequenceNumber).To(Equal(seqNo))
+		}
+		Expect(cache.RetainedBreadcrumb(5)).To(Equal(cache.CurrentBreadcrumb()))
+	})
+})
+
 func newFollower(cache *snapcache.Cache, name string, wg *sync.WaitGroup, initDelay, targetLatency time.Duration) *follower {
 	wg.Add(1)
 	return &follower{
@@ -634,6 +689,9 @@ var _ = Describe("Zero config after applying defaults", func() {
 	It("should default the wake up interval", func() {
 		Expect(config.WakeUpInterval).To(Equal(time.Second))
 	})
+	It("should default the number of retained breadcrumbs", func() {
+		Expect(config.MaxRetainedBreadcrumbs).To(Equal(100))
+	})
 })
 
 var _ = Describe("Non-zero config after applying defaults", func() {
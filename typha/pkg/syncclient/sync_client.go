@@ -28,11 +28,14 @@ import (
 	"time"
 
 	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 
 	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/readlogger"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/tlsutils"
@@ -60,6 +63,25 @@ type Options struct {
 	// it (such as compression).  Useful for simulating an older client in UT.
 	DisableDecoderRestart bool
 
+	// Reconnect tells the client to reconnect if its connection to Typha fails, rather than
+	// shutting down.  On reconnection, the client asks Typha to resume the session from the
+	// last breadcrumb that it applied.  Only the same, still-running, Typha instance can resume
+	// the session so the client tries that instance first.  If Typha can't resume, the client
+	// receives a new snapshot and sends deletions for any keys that are missing from it.  The
+	// client still shuts down if it fails to reconnect (unless ReconnectRetryInterval is set), or
+	// if the server doesn't support resuming.
+	Reconnect bool
+	// ReconnectRetryInterval, if non-zero, tells a reconnecting client to keep trying to
+	// reconnect, waiting this long between rounds of connection attempts, rather than shutting
+	// down when it fails to reconnect.
+	ReconnectRetryInterval time.Duration
+
+	// NodeScoped asks Typha to send full WorkloadEndpoints only for this client's own node (as
+	// given by the hostname passed to New).  Other nodes' endpoints are sent as a slimmed
+	// projection containing only what is needed to calculate IP set membership.  Older Typha
+	// instances ignore the request and send full endpoints.
+	NodeScoped bool
+
 	// DebugLogReads tells the client to wrap each connection with a Reader that
 	// logs every read.  Intended only for use in tests!
 	DebugLogReads bool
@@ -141,6 +163,7 @@ func New(
 		handshakeStatus: &handshakeStatus{
 			helloReceivedChan: make(chan struct{}, 1),
 		},
+		knownKeys: set.New[string](),
 	}
 }
 
@@ -152,13 +175,25 @@ type SyncerClient struct {
 	myHostname, myVersion, myInfo string
 	options                       *Options
 
+	// connLock protects connection, which is replaced when we reconnect, against the shutdown goroutine.
+	connLock                    sync.Mutex
 	connection                  net.Conn
 	connR                       io.Reader
 	encoder                     *gob.Encoder
 	decoder                     *gob.Decoder
+	zstdDecoder                 *zstd.Decoder // The stream that decoder reads from, if zstd compression is active.
 	handshakeStatus             *handshakeStatus
 	supportsNodeResourceUpdates bool
 
+	// Session state, only maintained if Options.Reconnect is set.  cacheGenerationID and
+	// lastBreadcrumbSeqNo identify the last breadcrumb that we applied.  knownKeys holds the keys
+	// that we've sent to the callbacks.  After a failed resume, staleKeys holds the keys that we
+	// knew about before the new snapshot and that the snapshot hasn't included (yet).
+	cacheGenerationID   string
+	lastBreadcrumbSeqNo uint64
+	knownKeys           set.Set[string]
+	staleKeys           set.Set[string]
+
 	callbacks callbacksWithKeysKnown
 	Finished  sync.WaitGroup
 }
@@ -184,30 +219,8 @@ type handshakeStatus struct {
 func (s *SyncerClient) Start(cxt context.Context) error {
 	// Connect synchronously so that we can return an error early if we can't connect at all.
 	s.logCxt.Info("Starting Typha client...")
-
-	// Defensive: in case there's a bug in NextAddr() and it never stops returning values,
-	// set a sanity limit on the number of tries.
-	maxTries := s.calculateConnectionAttemptLimit(len(s.discoverer.CachedTyphaAddrs()))
-	remainingTries := maxTries
-	cat := discovery.NewConnAttemptTracker(s.discoverer)
-	for {
-		remainingTries--
-		if remainingTries < 0 {
-			return fmt.Errorf("failed to connect to Typha after %d tries", maxTries)
-		}
-		addr, err := cat.NextAddr()
-		if err != nil {
-			return fmt.Errorf("failed to load next Typha address to try: %w", err)
-		}
-		s.logCxt.Infof("Connecting to typha endpoint %s.", addr.Addr)
-		err = s.connect(cxt, addr)
-		if err != nil {
-			s.logCxt.WithError(err).Warnf("Failed to connect to typha endpoint %s.  Will try another if available...", addr.Addr)
-			time.Sleep(100 * time.Millisecond) // Avoid tight loop.
-		} else {
-			s.logCxt.Infof("Successfully connected to Typha at %s.", addr.Addr)
-			break
-		}
+	if err := s.connectToAnyTypha(cxt); err != nil {
+		return err
 	}
 
 	// Then start our background goroutines.  We start the main loop and a second goroutine to
@@ -227,7 +240,9 @@ func (s *SyncerClient) Start(cxt context.Context) error {
 		s.logCxt.Info("Typha client Context asked us to exit, closing connection...")
 		// Close the connection.  This will trigger the main loop to exit if it hasn't
 		// already.
+		s.connLock.Lock()
 		err := s.connection.Close()
+		s.connLock.Unlock()
 		if err != nil {
 			log.WithError(err).Warn("Ignoring error from Close during shut-down of client.")
 		}
@@ -235,6 +250,35 @@ func (s *SyncerClient) Start(cxt context.Context) error {
 	return nil
 }
 
+// connectToAnyTypha tries each of the discovered Typha instances in turn until it manages to
+// connect to one.
+func (s *SyncerClient) connectToAnyTypha(cxt context.Context) error {
+	// Defensive: in case there's a bug in NextAddr() and it never stops returning values,
+	// set a sanity limit on the number of tries.
+	maxTries := s.calculateConnectionAttemptLimit(len(s.discoverer.CachedTyphaAddrs()))
+	remainingTries := maxTries
+	cat := discovery.NewConnAttemptTracker(s.discoverer)
+	for {
+		remainingTries--
+		if remainingTries < 0 {
+			return fmt.Errorf("failed to connect to Typha after %d tries", maxTries)
+		}
+		addr, err := cat.NextAddr()
+		if err != nil {
+			return fmt.Errorf("failed to load next Typha address to try: %w", err)
+		}
+		s.logCxt.Infof("Connecting to typha endpoint %s.", addr.Addr)
+		err = s.connect(cxt, addr)
+		if err != nil {
+			s.logCxt.WithError(err).Warnf("Failed to connect to typha endpoint %s.  Will try another if available...", addr.Addr)
+			time.Sleep(100 * time.Millisecond) // Avoid tight loop.
+		} else {
+			s.logCxt.Infof("Successfully connected to Typha at %s.", addr.Addr)
+			return nil
+		}
+	}
+}
+
 func (s *SyncerClient) calculateConnectionAttemptLimit(numDiscoveredTyphas int) int {
 	expectedNumTyphas := numDiscoveredTyphas
 	if expectedNumTyphas < 3 {
@@ -271,7 +315,6 @@ func (s *SyncerClient) SupportsNodeResourceUpdates(timeout time.Duration) (bool,
 
 func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) error {
 	log.Info("Starting Typha client")
-	var err error
 	logCxt := s.logCxt.WithField("address", typhaAddr)
 
 	var connFunc func(string) (net.Conn, error)
@@ -324,10 +367,13 @@ func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) e
 	}
 	if cxt.Err() == nil {
 		logCxt.Info("Connecting to Typha.")
-		s.connection, err = connFunc(typhaAddr.Addr)
+		conn, err := connFunc(typhaAddr.Addr)
 		if err != nil {
 			return err
 		}
+		s.connLock.Lock()
+		s.connection = conn
+		s.connLock.Unlock()
 		s.connR = s.connection
 		if s.options.DebugLogReads {
 			s.connR = readlogger.New(s.connection)
@@ -404,18 +450,71 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	defer s.Finished.Done()
 	defer cancelFn()
 
+	for {
+		s.handleConnection(cxt)
+		if cxt.Err() != nil || !s.options.Reconnect {
+			return
+		}
+		if s.cacheGenerationID == "" {
+			s.logCxt.Warn("Connection to Typha failed and server doesn't support resuming the session; not reconnecting.")
+			return
+		}
+		s.logCxt.WithField("seqNo", s.lastBreadcrumbSeqNo).Info("Connection to Typha failed, reconnecting...")
+		if err := s.connection.Close(); err != nil {
+			s.logCxt.WithError(err).Debug("Ignoring error from Close of failed connection.")
+		}
+		for {
+			err := s.reconnect(cxt)
+			if err == nil {
+				break
+			}
+			if s.options.ReconnectRetryInterval <= 0 {
+				s.logCxt.WithError(err).Error("Failed to reconnect to Typha.")
+				return
+			}
+			s.logCxt.WithError(err).WithField("retryInterval", s.options.ReconnectRetryInterval).Warn(
+				"Failed to reconnect to Typha, will retry.")
+			select {
+			case <-cxt.Done():
+				return
+			case <-time.After(s.options.ReconnectRetryInterval):
+			}
+		}
+	}
+}
+
+// reconnect reconnects to Typha after the connection fails.  Only the Typha instance that we were
+// connected to can resume our session so we try that one first before falling back to the others.
+func (s *SyncerClient) reconnect(cxt context.Context) error {
+	if s.connInfo != nil {
+		lastTypha := *s.connInfo
+		s.logCxt.Infof("Reconnecting to previous typha endpoint %s.", lastTypha.Addr)
+		err := s.connect(cxt, lastTypha)
+		if err == nil {
+			s.logCxt.Infof("Successfully reconnected to Typha at %s.", lastTypha.Addr)
+			return nil
+		}
+		s.logCxt.WithError(err).Warnf("Failed to reconnect to typha endpoint %s.  Will try others if available...", lastTypha.Addr)
+	}
+	return s.connectToAnyTypha(cxt)
+}
+
+// handleConnection does the handshake on the current connection and then processes messages from the
+// server until the connection fails.
+func (s *SyncerClient) handleConnection(cxt context.Context) {
 	logCxt := s.logCxt.WithField("connection", s.connInfo)
 	logCxt.Info("Started Typha client main loop")
 
 	// Always start with basic gob encoding for the handshake.  We may upgrade to a compressed version below.
 	s.encoder = gob.NewEncoder(s.connection)
 	s.decoder = gob.NewDecoder(s.connR)
+	defer s.closeZstdDecoder()
 
 	ourSyncerType := s.options.SyncerType
 	if ourSyncerType == "" {
 		ourSyncerType = syncproto.SyncerTypeFelix
 	}
-	compAlgs := []syncproto.CompressionAlgorithm{syncproto.CompressionSnappy}
+	compAlgs := []syncproto.CompressionAlgorithm{syncproto.CompressionSnappy, syncproto.CompressionZstd}
 	if s.options.DisableDecoderRestart {
 		// Compression requires decoder restart.
 		compAlgs = nil
@@ -429,6 +528,10 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 			SupportsDecoderRestart:         !s.options.DisableDecoderRestart,
 			SupportedCompressionAlgorithms: compAlgs,
 			ClientConnID:                   s.ID,
+			SupportsResume:                 s.options.Reconnect,
+			CacheGenerationID:              s.cacheGenerationID,
+			LastBreadcrumbSeqNo:            s.lastBreadcrumbSeqNo,
+			NodeScoped:                     s.options.NodeScoped,
 		},
 	)
 	if err != nil {
@@ -454,8 +557,30 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	if !serverHello.SupportsNodeResourceUpdates {
 		logCxt.Info("Server responded without support for node resource updates, assuming older Typha")
 	}
-	s.supportsNodeResourceUpdates = serverHello.SupportsNodeResourceUpdates
-	s.handshakeStatus.helloReceivedChan <- struct{}{}
+	if s.options.NodeScoped && !serverHello.NodeScoped {
+		logCxt.Info("Server doesn't support node-scoped updates, assuming older Typha; will receive all endpoints")
+	}
+	if s.cacheGenerationID != "" {
+		// We're reconnecting; our callbacks have already been told whether the server supports
+		// node resource updates so we can't continue if that has changed.
+		if serverHello.SupportsNodeResourceUpdates != s.supportsNodeResourceUpdates {
+			logCxt.Error("Reconnected to a server with different support for node resource updates.")
+			s.cacheGenerationID = ""
+			return
+		}
+		if serverHello.CacheGenerationID == "" {
+			// Without sequence numbers, we can't tell when the server's snapshot is complete.
+			logCxt.Error("Reconnected to a server that doesn't support resuming sessions.")
+			s.cacheGenerationID = ""
+			return
+		}
+	} else {
+		s.supportsNodeResourceUpdates = serverHello.SupportsNodeResourceUpdates
+		s.handshakeStatus.helloReceivedChan <- struct{}{}
+	}
+	if s.options.Reconnect {
+		s.startSession(logCxt, serverHello)
+	}
 
 	// Check the SyncerType reported by the server.  If the server is too old to support SyncerType then
 	// the message will have an empty string in place of the SyncerType.  In that case we only proceed if
@@ -481,6 +606,9 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 		switch msg := msg.(type) {
 		case syncproto.MsgSyncStatus:
 			logCxt.WithField("newStatus", msg.SyncStatus).Info("Status update from Typha.")
+			if msg.SequenceNumber != 0 {
+				s.onBreadcrumbApplied(logCxt, msg.SequenceNumber)
+			}
 			s.callbacks.OnStatusUpdated(msg.SyncStatus)
 		case syncproto.MsgPing:
 			logCxt.Debug("Ping received from Typha")
@@ -515,7 +643,13 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 				updates = append(updates, update)
 				keys = append(keys, kv.Key)
 			}
+			if s.options.Reconnect {
+				s.trackKeys(updates, keys)
+			}
 			s.callbacks.OnUpdatesKeysKnown(updates, keys)
+			if msg.SequenceNumber != 0 {
+				s.onBreadcrumbApplied(logCxt, msg.SequenceNumber)
+			}
 		case syncproto.MsgDecoderRestart:
 			if s.options.DisableDecoderRestart {
 				log.Error("Server sent MsgDecoderRestart but we signalled no support.")
@@ -533,14 +667,92 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	}
 }
 
+// startSession updates our session state after the handshake.  If the server didn't resume our
+// session, it's about to send a snapshot, so the keys we already know about become stale until
+// the snapshot includes them.
+func (s *SyncerClient) startSession(logCxt *log.Entry, serverHello syncproto.MsgServerHello) {
+	if serverHello.Resumed {
+		logCxt.WithField("seqNo", s.lastBreadcrumbSeqNo).Info("Server resumed our session.")
+	} else {
+		if s.cacheGenerationID != "" {
+			logCxt.WithField("numKnownKeys", s.knownKeys.Len()).Info(
+				"Server couldn't resume our session, expecting a new snapshot.")
+		}
+		if s.staleKeys == nil {
+			s.staleKeys = s.knownKeys
+		} else {
+			// A previous snapshot was interrupted.
+			s.staleKeys.AddSet(s.knownKeys)
+		}
+		s.knownKeys = set.New[string]()
+		s.lastBreadcrumbSeqNo = 0
+	}
+	s.cacheGenerationID = serverHello.CacheGenerationID
+}
+
+// trackKeys records which keys the callbacks currently know about.
+func (s *SyncerClient) trackKeys(updates []api.Update, keys []string) {
+	for i, upd := range updates {
+		if upd.Value == nil {
+			s.knownKeys.Discard(keys[i])
+		} else {
+			s.knownKeys.Add(keys[i])
+		}
+		if s.staleKeys != nil {
+			s.staleKeys.Discard(keys[i])
+		}
+	}
+}
+
+// onBreadcrumbApplied records the sequence number of the breadcrumb that we've now applied.  If
+// this completes a snapshot that replaced our previous state, it first sends deletions for the
+// keys that the snapshot didn't include.
+func (s *SyncerClient) onBreadcrumbApplied(logCxt *log.Entry, seqNo uint64) {
+	if !s.options.Reconnect {
+		return
+	}
+	if s.staleKeys != nil && s.staleKeys.Len() > 0 {
+		logCxt.WithField("numKeys", s.staleKeys.Len()).Info("Deleting keys that were missing from the new snapshot.")
+		updates := make([]api.Update, 0, s.staleKeys.Len())
+		keys := make([]string, 0, s.staleKeys.Len())
+		s.staleKeys.Iter(func(key string) error {
+			parsedKey := model.KeyFromDefaultPath(key)
+			if parsedKey == nil {
+				logCxt.WithField("key", key).Warn("Failed to parse stale key, skipping.")
+				return nil
+			}
+			updates = append(updates, api.Update{
+				KVPair:     model.KVPair{Key: parsedKey},
+				UpdateType: api.UpdateTypeKVDeleted,
+			})
+			keys = append(keys, key)
+			return nil
+		})
+		s.callbacks.OnUpdatesKeysKnown(updates, keys)
+	}
+	s.staleKeys = nil
+	s.lastBreadcrumbSeqNo = seqNo
+}
+
 func (s *SyncerClient) restartDecoder(cxt context.Context, logCxt *log.Entry, msg syncproto.MsgDecoderRestart) error {
 	logCxt.WithField("msg", msg).Info("Server asked us to restart our decoder")
+	s.closeZstdDecoder()
 	// Check if we should enable compression.
 	switch msg.CompressionAlgorithm {
 	case syncproto.CompressionSnappy:
 		logCxt.Info("Server selected snappy compression.")
 		r := snappy.NewReader(s.connR)
 		s.decoder = gob.NewDecoder(r)
+	case syncproto.CompressionZstd:
+		logCxt.Info("Server selected zstd compression.")
+		// Decode on this goroutine; there's no benefit to decoding ahead since we process messages in order.
+		r, err := zstd.NewReader(s.connR, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			logCxt.WithError(err).Error("Failed to create zstd decoder.")
+			return err
+		}
+		s.zstdDecoder = r
+		s.decoder = gob.NewDecoder(r)
 	case "":
 		logCxt.Info("Server selected no compression.")
 		s.decoder = gob.NewDecoder(s.connR)
@@ -552,6 +764,13 @@ func (s *SyncerClient) restartDecoder(cxt context.Context, logCxt *log.Entry, ms
 	return err
 }
 
+func (s *SyncerClient) closeZstdDecoder() {
+	if s.zstdDecoder != nil {
+		s.zstdDecoder.Close()
+		s.zstdDecoder = nil
+	}
+}
+
 // sendMessageToServer sends a single value-type MsgXYZ object to the server.  It updates the connection's
 // write deadline to ensure we don't block forever.  Logs errors via logConnectionFailure.
 func (s *SyncerClient) sendMessageToServer(cxt context.Context, logCxt *log.Entry, op string, message interface{}) error {
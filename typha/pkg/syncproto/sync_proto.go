@@ -83,6 +83,37 @@
 //	|<-----------------------|
 //	|                        |
 //
+// # Resuming a session
+//
+// Clients that set SupportsResume in their ClientHello receive a sequence number with each
+// delta KVs and SyncStatus message, along with the generation ID of Typha's cache in the
+// ServerHello.  Once the snapshot has been sent, Typha also sends a SyncStatus message to
+// tell the client the sequence number of the snapshot.  If the client reconnects, it
+// passes back the generation ID and last sequence number that it applied.  If the same
+// cache still retains that breadcrumb, Typha sets Resumed in its ServerHello, skips the
+// snapshot and streams the deltas that the client missed.  Otherwise, it sends a
+// snapshot as normal.
+//
+// The generation ID is chosen at random when Typha starts, and the breadcrumbs only live
+// in memory, so resuming only covers transient disconnects from a Typha that is still
+// running.  A client that reconnects to a different Typha, or to one that has restarted,
+// always gets a snapshot.  To make a resume possible, clients retry the Typha that they
+// were last connected to before trying the others.
+//
+// # Node-scoped updates
+//
+// Felix only needs the full WorkloadEndpoint objects for its own node; for other nodes'
+// endpoints it only uses the IPs, labels, profiles and named ports to calculate IP set
+// membership.  Clients that set NodeScoped in their ClientHello receive full endpoints
+// only for the node named in their Hostname field.  Other nodes' endpoints are sent as
+// a slimmed projection (see SlimWorkloadEndpoint).  The projection is calculated once,
+// when the update is serialized, so it is shared by all node-scoped clients.  Typha
+// echoes NodeScoped in its ServerHello if it honoured the request; older servers ignore
+// the field and send full endpoints, which is still correct.
+//
+// Since the compressed binary snapshot is shared by all clients, node-scoped clients
+// are always sent a streamed snapshot.
+//
 // # Wire format
 //
 // The protocol uses gob to encode messages.  Each message is wrapped in an Envelope
@@ -171,6 +202,11 @@
 // sent until after the other side has acknowledged that it has drained the old
 // format data and prepared the new format decoder.  Otherwise the old format decoder
 // may eagerly read data in the new format into its buffer and get confused.
+//
+// Compression is negotiated in the handshake: the client lists the algorithms that it
+// supports in SupportedCompressionAlgorithms and the server picks one (according to its
+// own preference) and names it in the MsgDecoderRestart.  Older servers ignore algorithms
+// that they don't recognise, so new algorithms can be offered unconditionally.
 package syncproto
 
 import (
@@ -244,6 +280,7 @@ type CompressionAlgorithm string
 
 const (
 	CompressionSnappy CompressionAlgorithm = "snappy"
+	CompressionZstd   CompressionAlgorithm = "zstd"
 )
 
 // MsgClientHello is the first message sent by the client after it opens the connection.  It begins the handshake.
@@ -261,6 +298,21 @@ type MsgClientHello struct {
 	SupportedCompressionAlgorithms []CompressionAlgorithm
 
 	ClientConnID uint64
+
+	// SupportsResume is set by clients that track the SequenceNumber fields of MsgKVs and MsgSyncStatus and so
+	// are able to resume a session from a breadcrumb after reconnecting.
+	SupportsResume bool
+	// CacheGenerationID and LastBreadcrumbSeqNo identify the last breadcrumb that the client applied on a
+	// previous connection.  If the server's cache has the same generation ID and still retains that breadcrumb,
+	// it skips the snapshot and only sends the deltas that followed it.  Sequence numbers are local to a cache
+	// so a different generation ID (for example, because the client connected to a different Typha or Typha
+	// restarted) always results in a snapshot.
+	CacheGenerationID   string
+	LastBreadcrumbSeqNo uint64
+
+	// NodeScoped is set by clients that only need full WorkloadEndpoints for their own node (as given by
+	// Hostname).  Other nodes' endpoints are sent as a slimmed projection.
+	NodeScoped bool
 }
 
 // MsgServerHello is the server's response to MsgClientHello.
@@ -275,6 +327,16 @@ type MsgServerHello struct {
 	SupportsNodeResourceUpdates bool
 
 	ServerConnID uint64
+
+	// CacheGenerationID is the generation ID of the server's cache, sent to clients that support resume so
+	// that they can include it in their MsgClientHello if they reconnect.
+	CacheGenerationID string
+	// Resumed is true if the server accepted the client's request to resume from its last breadcrumb.  In
+	// that case, the server skips the snapshot and streams deltas from that breadcrumb onwards.
+	Resumed bool
+
+	// NodeScoped is true if the server honoured the client's request for node-scoped updates.
+	NodeScoped bool
 }
 
 // MsgDecoderRestart is sent (currently only from server to client) to tell it to restart its decoder with new
@@ -290,6 +352,10 @@ type MsgACK struct {
 }
 type MsgSyncStatus struct {
 	SyncStatus api.SyncStatus
+
+	// SequenceNumber, if non-zero, is the sequence number of the breadcrumb that the client has fully
+	// applied once it has processed this message.
+	SequenceNumber uint64
 }
 type MsgPing struct {
 	Timestamp time.Time
@@ -300,6 +366,10 @@ type MsgPong struct {
 }
 type MsgKVs struct {
 	KVs []SerializedUpdate
+
+	// SequenceNumber, if non-zero, is the sequence number of the breadcrumb that the client has fully
+	// applied once it has processed this message.  It is zero for the messages that make up a snapshot.
+	SequenceNumber uint64
 }
 
 func (m MsgKVs) String() string {
@@ -370,9 +440,40 @@ func SerializeUpdate(u api.Update) (su SerializedUpdate, err error) {
 	}
 	su.Value = value
 
+	if k, ok := u.Key.(model.WorkloadEndpointKey); ok {
+		if wep, ok := u.Value.(*model.WorkloadEndpoint); ok {
+			// Pre-calculate the projection that we send to node-scoped clients on other nodes.
+			slimValue, err := model.SerializeValue(&model.KVPair{Key: k, Value: SlimWorkloadEndpoint(wep)})
+			if err != nil {
+				log.WithError(err).WithField("update", u).Error(
+					"Bug: failed to serialize slimmed workload endpoint, will send full value to all clients.")
+				return su, nil
+			}
+			su.node = k.Hostname
+			su.slimValue = slimValue
+		}
+	}
+
 	return
 }
 
+// SlimWorkloadEndpoint returns a copy of the given endpoint with only the fields that are needed to
+// calculate IP set membership on other nodes: its IPs, labels, profiles (which contribute inherited
+// labels) and named ports.  Name and State are retained because Felix's validation filter treats an
+// endpoint without a name as missing, and GenerateName is retained for flow log aggregation.
+func SlimWorkloadEndpoint(wep *model.WorkloadEndpoint) *model.WorkloadEndpoint {
+	return &model.WorkloadEndpoint{
+		State:        wep.State,
+		Name:         wep.Name,
+		ProfileIDs:   wep.ProfileIDs,
+		IPv4Nets:     wep.IPv4Nets,
+		IPv6Nets:     wep.IPv6Nets,
+		Labels:       wep.Labels,
+		Ports:        wep.Ports,
+		GenerateName: wep.GenerateName,
+	}
+}
+
 type SerializedUpdate struct {
 	Key               string
 	Value             []byte
@@ -380,6 +481,22 @@ type SerializedUpdate struct {
 	V3ResourceVersion string
 	TTL               time.Duration
 	UpdateType        api.UpdateType
+
+	// node and slimValue are only set for WorkloadEndpoints.  They hold the endpoint's node and its
+	// projection for node-scoped clients on other nodes (see ScopedToNode).  They're unexported so
+	// that gob doesn't send them over the wire.
+	node      string
+	slimValue []byte
+}
+
+// ScopedToNode returns the update that should be sent to a node-scoped client on the given node.  For
+// other nodes' WorkloadEndpoints, that is the slimmed projection of the endpoint; other updates are
+// returned unchanged.
+func (s SerializedUpdate) ScopedToNode(hostname string) SerializedUpdate {
+	if s.slimValue != nil && s.node != hostname {
+		s.Value = s.slimValue
+	}
+	return s
 }
 
 var ErrBadKey = errors.New("Unable to parse key.")
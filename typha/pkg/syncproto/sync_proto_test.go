@@ -21,6 +21,11 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
 )
 
 const cannedEnvelopeWithHello = "Iv+BAwEBCEVudmVsb3BlAf+CAAEBAQdNZXNzYWdlARAAAAD/jP+CATtnaXRodWIuY29tL3Byb2plY3R" +
@@ -78,3 +83,54 @@ func TestEncodeCanned(t *testing.T) {
 
 	t.Logf("%q", b2.String())
 }
+
+func TestScopedToNode(t *testing.T) {
+	RegisterTestingT(t)
+
+	key := model.WorkloadEndpointKey{
+		Hostname:       "node-a",
+		OrchestratorID: "k8s",
+		WorkloadID:     "default/pod-1",
+		EndpointID:     "eth0",
+	}
+	wep := &model.WorkloadEndpoint{
+		State:      "active",
+		Name:       "cali12345",
+		ProfileIDs: []string{"kns.default"},
+		IPv4Nets:   []net.IPNet{net.MustParseCIDR("10.0.0.1/32")},
+		Labels:     map[string]string{"app": "web"},
+		Ports:      []model.EndpointPort{{Name: "http", Protocol: numorstring.ProtocolFromString("TCP"), Port: 80}},
+	}
+	su, err := SerializeUpdate(api.Update{
+		KVPair:     model.KVPair{Key: key, Value: wep, Revision: "1"},
+		UpdateType: api.UpdateTypeKVNew,
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// The endpoint's own node gets the full value.
+	Expect(su.ScopedToNode("node-a").Value).To(Equal(su.Value))
+	upd, err := su.ScopedToNode("node-a").ToUpdate()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(upd.Value).To(Equal(wep))
+
+	// Other nodes get the projection.
+	upd, err = su.ScopedToNode("node-b").ToUpdate()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(upd.Key).To(Equal(key))
+	Expect(upd.Value).To(Equal(&model.WorkloadEndpoint{
+		State:      wep.State,
+		Name:       wep.Name,
+		ProfileIDs: wep.ProfileIDs,
+		IPv4Nets:   wep.IPv4Nets,
+		Labels:     wep.Labels,
+		Ports:      wep.Ports,
+	}))
+
+	// Other resources are unaffected.
+	su, err = SerializeUpdate(api.Update{
+		KVPair:     model.KVPair{Key: model.HostIPKey{Hostname: "node-a"}, Value: net.ParseIP("10.0.0.1")},
+		UpdateType: api.UpdateTypeKVNew,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(su.ScopedToNode("node-b")).To(Equal(su))
+}
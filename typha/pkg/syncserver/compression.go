@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncserver
+
+import (
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	log "github.com/sirupsen/logrus"
+)
+
+// zstdStreamWindowSize is the zstd window size that we use for per-connection streams.  zstd's default
+// window is several MB, and the encoder needs a multiple of that per connection, which adds up with
+// thousands of clients.  Once the snapshot has been sent, most messages are small so a larger window
+// gains very little.
+const zstdStreamWindowSize = 256 * 1024
+
+// compressingWriter is the interface shared by the compressed stream writers that we support.
+type compressingWriter interface {
+	io.Writer
+	Flush() error
+	Close() error
+}
+
+func newSnappyWriter(w io.Writer) compressingWriter {
+	return snappy.NewBufferedWriter(w)
+}
+
+// newZstdWriter returns a zstd stream writer with the given (standard zstd) compression level.  If
+// windowSize is 0, zstd's default window size for that level is used.
+func newZstdWriter(w io.Writer, level int, windowSize int) compressingWriter {
+	opts := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		// Compress on the calling goroutine rather than spinning up background encoders; we already
+		// have a goroutine per connection.
+		zstd.WithEncoderConcurrency(1),
+	}
+	if windowSize > 0 {
+		opts = append(opts, zstd.WithWindowSize(windowSize), zstd.WithLowerEncoderMem(true))
+	}
+	enc, err := zstd.NewWriter(w, opts...)
+	if err != nil {
+		// Only fails if the options are invalid.
+		log.WithError(err).Panic("Bug: failed to create zstd writer.")
+	}
+	return enc
+}
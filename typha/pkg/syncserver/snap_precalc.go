@@ -22,7 +22,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/golang/snappy"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
@@ -62,7 +61,11 @@ func init() {
 	prometheus.MustRegister(gaugeVecSnapCompressedBytes)
 }
 
-type SnappySnapshotCache struct {
+// BinarySnapshotCache maintains a pre-compressed binary snapshot of a cache, which is shared by all
+// clients that connect while it is valid.  Each instance uses a single compression algorithm.
+type BinarySnapshotCache struct {
+	compression         syncproto.CompressionAlgorithm
+	newWriter           func(io.Writer) compressingWriter
 	snapValidityTimeout time.Duration
 	logCtx              *logrus.Entry
 
@@ -86,14 +89,44 @@ func NewSnappySnapCache(
 	cache BreadcrumbProvider,
 	snapValidityTimeout time.Duration,
 	writeTimeout time.Duration,
-) *SnappySnapshotCache {
-	s := &SnappySnapshotCache{
+) *BinarySnapshotCache {
+	return newBinarySnapCache(syncerName, syncproto.CompressionSnappy, newSnappyWriter, cache, snapValidityTimeout, writeTimeout)
+}
+
+// NewZstdSnapCache creates a BinarySnapshotCache that compresses its snapshots with zstd at the given
+// level.  Since the snapshot is shared by all clients, it uses zstd's default window size, which
+// gives better compression than the smaller window that we use for per-connection streams.
+func NewZstdSnapCache(
+	syncerName string,
+	level int,
+	cache BreadcrumbProvider,
+	snapValidityTimeout time.Duration,
+	writeTimeout time.Duration,
+) *BinarySnapshotCache {
+	newWriter := func(w io.Writer) compressingWriter {
+		return newZstdWriter(w, level, 0)
+	}
+	return newBinarySnapCache(syncerName, syncproto.CompressionZstd, newWriter, cache, snapValidityTimeout, writeTimeout)
+}
+
+func newBinarySnapCache(
+	syncerName string,
+	compression syncproto.CompressionAlgorithm,
+	newWriter func(io.Writer) compressingWriter,
+	cache BreadcrumbProvider,
+	snapValidityTimeout time.Duration,
+	writeTimeout time.Duration,
+) *BinarySnapshotCache {
+	s := &BinarySnapshotCache{
+		compression:         compression,
+		newWriter:           newWriter,
 		snapValidityTimeout: snapValidityTimeout,
 		writeTimeout:        writeTimeout,
 		cache:               cache,
 		logCtx: logrus.WithFields(logrus.Fields{
-			"thread": "snapshotter",
-			"syncer": syncerName,
+			"thread":      "snapshotter",
+			"syncer":      syncerName,
+			"compression": compression,
 		}),
 		counterBinSnapsGenerated: counterVecSnapshotsGenerated.WithLabelValues(syncerName),
 		counterBinSnapsReused:    counterVecSnapshotsReused.WithLabelValues(syncerName),
@@ -104,11 +137,11 @@ func NewSnappySnapCache(
 	return s
 }
 
-// SendSnapshot waits for a binary snapshot to be ready and then sends it as a raw snappy-compressed gob stream
-// on the given connection.  Since the stream is cached, it starts with fresh snappy/gob headers.  Hence, the
+// SendSnapshot waits for a binary snapshot to be ready and then sends it as a raw compressed gob stream
+// on the given connection.  Since the stream is cached, it starts with fresh compression/gob headers.  Hence, the
 // decoder at the client side must also be reset before sending such a snapshot.  The snapshot ends with
 // a MsgDecoderRestart, so the caller should wait for an ACK and then reset their encoder.
-func (s *SnappySnapshotCache) SendSnapshot(ctx context.Context, w io.Writer, conn WriteDeadlineSetter) (*snapcache.Breadcrumb, error) {
+func (s *BinarySnapshotCache) SendSnapshot(ctx context.Context, w io.Writer, conn WriteDeadlineSetter) (*snapcache.Breadcrumb, error) {
 	// activeBinarySnapshot ensures there is an active snapshot and returns it.  The snapshot may or may not
 	// be complete yet.
 	snap := s.activeBinarySnapshot()
@@ -124,7 +157,7 @@ type WriteDeadlineSetter interface {
 
 // activeBinarySnapshot either returns the current active snapshot (which may still be being created on a background
 // goroutine), or it starts a new snapshot.  The returned snapshot's complete flag will be set once it is finished.
-func (s *SnappySnapshotCache) activeBinarySnapshot() *snapshot {
+func (s *BinarySnapshotCache) activeBinarySnapshot() *snapshot {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -146,7 +179,7 @@ func (s *SnappySnapshotCache) activeBinarySnapshot() *snapshot {
 	return s.activeSnapshot
 }
 
-func (s *SnappySnapshotCache) populateSnapshot(snap *snapshot) {
+func (s *BinarySnapshotCache) populateSnapshot(snap *snapshot) {
 	s.writeDataToSnapshot(snap)
 	// Wait until the snapshot expires...
 	time.Sleep(s.snapValidityTimeout)
@@ -155,14 +188,14 @@ func (s *SnappySnapshotCache) populateSnapshot(snap *snapshot) {
 	s.clearSnapshot()
 }
 
-func (s *SnappySnapshotCache) clearSnapshot() {
+func (s *BinarySnapshotCache) clearSnapshot() {
 	s.lock.Lock()
 	s.activeSnapshot = nil
 	s.lock.Unlock()
 }
 
 type progressWriter struct {
-	W            *snappy.Writer
+	W            compressingWriter
 	BytesWritten int
 }
 
@@ -172,10 +205,10 @@ func (p2 *progressWriter) Write(p []byte) (n int, err error) {
 	return
 }
 
-func (s *SnappySnapshotCache) writeDataToSnapshot(snap *snapshot) {
+func (s *BinarySnapshotCache) writeDataToSnapshot(snap *snapshot) {
 	s.counterBinSnapsGenerated.Inc()
-	snappyW := snappy.NewBufferedWriter(snap.buf)
-	progressW := progressWriter{W: snappyW}
+	compW := s.newWriter(snap.buf)
+	progressW := progressWriter{W: compW}
 	encoder := gob.NewEncoder(&progressW)
 	writeMsg := func(msg any) error {
 		envelope := syncproto.Envelope{
@@ -193,6 +226,7 @@ func (s *SnappySnapshotCache) writeDataToSnapshot(snap *snapshot) {
 		snap.crumb,
 		writeMsg,
 		1000, // Allow bigger messages in the snapshot.
+		"",   // The binary snapshot is shared so it can't be node-scoped.
 	)
 	if err != nil {
 		// Shouldn't happen because we're serialising to an in-memory buffer.
@@ -201,14 +235,14 @@ func (s *SnappySnapshotCache) writeDataToSnapshot(snap *snapshot) {
 
 	err = writeMsg(syncproto.MsgDecoderRestart{
 		Message:              "End of compressed snapshot.",
-		CompressionAlgorithm: syncproto.CompressionSnappy,
+		CompressionAlgorithm: s.compression,
 	})
 	if err != nil {
 		// Shouldn't happen because we're serialising to an in-memory buffer.
 		s.logCtx.WithError(err).Panic("Failed to serialise datastore snapshot end message.")
 	}
 
-	err = snappyW.Close() // Does Flush() for us.
+	err = compW.Close() // Does Flush() for us.
 	if err != nil {
 		// Shouldn't happen because we're serialising to an in-memory buffer.
 		s.logCtx.WithError(err).Panic("Failed to close datastore snapshot.")
@@ -229,7 +263,7 @@ func (s *SnappySnapshotCache) writeDataToSnapshot(snap *snapshot) {
 	s.setLastSnapSize(snapSize)
 }
 
-func (s *SnappySnapshotCache) setLastSnapSize(snapSize int) {
+func (s *BinarySnapshotCache) setLastSnapSize(snapSize int) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	s.lastSnapSize = snapSize
@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncserver
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/multireadbuf"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+// The benchmarks in this file compare the compression algorithms that we support for binary snapshots.
+// As well as the usual CPU time per snapshot, they report the compressed size of the snapshot.  Run with:
+//
+//	go test -run xxx -bench BinarySnapshot ./typha/pkg/syncserver
+
+const benchSnapshotNumEndpoints = 10000
+
+type benchCompression struct {
+	name      string
+	alg       syncproto.CompressionAlgorithm
+	newWriter func(io.Writer) compressingWriter
+}
+
+var benchCompressions = []benchCompression{
+	{name: "none", newWriter: func(w io.Writer) compressingWriter { return nopCompressingWriter{w} }},
+	{name: "snappy", alg: syncproto.CompressionSnappy, newWriter: newSnappyWriter},
+	{name: "zstd-1", alg: syncproto.CompressionZstd, newWriter: zstdWriterFactory(1)},
+	{name: "zstd-3", alg: syncproto.CompressionZstd, newWriter: zstdWriterFactory(3)},
+	{name: "zstd-9", alg: syncproto.CompressionZstd, newWriter: zstdWriterFactory(9)},
+}
+
+func zstdWriterFactory(level int) func(io.Writer) compressingWriter {
+	return func(w io.Writer) compressingWriter {
+		return newZstdWriter(w, level, 0)
+	}
+}
+
+type nopCompressingWriter struct {
+	io.Writer
+}
+
+func (nopCompressingWriter) Flush() error { return nil }
+func (nopCompressingWriter) Close() error { return nil }
+
+func BenchmarkBinarySnapshotEncode(b *testing.B) {
+	crumb := benchBreadcrumb(b)
+	for _, c := range benchCompressions {
+		b.Run(c.name, func(b *testing.B) {
+			var snapSize int
+			for i := 0; i < b.N; i++ {
+				snap := benchWriteSnapshot(crumb, c)
+				snapSize = snap.buf.Len()
+			}
+			b.ReportMetric(float64(snapSize), "snapshot-bytes")
+		})
+	}
+}
+
+func BenchmarkBinarySnapshotDecode(b *testing.B) {
+	crumb := benchBreadcrumb(b)
+	for _, c := range benchCompressions {
+		b.Run(c.name, func(b *testing.B) {
+			snap := benchWriteSnapshot(crumb, c)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				benchReadSnapshot(b, snap, c.alg)
+			}
+			b.ReportMetric(float64(snap.buf.Len()), "snapshot-bytes")
+		})
+	}
+}
+
+func benchWriteSnapshot(crumb *snapcache.Breadcrumb, c benchCompression) *snapshot {
+	s := newBinarySnapCache("bench", c.alg, c.newWriter, nil, time.Second, time.Second)
+	snap := &snapshot{
+		crumb: crumb,
+		buf:   multireadbuf.New(1024 * 1024),
+	}
+	s.writeDataToSnapshot(snap)
+	return snap
+}
+
+// benchReadSnapshot decodes the snapshot as the client would, up to the trailing MsgDecoderRestart.
+func benchReadSnapshot(b *testing.B, snap *snapshot, alg syncproto.CompressionAlgorithm) {
+	var r io.Reader = snap.buf.Reader()
+	switch alg {
+	case syncproto.CompressionSnappy:
+		r = snappy.NewReader(r)
+	case syncproto.CompressionZstd:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+	decoder := gob.NewDecoder(r)
+	numKVs := 0
+	for {
+		var envelope syncproto.Envelope
+		if err := decoder.Decode(&envelope); err != nil {
+			b.Fatal(err)
+		}
+		switch msg := envelope.Message.(type) {
+		case syncproto.MsgKVs:
+			numKVs += len(msg.KVs)
+		case syncproto.MsgDecoderRestart:
+			if numKVs != benchSnapshotNumEndpoints {
+				b.Fatalf("Expected %d KVs but got %d", benchSnapshotNumEndpoints, numKVs)
+			}
+			return
+		}
+	}
+}
+
+// benchBreadcrumb returns a breadcrumb containing a realistic snapshot of workload endpoints.
+func benchBreadcrumb(b *testing.B) *snapcache.Breadcrumb {
+	logLevel := logrus.GetLevel()
+	logrus.SetLevel(logrus.WarnLevel)
+	b.Cleanup(func() { logrus.SetLevel(logLevel) })
+
+	cache := snapcache.New(snapcache.Config{})
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Cleanup(cancel)
+	cache.Start(ctx)
+
+	rng := rand.New(rand.NewSource(1))
+	randomHex := func(n int) string {
+		buf := make([]byte, n/2)
+		rng.Read(buf)
+		return fmt.Sprintf("%x", buf)
+	}
+	cache.OnStatusUpdated(api.ResyncInProgress)
+	for i := 0; i < benchSnapshotNumEndpoints; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i/100)
+		podName := fmt.Sprintf("app-%d-%s-%s", i/10, randomHex(10), randomHex(5))
+		ip := net.MustParseCIDR(fmt.Sprintf("10.%d.%d.%d/32", i>>16&0xff, i>>8&0xff, i&0xff))
+		cache.OnUpdates([]api.Update{{
+			KVPair: model.KVPair{
+				Key: model.WorkloadEndpointKey{
+					Hostname:       fmt.Sprintf("node-%d", i/50),
+					OrchestratorID: "k8s",
+					WorkloadID:     namespace + "/" + podName,
+					EndpointID:     "eth0",
+				},
+				Value: &model.WorkloadEndpoint{
+					State:      "active",
+					Name:       "cali" + randomHex(11),
+					ProfileIDs: []string{"kns." + namespace, "ksa." + namespace + ".default"},
+					IPv4Nets:   []net.IPNet{ip},
+					Labels: map[string]string{
+						"app":                               fmt.Sprintf("app-%d", i/10),
+						"pod-template-hash":                 randomHex(10),
+						"projectcalico.org/namespace":       namespace,
+						"projectcalico.org/orchestrator":    "k8s",
+						"projectcalico.org/serviceaccount":  "default",
+						"topology.kubernetes.io/zone":       fmt.Sprintf("zone-%d", i%3),
+						"app.kubernetes.io/managed-by":      "helm",
+						"app.kubernetes.io/instance-suffix": randomHex(8),
+					},
+					GenerateName: fmt.Sprintf("app-%d-", i/10),
+				},
+				Revision: fmt.Sprint(i),
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}})
+	}
+	cache.OnStatusUpdated(api.InSync)
+
+	for cache.CurrentBreadcrumb().SyncStatus != api.InSync {
+		time.Sleep(10 * time.Millisecond)
+	}
+	return cache.CurrentBreadcrumb()
+}
@@ -30,7 +30,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/golang/snappy"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -78,6 +77,10 @@ var (
 		Help: "Total number of connections that made use of the grace period to catch up after sending the initial " +
 			"snapshot.",
 	}, []string{"syncer"})
+	counterVecConnectionsResumed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_connections_resumed",
+		Help: "Total number of connections that resumed from a retained breadcrumb instead of receiving a snapshot.",
+	}, []string{"syncer"})
 )
 
 func init() {
@@ -89,6 +92,8 @@ func init() {
 	promutils.PreCreateGaugePerSyncer(gaugeVecNumConnectionsStreaming)
 	prometheus.MustRegister(counterVecGracePeriodUsed)
 	promutils.PreCreateCounterPerSyncer(counterVecGracePeriodUsed)
+	prometheus.MustRegister(counterVecConnectionsResumed)
+	promutils.PreCreateCounterPerSyncer(counterVecConnectionsResumed)
 }
 
 const (
@@ -103,6 +108,8 @@ const (
 	defaultDropInterval                   = 1 * time.Second
 	defaultShutdownTimeout                = 300 * time.Second
 	defaultMaxConns                       = math.MaxInt32
+	defaultPreferredCompression           = syncproto.CompressionSnappy
+	defaultZstdLevel                      = 3
 	PortRandom                            = -1
 )
 
@@ -128,6 +135,8 @@ type Server struct {
 
 type BreadcrumbProvider interface {
 	CurrentBreadcrumb() *snapcache.Breadcrumb
+	RetainedBreadcrumb(seqNo uint64) *snapcache.Breadcrumb
+	GenerationID() string
 }
 
 type Config struct {
@@ -153,6 +162,12 @@ type Config struct {
 	ClientURISAN                   string
 	WriteBufferSize                int
 
+	// PreferredCompression is the compression algorithm to use with clients that support it.  Clients
+	// that don't support it get any other algorithm that they do support.
+	PreferredCompression syncproto.CompressionAlgorithm
+	// ZstdLevel is the (standard zstd) compression level to use for zstd-compressed connections.
+	ZstdLevel int
+
 	// DebugLogWrites tells the server to wrap each connection with a Writer that
 	// logs every write.  Intended only for use in tests!
 	DebugLogWrites bool
@@ -253,6 +268,20 @@ func (c *Config) ApplyDefaults() {
 		}).Info("Defaulting MaxConns.")
 		c.MaxConns = defaultMaxConns
 	}
+	if c.PreferredCompression == "" {
+		log.WithFields(log.Fields{
+			"value":   c.PreferredCompression,
+			"default": defaultPreferredCompression,
+		}).Info("Defaulting PreferredCompression.")
+		c.PreferredCompression = defaultPreferredCompression
+	}
+	if c.ZstdLevel <= 0 {
+		log.WithFields(log.Fields{
+			"value":   c.ZstdLevel,
+			"default": defaultZstdLevel,
+		}).Info("Defaulting ZstdLevel.")
+		c.ZstdLevel = defaultZstdLevel
+	}
 	if c.Port == 0 {
 		// We use 0 to mean "use the default port".
 		log.WithFields(log.Fields{
@@ -291,9 +320,13 @@ func New(caches map[syncproto.SyncerType]BreadcrumbProvider, config Config) *Ser
 	}
 
 	s.binSnapCaches[syncproto.CompressionSnappy] = map[syncproto.SyncerType]snapshotCache{}
+	s.binSnapCaches[syncproto.CompressionZstd] = map[syncproto.SyncerType]snapshotCache{}
 	for st, cache := range caches {
 		s.perSyncerConnMetrics[st] = makePerSyncerConnMetrics(st)
+		// Binary snapshots are only generated on demand so there's no cost to having a cache for an
+		// algorithm that no client chooses.
 		s.binSnapCaches[syncproto.CompressionSnappy][st] = NewSnappySnapCache(string(st), cache, config.BinarySnapshotTimeout, config.WriteTimeout)
+		s.binSnapCaches[syncproto.CompressionZstd][st] = NewZstdSnapCache(string(st), config.ZstdLevel, cache, config.BinarySnapshotTimeout, config.WriteTimeout)
 	}
 
 	// Register that we will report liveness.
@@ -686,6 +719,13 @@ type connection struct {
 	logCxt                       *log.Entry
 	chosenCompression            syncproto.CompressionAlgorithm
 	clientSupportsDecoderRestart bool
+	clientSupportsResume         bool
+	// resumeFrom is the breadcrumb that the client last applied, if it asked to resume its session and we
+	// still retain that breadcrumb.  If set, we skip the snapshot.
+	resumeFrom *snapcache.Breadcrumb
+	// nodeScopedHostname is set if the client asked for node-scoped updates.  It is the client's node, for
+	// which we send full WorkloadEndpoints; other nodes' endpoints are sent as a slimmed projection.
+	nodeScopedHostname string
 
 	// Similarly to allCaches, allMetrics contains all the metrics relevant to a particular syncer.  We copy one
 	// of them to the unnamed field after the handshake.
@@ -733,7 +773,9 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 	// Figure out if we should restart the decoder with new settings.
 	var binSnapCache snapshotCache
 	if h.clientSupportsDecoderRestart {
-		binSnapCache = h.allSnapshotters[h.chosenCompression][h.syncerType]
+		if h.resumeFrom == nil && h.nodeScopedHostname == "" {
+			binSnapCache = h.allSnapshotters[h.chosenCompression][h.syncerType]
+		}
 		var reasonsToRestart []string
 		if h.chosenCompression != "" {
 			reasonsToRestart = append(reasonsToRestart, fmt.Sprintf("enable compression: %v", h.chosenCompression))
@@ -753,7 +795,13 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 	}
 
 	var breadcrumb *snapcache.Breadcrumb
-	if binSnapCache != nil {
+	if h.resumeFrom != nil {
+		// The client already has the state up to this breadcrumb; just send the deltas that follow it.
+		h.logCxt.WithField("seqNo", h.resumeFrom.SequenceNumber).Info("Resuming client session, skipping snapshot.")
+		breadcrumb = h.resumeFrom
+		h.resumeFrom = nil
+		h.counterConnectionsResumed.Inc()
+	} else if binSnapCache != nil {
 		// We have a binary snapshot cache that supports this compression mode; send the compressed
 		// binary snapshot instead of a streamed snapshot.
 		snapStart := time.Now()
@@ -921,18 +969,27 @@ func (h *connection) doHandshake() error {
 	}
 	h.cache = desiredSyncerCache
 
-	for _, alg := range hello.SupportedCompressionAlgorithms {
-		switch alg {
-		case syncproto.CompressionSnappy:
-			h.chosenCompression = syncproto.CompressionSnappy
-		}
-	}
+	h.chosenCompression = h.chooseCompression(hello.SupportedCompressionAlgorithms)
 	h.clientSupportsDecoderRestart = hello.SupportsDecoderRestart
 	if h.chosenCompression != "" && !hello.SupportsDecoderRestart {
 		log.WithError(err).Warning("Client signalled compression but no support for decoder restart")
 		h.chosenCompression = ""
 	}
 
+	h.clientSupportsResume = hello.SupportsResume
+	var generationID string
+	if hello.SupportsResume {
+		generationID = h.cache.GenerationID()
+		if hello.CacheGenerationID != "" && hello.LastBreadcrumbSeqNo != 0 {
+			h.resumeFrom = h.findResumeBreadcrumb(hello, generationID)
+		}
+	}
+
+	if hello.NodeScoped && hello.Hostname != "" {
+		h.logCxt.WithField("hostname", hello.Hostname).Info("Client requested node-scoped updates.")
+		h.nodeScopedHostname = hello.Hostname
+	}
+
 	// Respond to client's hello.
 	err = h.sendMsg(syncproto.MsgServerHello{
 		Version: buildinfo.GitVersion,
@@ -941,6 +998,9 @@ func (h *connection) doHandshake() error {
 		SyncerType:                  syncerType,
 		SupportsNodeResourceUpdates: true,
 		ServerConnID:                h.ID,
+		CacheGenerationID:           generationID,
+		Resumed:                     h.resumeFrom != nil,
+		NodeScoped:                  h.nodeScopedHostname != "",
 	})
 	if err != nil {
 		log.WithError(err).Warning("Failed to send hello to client")
@@ -949,6 +1009,50 @@ func (h *connection) doHandshake() error {
 	return nil
 }
 
+// chooseCompression returns the compression algorithm to use with a client that supports the given
+// algorithms: our preferred algorithm if the client supports it, otherwise any other algorithm that we
+// both support.  Returns "" if there is no such algorithm.
+func (h *connection) chooseCompression(clientAlgs []syncproto.CompressionAlgorithm) syncproto.CompressionAlgorithm {
+	var chosen syncproto.CompressionAlgorithm
+	for _, alg := range clientAlgs {
+		if alg == h.config.PreferredCompression {
+			return alg
+		}
+		switch alg {
+		case syncproto.CompressionSnappy, syncproto.CompressionZstd:
+			if chosen == "" {
+				chosen = alg
+			}
+		}
+	}
+	return chosen
+}
+
+// findResumeBreadcrumb returns the breadcrumb that the client asked to resume from, or nil if it should be
+// sent a snapshot instead.
+func (h *connection) findResumeBreadcrumb(hello syncproto.MsgClientHello, generationID string) *snapcache.Breadcrumb {
+	logCxt := h.logCxt.WithFields(log.Fields{
+		"clientGeneration": hello.CacheGenerationID,
+		"clientSeqNo":      hello.LastBreadcrumbSeqNo,
+	})
+	if hello.CacheGenerationID != generationID {
+		logCxt.Info("Client asked to resume from a different cache generation, will send snapshot.")
+		return nil
+	}
+	crumb := h.cache.RetainedBreadcrumb(hello.LastBreadcrumbSeqNo)
+	if crumb == nil {
+		logCxt.Info("Client asked to resume from a breadcrumb that is no longer retained, will send snapshot.")
+		return nil
+	}
+	if age := h.cache.CurrentBreadcrumb().Timestamp.Sub(crumb.Timestamp); age > h.config.MaxFallBehind {
+		// The client would immediately be too far behind; a snapshot is likely to be cheaper.
+		logCxt.WithField("age", age).Info("Client asked to resume from a breadcrumb that is too old, will send snapshot.")
+		return nil
+	}
+	logCxt.Info("Client can resume from retained breadcrumb.")
+	return crumb
+}
+
 func (h *connection) restartEncodingIfSupported(message string) error {
 	if !h.clientSupportsDecoderRestart {
 		log.Debug("Can't restart decoder, client doesn't support it.")
@@ -988,9 +1092,14 @@ func (h *connection) waitForAckAndRestartEncoder() error {
 
 	// Upgrade to compressed connection if required.
 	bw := bufio.NewWriter(h.connW)
+	var w compressingWriter
 	switch h.chosenCompression {
 	case syncproto.CompressionSnappy:
-		w := snappy.NewBufferedWriter(bw)
+		w = newSnappyWriter(bw)
+	case syncproto.CompressionZstd:
+		w = newZstdWriter(bw, h.config.ZstdLevel, zstdStreamWindowSize)
+	}
+	if w != nil {
 		h.encoder = gob.NewEncoder(w) // Need a new Encoder, there's no way to change out the Writer.
 		h.flushWriter = func() error {
 			err := w.Flush()
@@ -999,7 +1108,7 @@ func (h *connection) waitForAckAndRestartEncoder() error {
 			}
 			return bw.Flush()
 		}
-	default:
+	} else {
 		h.encoder = gob.NewEncoder(bw) // Need a new Encoder, there's no way to change out the Writer.
 		h.flushWriter = bw.Flush
 	}
@@ -1074,24 +1183,34 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 
 	// Track the sync status reported in each Breadcrumb so we can send an update if it changes.
 	var lastSentStatus api.SyncStatus
-	maybeSendStatus := func() (err error) {
-		if lastSentStatus != breadcrumb.SyncStatus {
-			logCxt.WithField("newStatus", breadcrumb.SyncStatus).Info(
-				"Status update to send.")
-			err = h.sendMsg(syncproto.MsgSyncStatus{
-				SyncStatus: breadcrumb.SyncStatus,
-			})
-			if err != nil {
-				logCxt.WithError(err).Info("Failed to send status to client")
-				return
-			}
-			lastSentStatus = breadcrumb.SyncStatus
+	sendStatus := func() (err error) {
+		logCxt.WithField("newStatus", breadcrumb.SyncStatus).Info(
+			"Status update to send.")
+		err = h.sendMsg(syncproto.MsgSyncStatus{
+			SyncStatus:     breadcrumb.SyncStatus,
+			SequenceNumber: breadcrumb.SequenceNumber,
+		})
+		if err != nil {
+			logCxt.WithError(err).Info("Failed to send status to client")
+			return
 		}
+		lastSentStatus = breadcrumb.SyncStatus
 		return
 	}
+	maybeSendStatus := func() error {
+		if lastSentStatus != breadcrumb.SyncStatus {
+			return sendStatus()
+		}
+		return nil
+	}
 
-	// The first Breadcrumb may have changed the status.  Send an update if so.
-	if err := maybeSendStatus(); err != nil {
+	// The first Breadcrumb may have changed the status.  Send an update if so.  Clients that support resume
+	// always get the status so that they learn the sequence number of the snapshot.
+	if h.clientSupportsResume {
+		if err := sendStatus(); err != nil {
+			return
+		}
+	} else if err := maybeSendStatus(); err != nil {
 		return
 	}
 
@@ -1182,11 +1301,15 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 		}
 
 		if len(deltas) > 0 {
+			if h.nodeScopedHostname != "" {
+				deltas = scopeToNode(deltas, h.nodeScopedHostname)
+			}
 			// Send the deltas relative to the previous snapshot.
 			logCxt.WithField("num", len(deltas)).Debug("Sending deltas")
 			h.summaryNumKVsPerMsg.Observe(float64(len(deltas)))
 			err := h.sendMsg(syncproto.MsgKVs{
-				KVs: deltas,
+				KVs:            deltas,
+				SequenceNumber: breadcrumb.SequenceNumber,
 			})
 			if err != nil {
 				logCxt.WithError(err).Info("Failed to send to client.")
@@ -1210,6 +1333,7 @@ func (h *connection) streamSnapshotToClient(logCxt *log.Entry, breadcrumb *snapc
 		breadcrumb,
 		h.sendMsg,
 		h.config.MaxMessageSize,
+		h.nodeScopedHostname,
 	)
 	if err != nil {
 		return err
@@ -1219,13 +1343,25 @@ func (h *connection) streamSnapshotToClient(logCxt *log.Entry, breadcrumb *snapc
 	return nil
 }
 
+// scopeToNode returns a copy of the given updates, as they should be sent to a node-scoped client on the
+// given node.  The input slice may be shared with other clients so it is not modified.
+func scopeToNode(updates []syncproto.SerializedUpdate, hostname string) []syncproto.SerializedUpdate {
+	scoped := make([]syncproto.SerializedUpdate, len(updates))
+	for i, u := range updates {
+		scoped[i] = u.ScopedToNode(hostname)
+	}
+	return scoped
+}
+
 // writeSnapshotMessages chunks the given breadcrumb up into syncproto.MsgKVs objects and calls writeMsg for each one.
+// If nodeScopedHostname is non-empty, the KVs are scoped to that node (see syncproto.SerializedUpdate.ScopedToNode).
 func writeSnapshotMessages(
 	ctx context.Context,
 	logCxt *log.Entry,
 	breadcrumb *snapcache.Breadcrumb,
 	writeMsg func(any) error,
 	maxMsgSize int,
+	nodeScopedHostname string,
 ) (err error) {
 	logCxt = logCxt.WithFields(log.Fields{
 		"seqNo":  breadcrumb.SequenceNumber,
@@ -1257,6 +1393,9 @@ func writeSnapshotMessages(
 			err = ctx.Err()
 			return false
 		}
+		if nodeScopedHostname != "" {
+			entry = entry.ScopedToNode(nodeScopedHostname)
+		}
 		kvs = append(kvs, entry)
 		if len(kvs) >= maxMsgSize {
 			// Buffer is full, send the next batch.
@@ -1320,6 +1459,7 @@ type perSyncerConnMetrics struct {
 	summaryPingLatency           prometheus.Summary
 	summaryNumKVsPerMsg          prometheus.Summary
 	gaugeNumConnectionsStreaming prometheus.Gauge
+	counterConnectionsResumed    prometheus.Counter
 }
 
 func makePerSyncerConnMetrics(syncerType syncproto.SyncerType) perSyncerConnMetrics {
@@ -1362,5 +1502,6 @@ func makePerSyncerConnMetrics(syncerType syncproto.SyncerType) perSyncerConnMetr
 	}))
 	c.counterGracePeriodUsed = counterVecGracePeriodUsed.WithLabelValues(string(syncerType))
 	c.gaugeNumConnectionsStreaming = gaugeVecNumConnectionsStreaming.WithLabelValues(string(syncerType))
+	c.counterConnectionsResumed = counterVecConnectionsResumed.WithLabelValues(string(syncerType))
 	return c
 }
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	. "github.com/projectcalico/calico/typha/pkg/syncserver"
 )
 
@@ -47,6 +48,8 @@ var _ = Describe("With zero config", func() {
 			ShutdownMaxDropInterval:        time.Second,
 			MaxConns:                       math.MaxInt32,
 			Port:                           5473,
+			PreferredCompression:           syncproto.CompressionSnappy,
+			ZstdLevel:                      3,
 		}))
 	})
 	It("should convert random port to 0", func() {
@@ -31,6 +31,9 @@ const (
 
 	FlowsPath            = sep + "flows"
 	FlowsFilterHintsPath = sep + "flows-filter-hints"
+	FlowsGraphPath       = sep + "flows-graph"
+
+	FlowsPolicyRecommendationsPath = sep + "flows-policy-recommendations"
 )
 
 func init() {
@@ -64,6 +67,22 @@ func init() {
 		return nil, fmt.Errorf("unknown filter type value %s; allowed values are '%s'", vals[0], strings.Join(allowedValues, "', '"))
 	})
 
+	// Register a decoder for the GraphGranularity.
+	codec.RegisterCustomDecodeTypeFunc(func(vals []string) (GraphGranularity, error) {
+		for _, v := range vals {
+			if granularity, exists := proto.GraphGranularity_value["GraphGranularity"+v]; exists {
+				return GraphGranularity(granularity), nil
+			}
+		}
+
+		allowedValues := slices.Collect(maps.Keys(proto.GraphGranularity_value))
+		for i, val := range allowedValues {
+			allowedValues[i] = strings.TrimPrefix(val, "GraphGranularity")
+		}
+
+		return 0, fmt.Errorf("unknown granularity value %s; allowed values are '%s'", vals[0], strings.Join(allowedValues, "', '"))
+	})
+
 	codec.RegisterURLQueryJSONType[Filters]()
 }
 
@@ -225,6 +244,11 @@ type Filters struct {
 	DestPorts        FilterMatches[int64]  `json:"dest_ports,omitempty"`
 	Actions          Actions               `json:"actions,omitempty"`
 	Policies         []PolicyMatch         `json:"policies,omitempty"`
+
+	// SourceSelector and DestSelector are Calico selector expressions evaluated against
+	// the source and destination labels of each flow, e.g. "app == 'checkout'".
+	SourceSelector string `json:"source_selector,omitempty"`
+	DestSelector   string `json:"dest_selector,omitempty"`
 }
 
 type PolicyMatch struct {
@@ -288,3 +312,80 @@ type Pagination struct {
 type FlowFilterHintResponse struct {
 	Value string `json:"value"`
 }
+
+type GraphGranularity proto.GraphGranularity
+
+const (
+	GraphGranularityEndpoint  = GraphGranularity(proto.GraphGranularity_GraphGranularityEndpoint)
+	GraphGranularityNamespace = GraphGranularity(proto.GraphGranularity_GraphGranularityNamespace)
+)
+
+func (p GraphGranularity) String() string { return proto.GraphGranularity(p).String() }
+func (p GraphGranularity) AsProto() proto.GraphGranularity {
+	return proto.GraphGranularity(p)
+}
+
+type GraphNodeType proto.GraphNodeType
+
+func (p GraphNodeType) String() string {
+	return strings.TrimPrefix(proto.GraphNodeType(p).String(), "GraphNodeType")
+}
+func (p GraphNodeType) MarshalJSON() ([]byte, error) { return marshalToBytes(p) }
+func (p *GraphNodeType) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	if t, exists := proto.GraphNodeType_value["GraphNodeType"+str]; exists {
+		*p = GraphNodeType(t)
+		return nil
+	}
+	return fmt.Errorf("unknown value: %s", str)
+}
+
+type FlowGraphParams struct {
+	StartTimeGte int64 `urlQuery:"startTimeGte"`
+	StartTimeLt  int64 `urlQuery:"startTimeLt"`
+
+	// Granularity determines what each node in the graph represents, either "Endpoint" (the default) or "Namespace".
+	Granularity GraphGranularity `urlQuery:"granularity"`
+	Filters     Filters          `urlQuery:"filters"`
+}
+
+type FlowGraphResponse struct {
+	Nodes []FlowGraphNode `json:"nodes"`
+	Edges []FlowGraphEdge `json:"edges"`
+}
+
+type FlowGraphNode struct {
+	ID        string        `json:"id"`
+	Type      GraphNodeType `json:"type"`
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+}
+
+type FlowGraphEdge struct {
+	Source                  string `json:"source"`
+	Dest                    string `json:"dest"`
+	PacketsIn               int64  `json:"packets_in"`
+	PacketsOut              int64  `json:"packets_out"`
+	BytesIn                 int64  `json:"bytes_in"`
+	BytesOut                int64  `json:"bytes_out"`
+	NumConnectionsStarted   int64  `json:"num_connections_started"`
+	NumConnectionsCompleted int64  `json:"num_connections_completed"`
+	NumConnectionsLive      int64  `json:"num_connections_live"`
+	AllowedFlows            int64  `json:"allowed_flows"`
+	DeniedFlows             int64  `json:"denied_flows"`
+}
+
+type PolicyRecommendationsParams struct {
+	StartTimeGte int64 `urlQuery:"startTimeGte"`
+	StartTimeLt  int64 `urlQuery:"startTimeLt"`
+
+	// Namespace is the namespace to recommend StagedNetworkPolicies for. If empty, StagedGlobalNetworkPolicies are
+	// recommended for host endpoints.
+	Namespace string `urlQuery:"namespace"`
+
+	// Tier is the tier to place the recommended policies in. Defaults to the "default" tier.
+	Tier string `urlQuery:"tier"`
+}
@@ -75,6 +75,8 @@ func TestListFlows(t *testing.T) {
 					Namespace: v1.NewFilterMatch("namespace", v1.MatchTypeExact),
 					Action:    v1.ActionDeny,
 				}},
+				SourceSelector: "app == 'src'",
+				DestSelector:   "app == 'dest'",
 			}},
 		},
 	}
@@ -179,6 +181,51 @@ func TestListFlowsFilterHints_InvalidTypeGiven(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+func TestFlowGraph(t *testing.T) {
+	sc := setupTest(t)
+
+	tt := []struct {
+		description string
+		request     *http.Request
+		expected    *v1.FlowGraphParams
+	}{
+		{
+			description: "Decoder defaults to endpoint granularity",
+			request:     mustCreateGetRequest("GET", "", nil),
+			expected:    &v1.FlowGraphParams{Granularity: v1.GraphGranularityEndpoint},
+		},
+		{
+			description: "Decoder parses granularity and time range",
+			request: mustCreateGetRequest("GET", "", map[string][]string{
+				"granularity":  {"Namespace"},
+				"startTimeGte": {"-300"},
+				"startTimeLt":  {"-60"},
+			}),
+			expected: &v1.FlowGraphParams{
+				Granularity:  v1.GraphGranularityNamespace,
+				StartTimeGte: -300,
+				StartTimeLt:  -60,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.description, func(t *testing.T) {
+			params, err := codec.DecodeAndValidateRequestParams[v1.FlowGraphParams](sc.apiCtx, sc.URLVars, tc.request)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(params).Should(Equal(tc.expected))
+		})
+	}
+}
+
+func TestFlowGraph_InvalidGranularityGiven(t *testing.T) {
+	sc := setupTest(t)
+
+	req := mustCreateGetRequest("GET", "", map[string][]string{"granularity": {"FooBar"}})
+	_, err := codec.DecodeAndValidateRequestParams[v1.FlowGraphParams](sc.apiCtx, sc.URLVars, req)
+	Expect(err).Should(HaveOccurred())
+}
+
 func TestFilters_DecodedFromRawString(t *testing.T) {
 	sc := setupTest(t)
 
@@ -211,6 +258,8 @@ func TestFilters_DecodedFromRawString(t *testing.T) {
 					Namespace: v1.NewFilterMatch("namespace", v1.MatchTypeExact),
 					Action:    v1.ActionDeny,
 				}},
+				SourceSelector: "app == 'src'",
+				DestSelector:   "app == 'dest'",
 			},
 		},
 		{
@@ -15,6 +15,8 @@
 package v1
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/projectcalico/calico/goldmane/proto"
 	"github.com/projectcalico/calico/lib/httpmachinery/pkg/apiutil"
 	apictx "github.com/projectcalico/calico/lib/httpmachinery/pkg/context"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 	whiskerv1 "github.com/projectcalico/calico/whisker-backend/pkg/apis/v1"
 )
 
@@ -47,6 +50,16 @@ func (hdlr *flowsHdlr) APIs() []apiutil.Endpoint {
 			Path:    whiskerv1.FlowsFilterHintsPath,
 			Handler: apiutil.NewJSONListHandler(hdlr.ListFilterHints),
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    whiskerv1.FlowsGraphPath,
+			Handler: apiutil.NewJSONSingleHandler(hdlr.Graph),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    whiskerv1.FlowsPolicyRecommendationsPath,
+			Handler: apiutil.NewJSONListHandler(hdlr.ListPolicyRecommendations),
+		},
 	}
 }
 
@@ -57,6 +70,11 @@ func (hdlr *flowsHdlr) ListOrStream(ctx apictx.Context, params whiskerv1.ListFlo
 
 	logrus.WithField("filter", params.Filters).Debug("Applying filters.")
 
+	if err := validateSelectors(params.Filters); err != nil {
+		logger.WithError(err).Debug("Invalid selector in filters.")
+		return apiutil.NewListOrStreamResponse[whiskerv1.FlowResponse]().SetStatus(http.StatusBadRequest).SetError(err.Error())
+	}
+
 	filter := toProtoFilter(params.Filters)
 	if params.Watch {
 		logger.Debug("Watch is set, streaming flows...")
@@ -122,6 +140,13 @@ func (hdlr *flowsHdlr) ListFilterHints(ctx apictx.Context, params whiskerv1.Flow
 	logger := ctx.Logger()
 	logger.Debug("ListFilterHints called.")
 
+	if err := validateSelectors(params.Filters); err != nil {
+		logger.WithError(err).Debug("Invalid selector in filters.")
+		return apiutil.NewListResponse[whiskerv1.FlowFilterHintResponse]().
+			SetStatus(http.StatusBadRequest).
+			SetError(err.Error())
+	}
+
 	req := &proto.FilterHintsRequest{
 		PageSize: int64(params.PageSize),
 		Page:     int64(params.Page),
@@ -153,3 +178,84 @@ func (hdlr *flowsHdlr) ListFilterHints(ctx apictx.Context, params whiskerv1.Flow
 		SetMeta(apiutil.ListMeta{TotalPages: int(hintsMeta.TotalPages)}).
 		SetItems(hints)
 }
+
+// Graph returns a graph of the communication between endpoints (or namespaces) built from the flows that match the
+// given filters.
+func (hdlr *flowsHdlr) Graph(ctx apictx.Context, params whiskerv1.FlowGraphParams) apiutil.SingleResponse[whiskerv1.FlowGraphResponse] {
+	logger := ctx.Logger()
+	logger.Debug("Graph called.")
+
+	if err := validateSelectors(params.Filters); err != nil {
+		logger.WithError(err).Debug("Invalid selector in filters.")
+		return apiutil.NewSingleResponse[whiskerv1.FlowGraphResponse]().
+			SetStatus(http.StatusBadRequest).
+			SetError(err.Error())
+	}
+
+	req := &proto.GraphRequest{
+		StartTimeGte: params.StartTimeGte,
+		StartTimeLt:  params.StartTimeLt,
+		Granularity:  params.Granularity.AsProto(),
+		Filter:       toProtoFilter(params.Filters),
+	}
+
+	graph, err := hdlr.flowCli.Graph(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("failed to get flow graph")
+		return apiutil.NewSingleResponse[whiskerv1.FlowGraphResponse]().
+			SetStatus(http.StatusInternalServerError).
+			SetError("Internal Server Error")
+	}
+
+	return apiutil.NewSingleResponse[whiskerv1.FlowGraphResponse]().
+		SetStatus(http.StatusOK).
+		SetItem(protoToGraph(graph))
+}
+
+// ListPolicyRecommendations returns a list of projectcalico.org/v3 staged policies that would allow the flows observed
+// for the requested namespace.
+func (hdlr *flowsHdlr) ListPolicyRecommendations(ctx apictx.Context, params whiskerv1.PolicyRecommendationsParams) apiutil.ListResponse[json.RawMessage] {
+	logger := ctx.Logger()
+	logger.Debug("ListPolicyRecommendations called.")
+
+	req := &proto.PolicyRecommendationRequest{
+		StartTimeGte: params.StartTimeGte,
+		StartTimeLt:  params.StartTimeLt,
+		Namespace:    params.Namespace,
+		Tier:         params.Tier,
+	}
+
+	result, err := hdlr.flowCli.PolicyRecommendations(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("failed to get policy recommendations")
+		return apiutil.NewListResponse[json.RawMessage]().
+			SetStatus(http.StatusInternalServerError).
+			SetError("Internal Server Error")
+	}
+
+	policies := make([]json.RawMessage, len(result.Policies))
+	for i, p := range result.Policies {
+		policies[i] = p.Resource
+	}
+
+	return apiutil.NewListResponse[json.RawMessage]().
+		SetStatus(http.StatusOK).
+		SetMeta(apiutil.ListMeta{TotalPages: 1}).
+		SetItems(policies)
+}
+
+// validateSelectors checks the label selectors in the given filters, so that we can return a meaningful
+// error to the caller rather than failing the request to Goldmane.
+func validateSelectors(filters whiskerv1.Filters) error {
+	if filters.SourceSelector != "" {
+		if err := selector.Validate(filters.SourceSelector); err != nil {
+			return fmt.Errorf("invalid source_selector: %w", err)
+		}
+	}
+	if filters.DestSelector != "" {
+		if err := selector.Validate(filters.DestSelector); err != nil {
+			return fmt.Errorf("invalid dest_selector: %w", err)
+		}
+	}
+	return nil
+}
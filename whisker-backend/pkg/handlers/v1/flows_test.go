@@ -16,6 +16,7 @@ package v1_test
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -288,6 +289,8 @@ func TestListFlowsParameterConversion(t *testing.T) {
 					Protocols:        []whiskerv1.FilterMatch[string]{{V: "tcp"}},
 					DestPorts:        []whiskerv1.FilterMatch[int64]{{V: 6060}},
 					Actions:          whiskerv1.Actions{whiskerv1.Action(proto.Action_Pass), whiskerv1.Action(proto.Action_Allow)},
+					SourceSelector:   "app == 'src'",
+					DestSelector:     "app == 'dst'",
 				},
 			},
 			expected: &proto.FlowListRequest{
@@ -305,6 +308,8 @@ func TestListFlowsParameterConversion(t *testing.T) {
 					Protocols:        []*proto.StringMatch{{Value: "tcp"}},
 					DestPorts:        []*proto.PortMatch{{Port: 6060}},
 					Actions:          []proto.Action{proto.Action_Pass, proto.Action_Allow},
+					SourceSelector:   "app == 'src'",
+					DestSelector:     "app == 'dst'",
 				},
 			},
 			configureFlowsCli: func(fsCli *climocks.FlowsClient) {
@@ -364,3 +369,87 @@ func TestListFilterHints(t *testing.T) {
 			},
 		}))
 }
+
+func TestGraph(t *testing.T) {
+	sc := setupTest(t)
+
+	fsCli := new(climocks.FlowsClient)
+	fsCli.On("Graph", mock.Anything, mock.MatchedBy(func(req *proto.GraphRequest) bool {
+		return req.Granularity == proto.GraphGranularity_GraphGranularityNamespace
+	})).Return(
+		&proto.GraphResult{
+			Nodes: []*proto.GraphNode{
+				{Id: "namespace/default", Type: proto.GraphNodeType_GraphNodeTypeNamespace, Namespace: "default"},
+				{Id: "network//pub", Type: proto.GraphNodeType_GraphNodeTypeNetwork, Name: "pub"},
+			},
+			Edges: []*proto.GraphEdge{
+				{Source: "namespace/default", Dest: "network//pub", BytesOut: 100, PacketsOut: 10, DeniedFlows: 1},
+			},
+		}, nil)
+
+	hdlr := hdlrv1.NewFlows(fsCli)
+	rsp := hdlr.Graph(sc.apiCtx, whiskerv1.FlowGraphParams{Granularity: whiskerv1.GraphGranularityNamespace})
+	Expect(rsp.Status()).Should(Equal(http.StatusOK))
+	recorder := httptest.NewRecorder()
+	Expect(rsp.ResponseWriter().WriteResponse(sc.apiCtx, http.StatusOK, recorder)).ShouldNot(HaveOccurred())
+	Expect(recorder.Body.String()).Should(ContainSubstring(`"type":"Namespace"`))
+
+	graph := testutil.MustUnmarshal[whiskerv1.FlowGraphResponse](t, recorder.Body.Bytes())
+	Expect(graph).Should(Equal(&whiskerv1.FlowGraphResponse{
+		Nodes: []whiskerv1.FlowGraphNode{
+			{ID: "namespace/default", Type: whiskerv1.GraphNodeType(proto.GraphNodeType_GraphNodeTypeNamespace), Namespace: "default"},
+			{ID: "network//pub", Type: whiskerv1.GraphNodeType(proto.GraphNodeType_GraphNodeTypeNetwork), Name: "PUBLIC NETWORK"},
+		},
+		Edges: []whiskerv1.FlowGraphEdge{
+			{Source: "namespace/default", Dest: "network//pub", BytesOut: 100, PacketsOut: 10, DeniedFlows: 1},
+		},
+	}))
+	fsCli.AssertExpectations(t)
+}
+
+func TestListPolicyRecommendations(t *testing.T) {
+	sc := setupTest(t)
+
+	policy := `{"kind":"StagedNetworkPolicy","apiVersion":"projectcalico.org/v3","metadata":{"name":"recommended-1","namespace":"shop"}}`
+
+	fsCli := new(climocks.FlowsClient)
+	fsCli.On("PolicyRecommendations", mock.Anything, &proto.PolicyRecommendationRequest{Namespace: "shop", StartTimeGte: -3600}).Return(
+		&proto.PolicyRecommendationResult{
+			Policies: []*proto.RecommendedPolicy{
+				{Kind: "StagedNetworkPolicy", Name: "recommended-1", Namespace: "shop", Resource: []byte(policy)},
+			},
+		}, nil)
+
+	hdlr := hdlrv1.NewFlows(fsCli)
+	rsp := hdlr.ListPolicyRecommendations(sc.apiCtx, whiskerv1.PolicyRecommendationsParams{Namespace: "shop", StartTimeGte: -3600})
+	Expect(rsp.Status()).Should(Equal(http.StatusOK))
+	recorder := httptest.NewRecorder()
+	Expect(rsp.ResponseWriter().WriteResponse(sc.apiCtx, http.StatusOK, recorder)).ShouldNot(HaveOccurred())
+
+	policies := testutil.MustUnmarshal[apiutil.List[json.RawMessage]](t, recorder.Body.Bytes())
+	Expect(policies.Items).Should(HaveLen(1))
+	Expect(string(policies.Items[0])).Should(MatchJSON(policy))
+	fsCli.AssertExpectations(t)
+}
+
+func TestInvalidSelector(t *testing.T) {
+	sc := setupTest(t)
+
+	// The Goldmane client should never be called with an invalid selector.
+	fsCli := new(climocks.FlowsClient)
+	hdlr := hdlrv1.NewFlows(fsCli)
+
+	filters := whiskerv1.Filters{SourceSelector: "app == "}
+	rsp := hdlr.ListOrStream(sc.apiCtx, whiskerv1.ListFlowsParams{Filters: filters})
+	Expect(rsp.Status()).Should(Equal(http.StatusBadRequest))
+
+	hintsRsp := hdlr.ListFilterHints(sc.apiCtx, whiskerv1.FlowFilterHintsRequest{
+		Type:    ptr.ToPtr(whiskerv1.FilterType(proto.FilterType_FilterTypeDestNamespace)),
+		Filters: filters,
+	})
+	Expect(hintsRsp.Status()).Should(Equal(http.StatusBadRequest))
+
+	graphRsp := hdlr.Graph(sc.apiCtx, whiskerv1.FlowGraphParams{Filters: filters})
+	Expect(graphRsp.Status()).Should(Equal(http.StatusBadRequest))
+	fsCli.AssertExpectations(t)
+}
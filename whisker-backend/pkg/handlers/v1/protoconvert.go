@@ -85,6 +85,8 @@ func toProtoFilter(filters whiskerv1.Filters) *proto.Filter {
 		DestPorts:        toProtoPorts(filters.DestPorts),
 		Actions:          filters.Actions.AsProtos(),
 		Policies:         toProtoPolicyMatch(filters.Policies),
+		SourceSelector:   filters.SourceSelector,
+		DestSelector:     filters.DestSelector,
 	}
 }
 
@@ -163,6 +165,37 @@ func protoToFlow(flow *proto.Flow) whiskerv1.FlowResponse {
 	}
 }
 
+func protoToGraph(graph *proto.GraphResult) whiskerv1.FlowGraphResponse {
+	rsp := whiskerv1.FlowGraphResponse{
+		Nodes: make([]whiskerv1.FlowGraphNode, len(graph.Nodes)),
+		Edges: make([]whiskerv1.FlowGraphEdge, len(graph.Edges)),
+	}
+	for i, n := range graph.Nodes {
+		rsp.Nodes[i] = whiskerv1.FlowGraphNode{
+			ID:        n.Id,
+			Type:      whiskerv1.GraphNodeType(n.Type),
+			Namespace: n.Namespace,
+			Name:      protoToName(n.Name),
+		}
+	}
+	for i, e := range graph.Edges {
+		rsp.Edges[i] = whiskerv1.FlowGraphEdge{
+			Source:                  e.Source,
+			Dest:                    e.Dest,
+			PacketsIn:               e.PacketsIn,
+			PacketsOut:              e.PacketsOut,
+			BytesIn:                 e.BytesIn,
+			BytesOut:                e.BytesOut,
+			NumConnectionsStarted:   e.NumConnectionsStarted,
+			NumConnectionsCompleted: e.NumConnectionsCompleted,
+			NumConnectionsLive:      e.NumConnectionsLive,
+			AllowedFlows:            e.AllowedFlows,
+			DeniedFlows:             e.DeniedFlows,
+		}
+	}
+	return rsp
+}
+
 // The Goldmane API uses an empty namespace to represent "no namespace", but the UI wants a value.
 func protoToNamespace(namespace string) string {
 	if namespace == "" || namespace == "-" {